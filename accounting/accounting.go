@@ -0,0 +1,230 @@
+package accounting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fkgi/diameter"
+)
+
+const (
+	// CommandCode is command code of Accounting-Request/Answer.
+	CommandCode uint32 = 271
+	// BaseAccounting is Application-ID of Diameter base accounting.
+	BaseAccounting uint32 = 3
+)
+
+// Record is accounting record that is sent with ACR.
+type Record struct {
+	SessionID string
+	Type      diameter.Enumerated // Accounting-Record-Type
+	Number    uint32              // Accounting-Record-Number
+	AVPs      []diameter.AVP      // other AVPs of the record
+}
+
+/*
+Client sends accounting record with Accounting-Request message.
+
+	<ACR> ::= < Diameter Header: 271, REQ, PXY >
+		< Session-Id >
+		{ Origin-Host }
+		{ Origin-Realm }
+		{ Destination-Realm }
+		{ Accounting-Record-Type }
+		{ Accounting-Record-Number }
+		[ Acct-Application-Id ]
+		[ Vendor-Specific-Application-Id ]
+		[ Destination-Host ]
+	  * [ AVP ]
+
+Record that is failed to be delivered is kept in Store,
+and it is sent again with T flag by Flush.
+*/
+type Client struct {
+	// Tx is ContextHandler of ACR that is returned from HandleAcctContext.
+	Tx diameter.ContextHandler
+	// Node is local diameter node for Origin-Host and Origin-Realm. nil is default node.
+	Node *diameter.Node
+
+	AppID    uint32 // AppID is Acct-Application-Id
+	VendorID uint32 // VendorID is Vendor-Id of vendor specific application
+
+	DestinationRealm diameter.Identity
+	DestinationHost  diameter.Identity // optional
+
+	// Store keeps undelivered records. nil means that the records are discarded.
+	Store Store
+}
+
+// Request returns AVPs of ACR for the record.
+func (c *Client) Request(r Record) []diameter.AVP {
+	n := c.Node
+	if n == nil {
+		n = diameter.DefaultNode()
+	}
+
+	avps := make([]diameter.AVP, 0, 9+len(r.AVPs))
+	avps = append(avps,
+		diameter.SetSessionID(r.SessionID),
+		diameter.SetOriginHost(n.Host),
+		diameter.SetOriginRealm(n.Realm),
+		diameter.SetDestinationRealm(c.DestinationRealm),
+		diameter.SetAcctRecordType(r.Type),
+		diameter.SetAcctRecordNumber(r.Number))
+	if c.VendorID != 0 {
+		avps = append(avps, diameter.SetVendorSpecAcctAppID(c.VendorID, c.AppID))
+	} else {
+		avps = append(avps, diameter.SetAcctAppID(c.AppID))
+	}
+	if c.DestinationHost != "" {
+		avps = append(avps, diameter.SetDestinationHost(c.DestinationHost))
+	}
+	return append(avps, r.AVPs...)
+}
+
+// Send sends the record and returns Vendor-Id and code of Result-Code or Experimental-Result of ACA.
+// Vendor-Id is 0 for Result-Code.
+// The record is pushed to Store when it is not delivered.
+func (c *Client) Send(ctx context.Context, r Record) (uint32, uint32, error) {
+	venID, result, err := c.send(ctx, false, r)
+	if err != nil && c.Store != nil {
+		if e := c.Store.Push(r); e != nil {
+			err = errors.Join(err, e)
+		}
+	}
+	return venID, result, err
+}
+
+// Flush sends records in Store with T flag in stored order.
+// Flush stops at the first record that is not delivered, and the record is kept in Store.
+// Output is number of delivered records.
+func (c *Client) Flush(ctx context.Context) (int, error) {
+	if c.Store == nil {
+		return 0, nil
+	}
+	for i := 0; ; i++ {
+		r, ok, err := c.Store.Peek()
+		if err != nil || !ok {
+			return i, err
+		}
+		if _, _, err = c.send(ctx, true, r); err != nil {
+			return i, err
+		}
+		if err = c.Store.Pop(); err != nil {
+			return i, err
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, retry bool, r Record) (uint32, uint32, error) {
+	if c.Tx == nil {
+		return 0, 0, errors.New("no ContextHandler for ACR")
+	}
+	_, avps, err := c.Tx(ctx, retry, c.Request(r))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	venID, result, ok := diameter.ResultOf(avps)
+	if !ok {
+		return 0, 0, diameter.InvalidMessage{
+			Code: diameter.MissingAvp, ErrMsg: "no valid Result-Code or Experimental-Result in ACA"}
+	}
+	// only base protocol result means that the record is not delivered to server
+	if venID == 0 && (result == diameter.UnableToDeliver || result == diameter.TooBusy) {
+		return venID, result, diameter.InvalidMessage{
+			Code: result, ErrMsg: fmt.Sprintf("record is not delivered by Result-Code %d", result)}
+	}
+	return venID, result, nil
+}
+
+/*
+Handler returns Handler for ACR that calls f with received record.
+Output of f is Result-Code of ACA.
+
+	<ACA> ::= < Diameter Header: 271, PXY >
+		< Session-Id >
+		{ Result-Code }
+		{ Origin-Host }
+		{ Origin-Realm }
+		{ Accounting-Record-Type }
+		{ Accounting-Record-Number }
+		[ Acct-Application-Id ]
+		[ Vendor-Specific-Application-Id ]
+		[ Failed-AVP ]
+
+Origin-Host and Origin-Realm of the record are kept in AVPs of the record.
+Input node n is local diameter node for the answer, nil is default node.
+*/
+func Handler(n *diameter.Node, f func(bool, Record) uint32) diameter.Handler {
+	return func(retry bool, avps []diameter.AVP) (bool, []diameter.AVP) {
+		local := n
+		if local == nil {
+			local = diameter.DefaultNode()
+		}
+
+		var r Record
+		var app diameter.AVP
+		var err error
+		for _, a := range avps {
+			if a.VendorID != 0 {
+				r.AVPs = append(r.AVPs, a)
+				continue
+			}
+			switch a.Code {
+			case 263:
+				r.SessionID, err = diameter.GetSessionID(a)
+			case 480:
+				r.Type, err = diameter.GetAcctRecordType(a)
+			case 485:
+				r.Number, err = diameter.GetAcctRecordNumber(a)
+			case 259:
+				_, err = diameter.GetAcctAppID(a)
+				app = a
+			case 260:
+				_, _, err = diameter.GetVendorSpecAcctAppID(a)
+				app = a
+			case 283, 293:
+			default:
+				r.AVPs = append(r.AVPs, a)
+			}
+			if err != nil {
+				break
+			}
+		}
+		if err == nil && r.SessionID == "" {
+			err = diameter.InvalidAVP{
+				Code: diameter.MissingAvp, AVP: diameter.SetSessionID("")}
+		} else if err == nil && r.Type == 0 {
+			err = diameter.InvalidAVP{
+				Code: diameter.MissingAvp, AVP: diameter.SetAcctRecordType(0)}
+		}
+
+		result := diameter.Success
+		var failed diameter.AVP
+		if iavp, ok := err.(diameter.InvalidAVP); ok {
+			result = iavp.Code
+			failed = iavp.AVP
+		} else if err != nil {
+			result = diameter.InvalidAvpValue
+		} else {
+			result = f(retry, r)
+		}
+
+		ans := []diameter.AVP{
+			diameter.SetSessionID(r.SessionID),
+			diameter.SetResultCode(result),
+			diameter.SetOriginHost(local.Host),
+			diameter.SetOriginRealm(local.Realm),
+			diameter.SetAcctRecordType(r.Type),
+			diameter.SetAcctRecordNumber(r.Number)}
+		if app.Code != 0 {
+			ans = append(ans, app)
+		}
+		if failed.Code != 0 {
+			ans = append(ans, diameter.SetFailedAVP([]diameter.AVP{failed}))
+		}
+		return diameter.IsProtocolError(result), ans
+	}
+}
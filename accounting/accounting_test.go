@@ -0,0 +1,41 @@
+package accounting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fkgi/diameter"
+)
+
+func TestClientResult(t *testing.T) {
+	for _, tc := range []struct {
+		result      diameter.AVP
+		venID, code uint32
+		undelivered bool
+	}{
+		{diameter.SetResultCode(diameter.Success), 0, diameter.Success, false},
+		{diameter.SetResultCode(diameter.UnableToDeliver), 0, diameter.UnableToDeliver, true},
+		{diameter.SetExperimentalResult(10415, diameter.UnableToDeliver),
+			10415, diameter.UnableToDeliver, false},
+	} {
+		store := &MemoryStore{}
+		c := &Client{
+			Tx: func(context.Context, bool, []diameter.AVP) (bool, []diameter.AVP, error) {
+				return false, []diameter.AVP{
+					diameter.SetSessionID("a.local;1;2"), tc.result}, nil
+			},
+			Node:  diameter.NewNode("a.local", "local"),
+			AppID: BaseAccounting, Store: store}
+		venID, code, err := c.Send(context.Background(), Record{
+			SessionID: "a.local;1;2", Type: 2, Number: 0})
+		c.Node.Close()
+
+		if venID != tc.venID || code != tc.code {
+			t.Errorf("result is %d/%d, not %d/%d", venID, code, tc.venID, tc.code)
+		}
+		if (err != nil) != tc.undelivered || (store.Len() != 0) != tc.undelivered {
+			t.Errorf("result %d/%d: error is %v and %d records are stored",
+				venID, code, err, store.Len())
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package accounting
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// Store keeps accounting records that are not delivered (RFC 6733 section 9.4).
+type Store interface {
+	// Push appends the record to the end of the store.
+	Push(Record) error
+	// Peek returns the oldest record. false is returned when the store is empty.
+	Peek() (Record, bool, error)
+	// Pop removes the oldest record.
+	Pop() error
+}
+
+// MemoryStore is Store on memory.
+type MemoryStore struct {
+	lock    sync.Mutex
+	records []Record
+}
+
+// Push appends the record to the end of the store.
+func (s *MemoryStore) Push(r Record) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.records = append(s.records, r)
+	return nil
+}
+
+// Peek returns the oldest record.
+func (s *MemoryStore) Peek() (Record, bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.records) == 0 {
+		return Record{}, false, nil
+	}
+	return s.records[0], true, nil
+}
+
+// Pop removes the oldest record.
+func (s *MemoryStore) Pop() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.records) != 0 {
+		s.records = s.records[1:]
+	}
+	return nil
+}
+
+// Len returns number of records in the store.
+func (s *MemoryStore) Len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.records)
+}
+
+/*
+FileStore is Store on file that survives restart of the process.
+Each record is written as a line of JSON text,
+and the file is replaced with rest of records on Pop.
+*/
+type FileStore struct {
+	path string
+	mem  MemoryStore
+}
+
+// OpenFileStore opens FileStore and loads records in the file.
+// The file is created when it does not exist.
+func OpenFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path}
+
+	f, err := os.OpenFile(path, os.O_RDONLY|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var r Record
+		if err = json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			return nil, err
+		}
+		s.mem.records = append(s.mem.records, r)
+	}
+	return s, scanner.Err()
+}
+
+// Push appends the record to the end of the store.
+func (s *FileStore) Push(r Record) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	s.mem.lock.Lock()
+	defer s.mem.lock.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(data, '\n')); err == nil {
+		err = f.Sync()
+	}
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err == nil {
+		s.mem.records = append(s.mem.records, r)
+	}
+	return err
+}
+
+// Peek returns the oldest record.
+func (s *FileStore) Peek() (Record, bool, error) {
+	return s.mem.Peek()
+}
+
+// Pop removes the oldest record.
+func (s *FileStore) Pop() error {
+	s.mem.lock.Lock()
+	defer s.mem.lock.Unlock()
+	if len(s.mem.records) == 0 {
+		return nil
+	}
+
+	tmp := s.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, r := range s.mem.records[1:] {
+		if err = enc.Encode(r); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err == nil {
+		err = os.Rename(tmp, s.path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	s.mem.records = s.mem.records[1:]
+	return nil
+}
+
+// Len returns number of records in the store.
+func (s *FileStore) Len() int {
+	return s.mem.Len()
+}
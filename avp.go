@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math"
 	"net"
 	"time"
 )
@@ -53,10 +54,15 @@ func (a AVP) String() string {
 
 // MarshalTo wite binary data to io.Writer
 func (a AVP) MarshalTo(w io.Writer) error {
-	var b bytes.Buffer
-
-	binary.Write(&b, binary.BigEndian, a.Code)
+	p := getBuffer()
+	*p = a.AppendTo((*p)[:0])
+	_, err := w.Write(*p)
+	putBuffer(p)
+	return err
+}
 
+// AppendTo appends binary data of the AVP to b and returns the extended buffer.
+func (a AVP) AppendTo(b []byte) []byte {
 	var flags byte
 	if a.VendorID != 0 {
 		flags |= 128
@@ -82,135 +88,119 @@ func (a AVP) MarshalTo(w io.Writer) error {
 	if a.Reserved[4] {
 		flags |= 1
 	}
-	b.WriteByte(flags)
 
-	lng := uint32(8 + len(a.Data))
+	lng := 8 + len(a.Data)
 	if a.VendorID != 0 {
 		lng += 4
 	}
-	buf := new(bytes.Buffer)
-	binary.Write(buf, binary.BigEndian, lng)
-	b.Write(buf.Bytes()[1:4])
-
+	b = binary.BigEndian.AppendUint32(b, a.Code)
+	b = append(b, flags, byte(lng>>16), byte(lng>>8), byte(lng))
 	if a.VendorID != 0 {
-		binary.Write(&b, binary.BigEndian, a.VendorID)
+		b = binary.BigEndian.AppendUint32(b, a.VendorID)
 	}
-	b.Write(a.Data)
-
-	b.Write(make([]byte, (4-len(a.Data)%4)%4))
-
-	_, err := b.WriteTo(w)
-	return err
-}
-
-func (a *AVP) wrapedUnmarshalFrom(r io.Reader) error {
-	err := a.UnmarshalFrom(r)
-	if err != nil {
-		if _, ok := err.(InvalidAVP); !ok {
-			err = InvalidAVP{Code: InvalidAvpValue, AVP: *a, E: err}
-		}
+	b = append(b, a.Data...)
+	for i := len(a.Data) % 4; i != 0 && i < 4; i++ {
+		b = append(b, 0)
 	}
-	return err
+	return b
 }
 
 // UnmarshalFrom read binary data from io.Reader
 func (a *AVP) UnmarshalFrom(r io.Reader) error {
-	buf, err := readUntil(r, 8)
-	if err != nil {
+	var h [12]byte
+	if _, err := io.ReadFull(r, h[:8]); err != nil {
 		return err
 	}
-
-	binary.Read(bytes.NewBuffer(buf[0:4]), binary.BigEndian, &a.Code)
-
-	vid := buf[4]&128 == 128
-	a.Mandatory = buf[4]&64 == 64
-	a.Protected = buf[4]&32 == 32
-	a.Reserved[0] = buf[4]&16 == 16
-	a.Reserved[1] = buf[4]&8 == 8
-	a.Reserved[2] = buf[4]&4 == 4
-	a.Reserved[3] = buf[4]&2 == 2
-	a.Reserved[4] = buf[4]&1 == 1
-
-	buf[4] = 0x00
-	var lng uint32
-	binary.Read(bytes.NewBuffer(buf[4:8]), binary.BigEndian, &lng)
-	l := lng - 8
-
-	if vid {
-		if buf, err = readUntil(r, 4); err != nil {
+	hl := a.unmarshalHeader(h[:8])
+	lng := int(binary.BigEndian.Uint32(h[4:8]) & 0x00ffffff)
+	if lng < hl {
+		return InvalidAVP{Code: InvalidAvpLength, AVP: *a}
+	}
+	if hl == 12 {
+		if _, err := io.ReadFull(r, h[8:12]); err != nil {
 			return err
 		}
-		binary.Read(bytes.NewBuffer(buf), binary.BigEndian, &a.VendorID)
-		l -= 4
+		a.VendorID = binary.BigEndian.Uint32(h[8:12])
 	}
 
-	if a.Data, err = readUntil(r, int(l)); err != nil {
-		return err
-	}
-	if _, err = readUntil(r, (4-int(lng%4))%4); err != nil {
+	a.Data = make([]byte, lng-hl+(4-lng%4)%4)
+	if _, err := io.ReadFull(r, a.Data); err != nil {
 		return err
 	}
-	/*
-		if pbit {
-			return InvalidAVP{
-				Code: InvalidAvpBits, AVP: *a,
-				E: fmt.Errorf("p bit is not supported")}
-		}
-	*/
+	a.Data = a.Data[:lng-hl]
 	return nil
 }
 
+// unmarshalHeader reads code and flags of AVP header, and returns header length.
+func (a *AVP) unmarshalHeader(h []byte) int {
+	a.Code = binary.BigEndian.Uint32(h[0:4])
+	a.VendorID = 0
+	a.Mandatory = h[4]&64 == 64
+	a.Protected = h[4]&32 == 32
+	a.Reserved[0] = h[4]&16 == 16
+	a.Reserved[1] = h[4]&8 == 8
+	a.Reserved[2] = h[4]&4 == 4
+	a.Reserved[3] = h[4]&2 == 2
+	a.Reserved[4] = h[4]&1 == 1
+	if h[4]&128 == 128 {
+		return 12
+	}
+	return 8
+}
+
 // Encode make AVP from primitive go value
 func (a *AVP) Encode(d interface{}) (e error) {
-	buf := new(bytes.Buffer)
+	var b []byte
 
 	switch d := d.(type) {
 	case net.IP:
-		buf.WriteByte(0x00)
-		if d.To4() != nil {
-			d = d.To4()
-			buf.WriteByte(0x01)
-			for i := 0; i < 4; i++ {
-				buf.WriteByte(d[i])
-			}
-		} else if d.To16() != nil {
-			d = d.To16()
-			buf.WriteByte(0x02)
-			for i := 0; i < 16; i++ {
-				buf.WriteByte(d[i])
-			}
+		if v4 := d.To4(); v4 != nil {
+			b = append([]byte{0x00, 0x01}, v4...)
+		} else if v6 := d.To16(); v6 != nil {
+			b = append([]byte{0x00, 0x02}, v6...)
 		} else {
 			e = fmt.Errorf("invalid net.IP struct")
 		}
 	case time.Time:
-		e = binary.Write(buf, binary.BigEndian, int64(d.Unix()+2208988800))
+		b = binary.BigEndian.AppendUint64(nil, uint64(d.Unix()+2208988800))
 	case Identity:
-		buf.Write([]byte(d))
+		b = []byte(d)
 	case URI:
-		buf.Write([]byte(d.String()))
+		b = []byte(d.String())
 	case Enumerated:
-		e = binary.Write(buf, binary.BigEndian, int32(d))
+		b = binary.BigEndian.AppendUint32(nil, uint32(d))
 	case IPFilterRule:
-		buf.WriteString(string(d))
+		b = []byte(d)
 	case string:
-		buf.WriteString(d)
+		b = []byte(d)
 	case []AVP:
 		for _, avp := range d {
-			if e = avp.MarshalTo(buf); e != nil {
-				break
-			}
+			b = avp.AppendTo(b)
 		}
 	case []byte:
-		buf.Write(d)
-	case int32, int64, uint32, uint64, float32, float64:
-		e = binary.Write(buf, binary.BigEndian, d)
+		b = append([]byte{}, d...)
+	case int32:
+		b = binary.BigEndian.AppendUint32(nil, uint32(d))
+	case uint32:
+		b = binary.BigEndian.AppendUint32(nil, d)
+	case float32:
+		b = binary.BigEndian.AppendUint32(nil, math.Float32bits(d))
+	case int64:
+		b = binary.BigEndian.AppendUint64(nil, uint64(d))
+	case uint64:
+		b = binary.BigEndian.AppendUint64(nil, d)
+	case float64:
+		b = binary.BigEndian.AppendUint64(nil, math.Float64bits(d))
 	case nil:
 	default:
 		e = fmt.Errorf("unacceptable type value for AVP")
 	}
 
 	if e == nil {
-		a.Data = buf.Bytes()
+		if len(b) == 0 {
+			b = nil
+		}
+		a.Data = b
 	}
 	return
 }
@@ -234,7 +224,7 @@ func (a AVP) Decode(d interface{}) (e error) {
 
 	switch d := d.(type) {
 	case *net.IP:
-		if a.Data[0] != 0x00 || a.Data[1] < 0x01 || a.Data[1] > 0x02 {
+		if len(a.Data) < 2 || a.Data[0] != 0x00 || a.Data[1] < 0x01 || a.Data[1] > 0x02 {
 			e = fmt.Errorf("invalid address family")
 		} else if len(a.Data) == 6 && a.Data[1] == 0x01 {
 			*d = net.IP(a.Data[2:6])
@@ -247,11 +237,8 @@ func (a AVP) Decode(d interface{}) (e error) {
 		if len(a.Data) != 8 {
 			e = io.EOF
 		} else {
-			buf := bytes.NewReader(a.Data)
-			var t uint64
-			if e = binary.Read(buf, binary.BigEndian, &t); e == nil {
-				*d = time.Unix(int64(t-2208988800), int64(0))
-			}
+			t := binary.BigEndian.Uint64(a.Data)
+			*d = time.Unix(int64(t-2208988800), int64(0))
 		}
 	case *Identity:
 		*d, e = ParseIdentity(string(a.Data))
@@ -261,11 +248,7 @@ func (a AVP) Decode(d interface{}) (e error) {
 		if len(a.Data) != 4 {
 			e = io.EOF
 		} else {
-			buf := bytes.NewReader(a.Data)
-			var t Enumerated
-			if e = binary.Read(buf, binary.BigEndian, &t); e == nil {
-				*d = t
-			}
+			*d = Enumerated(binary.BigEndian.Uint32(a.Data))
 		}
 	case *IPFilterRule:
 		*d = IPFilterRule(a.Data)
@@ -273,13 +256,11 @@ func (a AVP) Decode(d interface{}) (e error) {
 		*d = string(a.Data)
 	case *[]AVP:
 		*d = make([]AVP, 0)
-		for buf := bytes.NewReader(a.Data); buf.Len() != 0; {
-			avp := AVP{}
-			if e = avp.UnmarshalFrom(buf); e != nil {
-				break
-			}
-			*d = append(*d, avp)
+		it := NewAVPIterator(a.Data)
+		for it.Next() {
+			*d = append(*d, it.AVP())
 		}
+		e = it.Err()
 	case *[]byte:
 		b := make([]byte, len(a.Data))
 		copy(b, a.Data)
@@ -287,16 +268,30 @@ func (a AVP) Decode(d interface{}) (e error) {
 	case *int32, *uint32, *float32:
 		if len(a.Data) != 4 {
 			e = io.EOF
-		} else {
-			buf := bytes.NewReader(a.Data)
-			e = binary.Read(buf, binary.BigEndian, d)
+			break
+		}
+		v := binary.BigEndian.Uint32(a.Data)
+		switch d := d.(type) {
+		case *int32:
+			*d = int32(v)
+		case *uint32:
+			*d = v
+		case *float32:
+			*d = math.Float32frombits(v)
 		}
 	case *int64, *uint64, *float64:
 		if len(a.Data) != 8 {
 			e = io.EOF
-		} else {
-			buf := bytes.NewReader(a.Data)
-			e = binary.Read(buf, binary.BigEndian, d)
+			break
+		}
+		v := binary.BigEndian.Uint64(a.Data)
+		switch d := d.(type) {
+		case *int64:
+			*d = int64(v)
+		case *uint64:
+			*d = v
+		case *float64:
+			*d = math.Float64frombits(v)
 		}
 	default:
 		e = fmt.Errorf("unacceptable type value for AVP")
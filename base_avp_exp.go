@@ -1,6 +1,9 @@
 package diameter
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // SetAuthAppID make Auth-Application-Id AVP
 func SetAuthAppID(v uint32) (a AVP) {
@@ -71,6 +74,76 @@ func GetVendorSpecAppID(a AVP) (vi, ai uint32, e error) {
 	return
 }
 
+// SetAcctAppID make Acct-Application-Id AVP
+func SetAcctAppID(v uint32) (a AVP) {
+	a = AVP{Code: 259, Mandatory: true}
+	a.Encode(v)
+	return
+}
+
+// GetAcctAppID read Acct-Application-Id AVP
+func GetAcctAppID(a AVP) (v uint32, e error) {
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else {
+		e = a.wrapedDecode(&v)
+	}
+	return
+}
+
+// SetVendorSpecAcctAppID make Vendor-Specific-Application-Id AVP with Acct-Application-Id
+func SetVendorSpecAcctAppID(vi, ai uint32) (a AVP) {
+	a = AVP{Code: 260, Mandatory: true}
+	a.Encode([]AVP{SetVendorID(vi), SetAcctAppID(ai)})
+	return
+}
+
+// GetVendorSpecAcctAppID read Vendor-Specific-Application-Id AVP with Acct-Application-Id
+func GetVendorSpecAcctAppID(a AVP) (vi, ai uint32, e error) {
+	var acct bool
+	if vi, ai, acct, e = getVendorSpecApp(a); e == nil && !acct {
+		e = fmt.Errorf("AVP 259 not found")
+		e = InvalidAVP{Code: MissingAvp, AVP: a, E: e}
+	}
+	return
+}
+
+// getVendorSpecApp read Vendor-Specific-Application-Id AVP
+// with either Auth-Application-Id or Acct-Application-Id.
+func getVendorSpecApp(a AVP) (vi, ai uint32, acct bool, e error) {
+	o := []AVP{}
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else {
+		e = a.wrapedDecode(&o)
+	}
+	var auth bool
+	for _, a := range o {
+		if e != nil {
+			break
+		}
+		if a.VendorID != 0 {
+			continue
+		}
+		switch a.Code {
+		case 266:
+			vi, e = GetVendorID(a)
+		case 258:
+			ai, e = GetAuthAppID(a)
+			auth = true
+		case 259:
+			ai, e = GetAcctAppID(a)
+			acct = true
+		}
+	}
+	if e == nil && auth && acct {
+		e = InvalidAVP{Code: ContradictingAvps, AVP: a}
+	} else if e == nil && (vi == 0 || !(auth || acct)) {
+		e = InvalidAVP{Code: MissingAvp, AVP: a}
+	}
+	return
+}
+
 // SetSessionID make Session-ID AVP
 func SetSessionID(v string) (a AVP) {
 	a = AVP{Code: 263, Mandatory: true}
@@ -145,61 +218,79 @@ const (
 	NoCommonSecurity      uint32 = 5017 // NoCommonSecurity is Result-Code 5017
 )
 
-// SetResultCode make Result-Code AVP
+// SetResultCode make Result-Code AVP.
+// Value larger than 10000 is Experimental-Result of Vendor-Id (c / 10000).
 func SetResultCode(c uint32) (a AVP) {
 	if c < 10000 {
 		a = AVP{Code: 268, Mandatory: true}
 		a.Encode(c)
 		return
 	}
-	a = AVP{Code: 297, Mandatory: true}
-	v := []AVP{{Code: 266, Mandatory: true}, {Code: 298, Mandatory: true}}
-	v[0].Encode(c / 10000)
-	v[1].Encode(c % 10000)
-	a.Encode(v)
-	return
+	return SetExperimentalResult(c/10000, c%10000)
 }
 
-// GetResultCode read Result-Code AVP
+// GetResultCode read Result-Code AVP.
+// Experimental-Result is read as Vendor-Id * 10000 + Experimental-Result-Code.
 func GetResultCode(a AVP) (c uint32, e error) {
 	if a.VendorID != 0 || !a.Mandatory {
 		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
 	} else if a.Code == 268 {
 		e = a.wrapedDecode(&c)
 	} else if a.Code == 297 {
-		o := []AVP{}
-		if e = a.wrapedDecode(&o); e == nil {
-			for _, a := range o {
-				switch a.Code {
-				case 266:
-					if a.VendorID != 0 || !a.Mandatory {
-						e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
-					} else {
-						var i uint32
-						e = a.Decode(&i)
-						c += i * 10000
-					}
-				case 298:
-					if a.VendorID != 0 || !a.Mandatory {
-						e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
-					} else {
-						var r uint32
-						e = a.Decode(&r)
-						c += r
-					}
-				}
-				if e != nil {
-					break
-				}
+		var v uint32
+		if v, c, e = GetExperimentalResult(a); e == nil {
+			c += v * 10000
+		}
+	}
+	return
+}
+
+// SetExperimentalResult make Experimental-Result AVP
+func SetExperimentalResult(venID, code uint32) (a AVP) {
+	a = AVP{Code: 297, Mandatory: true}
+	v := []AVP{{Code: 266, Mandatory: true}, {Code: 298, Mandatory: true}}
+	v[0].Encode(venID)
+	v[1].Encode(code)
+	a.Encode(v)
+	return
+}
+
+// GetExperimentalResult read Experimental-Result AVP
+func GetExperimentalResult(a AVP) (venID, code uint32, e error) {
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+		return
+	}
+	o := []AVP{}
+	if e = a.wrapedDecode(&o); e != nil {
+		return
+	}
+	var hasVen, hasCode bool
+	for _, a := range o {
+		switch a.Code {
+		case 266:
+			if a.VendorID != 0 || !a.Mandatory {
+				e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+			} else {
+				e = a.wrapedDecode(&venID)
+				hasVen = true
 			}
-			if e == nil && c < 10000 {
-				e = fmt.Errorf("AVP 266 not found")
-				e = InvalidAVP{Code: MissingAvp, AVP: a, E: e}
-			} else if e == nil && c%10000 == 0 {
-				e = fmt.Errorf("AVP 298 not found")
-				e = InvalidAVP{Code: MissingAvp, AVP: a, E: e}
+		case 298:
+			if a.VendorID != 0 || !a.Mandatory {
+				e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+			} else {
+				e = a.wrapedDecode(&code)
+				hasCode = true
 			}
 		}
+		if e != nil {
+			return
+		}
+	}
+	if !hasVen {
+		e = InvalidAVP{Code: MissingAvp, AVP: a, E: fmt.Errorf("AVP 266 not found")}
+	} else if !hasCode {
+		e = InvalidAVP{Code: MissingAvp, AVP: a, E: fmt.Errorf("AVP 298 not found")}
 	}
 	return
 }
@@ -337,6 +428,23 @@ func GetErrorMessage(a AVP) (v string, e error) {
 	return
 }
 
+// SetErrorReportingHost make Error-Reporting-Host AVP
+func SetErrorReportingHost(v Identity) (a AVP) {
+	a = AVP{Code: 294}
+	a.Encode(v)
+	return
+}
+
+// GetErrorReportingHost read Error-Reporting-Host AVP
+func GetErrorReportingHost(a AVP) (v Identity, e error) {
+	if a.VendorID != 0 || a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else {
+		e = a.wrapedDecode(&v)
+	}
+	return
+}
+
 // SetUserName make User-Name AVP
 func SetUserName(v string) (a AVP) {
 	a = AVP{Code: 1, Mandatory: true}
@@ -354,99 +462,264 @@ func GetUserName(a AVP) (v string, e error) {
 	return
 }
 
-/*
-// ProxyHost AVP
-type ProxyHost diameter.Identity
+// SetProxyInfo make Proxy-Info AVP
+func SetProxyInfo(host Identity, state []byte) (a AVP) {
+	h := AVP{Code: 280, Mandatory: true}
+	h.Encode(host)
+	st := AVP{Code: 33, Mandatory: true}
+	st.Encode(state)
 
-// ToRaw return AVP struct of this value
-func (v *ProxyHost) ToRaw() diameter.AVP {
-	a := diameter.AVP{Code: 280, VenID: 0,
-		FlgV: false, Mandatory: true, FlgP: false}
-	if v != nil {
-		a.Encode(diameter.Identity(*v))
+	a = AVP{Code: 284, Mandatory: true}
+	a.Encode([]AVP{h, st})
+	return
+}
+
+// GetProxyInfo read Proxy-Info AVP
+func GetProxyInfo(a AVP) (host Identity, state []byte, e error) {
+	o := []AVP{}
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else {
+		e = a.wrapedDecode(&o)
 	}
-	return a
+	for _, a := range o {
+		if a.VendorID != 0 {
+			continue
+		}
+		switch a.Code {
+		case 280:
+			if !a.Mandatory {
+				e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+			} else {
+				e = a.wrapedDecode(&host)
+			}
+		case 33:
+			if !a.Mandatory {
+				e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+			} else {
+				state = a.Data
+			}
+		}
+		if e != nil {
+			break
+		}
+	}
+	if e == nil && (len(host) == 0 || state == nil) {
+		e = InvalidAVP{Code: MissingAvp, AVP: a}
+	}
+	return
 }
 
-// FromRaw get AVP value
-func (v *ProxyHost) FromRaw(a diameter.AVP) (e error) {
-	if e = a.Validate(0, 280, false, true, false); e != nil {
-		return
+// SetRedirectHost make Redirect-Host AVP
+func SetRedirectHost(v URI) (a AVP) {
+	a = AVP{Code: 292, Mandatory: true}
+	a.Encode(v)
+	return
+}
+
+// GetRedirectHost read Redirect-Host AVP
+func GetRedirectHost(a AVP) (v URI, e error) {
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else {
+		e = a.wrapedDecode(&v)
 	}
-	s := new(diameter.Identity)
-	if e = a.Decode(s); e != nil {
-		return
+	return
+}
+
+const (
+	// DontCache is Redirect-Host-Usage value 0
+	DontCache Enumerated = 0
+	// AllSession is Redirect-Host-Usage value 1
+	AllSession Enumerated = 1
+	// AllRealm is Redirect-Host-Usage value 2
+	AllRealm Enumerated = 2
+	// RealmAndApplication is Redirect-Host-Usage value 3
+	RealmAndApplication Enumerated = 3
+	// AllApplication is Redirect-Host-Usage value 4
+	AllApplication Enumerated = 4
+	// AllHost is Redirect-Host-Usage value 5
+	AllHost Enumerated = 5
+	// AllUser is Redirect-Host-Usage value 6
+	AllUser Enumerated = 6
+)
+
+// SetRedirectHostUsage make Redirect-Host-Usage AVP
+func SetRedirectHostUsage(v Enumerated) (a AVP) {
+	a = AVP{Code: 261, Mandatory: true}
+	a.Encode(v)
+	return
+}
+
+// GetRedirectHostUsage read Redirect-Host-Usage AVP
+func GetRedirectHostUsage(a AVP) (v Enumerated, e error) {
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else if e = a.wrapedDecode(&v); e == nil && (v < DontCache || v > AllUser) {
+		e = InvalidAVP{Code: InvalidAvpValue, AVP: a}
 	}
-	*v = ProxyHost(*s)
 	return
 }
 
-// ProxyState AVP
-type ProxyState []byte
+// SetRedirectMaxCacheTime make Redirect-Max-Cache-Time AVP
+func SetRedirectMaxCacheTime(v time.Duration) (a AVP) {
+	a = AVP{Code: 262, Mandatory: true}
+	a.Encode(uint32(v / time.Second))
+	return
+}
 
-// ToRaw return AVP struct of this value
-func (v *ProxyState) ToRaw() diameter.AVP {
-	a := diameter.AVP{Code: 33, VenID: 0,
-		FlgV: false, Mandatory: true, FlgP: false}
-	if v != nil {
-		a.Encode([]byte(*v))
+// GetRedirectMaxCacheTime read Redirect-Max-Cache-Time AVP
+func GetRedirectMaxCacheTime(a AVP) (v time.Duration, e error) {
+	var s uint32
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else if e = a.wrapedDecode(&s); e == nil {
+		v = time.Duration(s) * time.Second
 	}
-	return a
+	return
 }
 
-// FromRaw get AVP value
-func (v *ProxyState) FromRaw(a diameter.AVP) (e error) {
-	if e = a.Validate(0, 33, false, true, false); e != nil {
-		return
+const (
+	EventRecord   Enumerated = 1 // EventRecord is Accounting-Record-Type EVENT_RECORD
+	StartRecord   Enumerated = 2 // StartRecord is Accounting-Record-Type START_RECORD
+	InterimRecord Enumerated = 3 // InterimRecord is Accounting-Record-Type INTERIM_RECORD
+	StopRecord    Enumerated = 4 // StopRecord is Accounting-Record-Type STOP_RECORD
+)
+
+// SetAcctRecordType make Accounting-Record-Type AVP
+func SetAcctRecordType(v Enumerated) (a AVP) {
+	a = AVP{Code: 480, Mandatory: true}
+	a.Encode(v)
+	return
+}
+
+// GetAcctRecordType read Accounting-Record-Type AVP
+func GetAcctRecordType(a AVP) (v Enumerated, e error) {
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else if e = a.wrapedDecode(&v); e == nil && (v < EventRecord || v > StopRecord) {
+		e = InvalidAVP{Code: InvalidAvpValue, AVP: a}
 	}
-	s := new([]byte)
-	if e = a.Decode(s); e != nil {
-		return
+	return
+}
+
+// SetAcctRecordNumber make Accounting-Record-Number AVP
+func SetAcctRecordNumber(v uint32) (a AVP) {
+	a = AVP{Code: 485, Mandatory: true}
+	a.Encode(v)
+	return
+}
+
+// GetAcctRecordNumber read Accounting-Record-Number AVP
+func GetAcctRecordNumber(a AVP) (v uint32, e error) {
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else {
+		e = a.wrapedDecode(&v)
 	}
-	*v = ProxyState(*s)
 	return
 }
 
-// ProxyInfo AVP
-type ProxyInfo struct {
-	ProxyHost
-	ProxyState
+// SetSessionTimeout make Session-Timeout AVP
+func SetSessionTimeout(v time.Duration) (a AVP) {
+	a = AVP{Code: 27, Mandatory: true}
+	a.Encode(uint32(v / time.Second))
+	return
 }
 
-// ToRaw return AVP struct of this value
-func (v *ProxyInfo) ToRaw() diameter.AVP {
-	a := diameter.AVP{Code: 284, VenID: 0,
-		FlgV: false, Mandatory: true, FlgP: false}
-	if v != nil {
-		t := []diameter.AVP{
-			v.ProxyHost.ToRaw(),
-			v.ProxyState.ToRaw()}
-		a.Encode(diameter.GroupedAVP(t))
+// GetSessionTimeout read Session-Timeout AVP
+func GetSessionTimeout(a AVP) (v time.Duration, e error) {
+	var s uint32
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else if e = a.wrapedDecode(&s); e == nil {
+		v = time.Duration(s) * time.Second
 	}
-	return a
+	return
 }
 
-// FromRaw get AVP value
-func (v *ProxyInfo) FromRaw(a diameter.AVP) (e error) {
-	if e = a.Validate(0, 284, false, true, false); e != nil {
-		return
+// SetAuthLifetime make Authorization-Lifetime AVP
+func SetAuthLifetime(v time.Duration) (a AVP) {
+	a = AVP{Code: 291, Mandatory: true}
+	a.Encode(uint32(v / time.Second))
+	return
+}
+
+// GetAuthLifetime read Authorization-Lifetime AVP
+func GetAuthLifetime(a AVP) (v time.Duration, e error) {
+	var s uint32
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else if e = a.wrapedDecode(&s); e == nil {
+		v = time.Duration(s) * time.Second
 	}
-	o := diameter.GroupedAVP{}
-	if e = a.Decode(&o); e != nil {
-		return
+	return
+}
+
+// SetAuthGracePeriod make Auth-Grace-Period AVP
+func SetAuthGracePeriod(v time.Duration) (a AVP) {
+	a = AVP{Code: 276, Mandatory: true}
+	a.Encode(uint32(v / time.Second))
+	return
+}
+
+// GetAuthGracePeriod read Auth-Grace-Period AVP
+func GetAuthGracePeriod(a AVP) (v time.Duration, e error) {
+	var s uint32
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else if e = a.wrapedDecode(&s); e == nil {
+		v = time.Duration(s) * time.Second
 	}
-	*v = ProxyInfo{}
-	for _, a := range o {
-		if a.VenID != 0 {
-			continue
-		}
-		switch a.Code {
-		case 280:
-			e = v.ProxyHost.FromRaw(a)
-		case 33:
-			e = v.ProxyState.FromRaw(a)
-		}
+	return
+}
+
+const (
+	Logout             Enumerated = 1 // Logout is Termination-Cause DIAMETER_LOGOUT
+	ServiceNotProvided Enumerated = 2 // ServiceNotProvided is Termination-Cause DIAMETER_SERVICE_NOT_PROVIDED
+	BadAnswer          Enumerated = 3 // BadAnswer is Termination-Cause DIAMETER_BAD_ANSWER
+	Administrative     Enumerated = 4 // Administrative is Termination-Cause DIAMETER_ADMINISTRATIVE
+	LinkBroken         Enumerated = 5 // LinkBroken is Termination-Cause DIAMETER_LINK_BROKEN
+	AuthExpired        Enumerated = 6 // AuthExpired is Termination-Cause DIAMETER_AUTH_EXPIRED
+	UserMoved          Enumerated = 7 // UserMoved is Termination-Cause DIAMETER_USER_MOVED
+	SessionTimeout     Enumerated = 8 // SessionTimeout is Termination-Cause DIAMETER_SESSION_TIMEOUT
+)
+
+// SetTerminationCause make Termination-Cause AVP
+func SetTerminationCause(v Enumerated) (a AVP) {
+	a = AVP{Code: 295, Mandatory: true}
+	a.Encode(v)
+	return
+}
+
+// GetTerminationCause read Termination-Cause AVP
+func GetTerminationCause(a AVP) (v Enumerated, e error) {
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else {
+		e = a.wrapedDecode(&v)
+	}
+	return
+}
+
+const (
+	AuthorizeOnly         Enumerated = 0 // AuthorizeOnly is Re-Auth-Request-Type AUTHORIZE_ONLY
+	AuthorizeAuthenticate Enumerated = 1 // AuthorizeAuthenticate is Re-Auth-Request-Type AUTHORIZE_AUTHENTICATE
+)
+
+// SetReAuthRequestType make Re-Auth-Request-Type AVP
+func SetReAuthRequestType(v Enumerated) (a AVP) {
+	a = AVP{Code: 285, Mandatory: true}
+	a.Encode(v)
+	return
+}
+
+// GetReAuthRequestType read Re-Auth-Request-Type AVP
+func GetReAuthRequestType(a AVP) (v Enumerated, e error) {
+	if a.VendorID != 0 || !a.Mandatory {
+		e = InvalidAVP{Code: InvalidAvpBits, AVP: a}
+	} else if e = a.wrapedDecode(&v); e == nil && v != AuthorizeOnly && v != AuthorizeAuthenticate {
+		e = InvalidAVP{Code: InvalidAvpValue, AVP: a}
 	}
 	return
 }
-*/
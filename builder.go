@@ -0,0 +1,271 @@
+package diameter
+
+import (
+	"bytes"
+)
+
+/*
+Builder makes Diameter message with chained method call.
+
+	m := diameter.NewRequest(316, 16777251).
+		SessionID(n.NextSession()).
+		Origin(n.Host, n.Realm).
+		Destination("", "epc.example.com").
+		Add(diameter.SetAuthSessionState(false)).
+		Message()
+*/
+type Builder struct {
+	m   Message
+	buf bytes.Buffer
+}
+
+// NewRequest returns Builder of proxiable request message with new Hop-by-Hop ID and End-to-End ID.
+func NewRequest(code, appID uint32) *Builder {
+	return &Builder{m: Message{
+		FlgR: true, FlgP: true, FlgE: false, FlgT: false,
+		Code: code, AppID: appID,
+		HbHID: nextHbH(), EtEID: nextEtE()}}
+}
+
+// NewAnswer returns Builder of answer message for the request.
+func NewAnswer(req Message) *Builder {
+	return &Builder{m: Message{
+		FlgR: false, FlgP: req.FlgP, FlgE: false, FlgT: false,
+		Code: req.Code, AppID: req.AppID,
+		HbHID: req.HbHID, EtEID: req.EtEID,
+		local: req.local}}
+}
+
+// Proxiable sets P flag of the message.
+func (b *Builder) Proxiable(f bool) *Builder {
+	b.m.FlgP = f
+	return b
+}
+
+// Error sets E flag of the message.
+func (b *Builder) Error(f bool) *Builder {
+	b.m.FlgE = f
+	return b
+}
+
+// Retransmit sets T flag of the message.
+func (b *Builder) Retransmit(f bool) *Builder {
+	b.m.FlgT = f
+	return b
+}
+
+// Add appends AVPs to the message.
+func (b *Builder) Add(avp ...AVP) *Builder {
+	for _, a := range avp {
+		a.MarshalTo(&b.buf)
+	}
+	return b
+}
+
+// Group appends Grouped AVP that contains the AVPs.
+func (b *Builder) Group(code, venID uint32, mandatory bool, avp ...AVP) *Builder {
+	a := AVP{Code: code, VendorID: venID, Mandatory: mandatory}
+	a.Encode(avp)
+	return b.Add(a)
+}
+
+// SessionID appends Session-Id AVP.
+func (b *Builder) SessionID(v string) *Builder {
+	return b.Add(SetSessionID(v))
+}
+
+// Origin appends Origin-Host and Origin-Realm AVP.
+func (b *Builder) Origin(host, realm Identity) *Builder {
+	return b.Add(SetOriginHost(host), SetOriginRealm(realm))
+}
+
+// Destination appends Destination-Host and Destination-Realm AVP.
+// Empty value is not appended.
+func (b *Builder) Destination(host, realm Identity) *Builder {
+	if host != "" {
+		b.Add(SetDestinationHost(host))
+	}
+	if realm != "" {
+		b.Add(SetDestinationRealm(realm))
+	}
+	return b
+}
+
+// ResultCode appends Result-Code AVP.
+func (b *Builder) ResultCode(v uint32) *Builder {
+	return b.Add(SetResultCode(v))
+}
+
+// ExperimentalResult appends Experimental-Result AVP.
+func (b *Builder) ExperimentalResult(venID, code uint32) *Builder {
+	return b.Add(SetExperimentalResult(venID, code))
+}
+
+// Message returns built message.
+func (b *Builder) Message() Message {
+	m := b.m
+	m.AVPs = append([]byte{}, b.buf.Bytes()...)
+	return m
+}
+
+// avpCache is parsed AVPs of Message.
+type avpCache struct {
+	src  []byte
+	avps []AVP
+	err  error
+}
+
+// avpList returns parsed AVPs of the message.
+// Parsed value is cached until AVPs of the message is replaced.
+func (m *Message) avpList() ([]AVP, error) {
+	if c := m.parsed; c != nil && len(c.src) == len(m.AVPs) &&
+		(len(m.AVPs) == 0 || &c.src[0] == &m.AVPs[0]) {
+		return c.avps, c.err
+	}
+	avps, err := m.GetAVP()
+	m.parsed = &avpCache{src: m.AVPs, avps: avps, err: err}
+	return avps, err
+}
+
+// FindAVP returns first AVP with the code and Vendor-ID.
+func (m *Message) FindAVP(code, venID uint32) (AVP, bool) {
+	avps, _ := m.avpList()
+	for _, a := range avps {
+		if a.Code == code && a.VendorID == venID {
+			return a, true
+		}
+	}
+	return AVP{}, false
+}
+
+// FindAllAVP returns all AVPs with the code and Vendor-ID.
+func (m *Message) FindAllAVP(code, venID uint32) []AVP {
+	avps, _ := m.avpList()
+	return filterAVP(avps, code, venID)
+}
+
+// AVPKey is code and Vendor-ID of AVP.
+type AVPKey struct {
+	Code     uint32
+	VendorID uint32
+}
+
+/*
+LookupAVP returns all AVPs with the path of code and Vendor-ID.
+Grouped AVPs in the path are decoded to find next AVP in the path.
+
+	m.LookupAVP(diameter.AVPKey{Code: 297}, diameter.AVPKey{Code: 298})
+*/
+func (m *Message) LookupAVP(path ...AVPKey) []AVP {
+	if len(path) == 0 {
+		return nil
+	}
+	avps, _ := m.avpList()
+	avps = filterAVP(avps, path[0].Code, path[0].VendorID)
+	for _, k := range path[1:] {
+		var next []AVP
+		for _, a := range avps {
+			var sub []AVP
+			if a.Decode(&sub) == nil {
+				next = append(next, filterAVP(sub, k.Code, k.VendorID)...)
+			}
+		}
+		avps = next
+	}
+	return avps
+}
+
+func filterAVP(avps []AVP, code, venID uint32) []AVP {
+	var r []AVP
+	for _, a := range avps {
+		if a.Code == code && a.VendorID == venID {
+			r = append(r, a)
+		}
+	}
+	return r
+}
+
+// ResultCode returns value of Result-Code AVP.
+func (m *Message) ResultCode() (uint32, bool) {
+	a, ok := m.FindAVP(268, 0)
+	if !ok {
+		return 0, false
+	}
+	v, e := GetResultCode(a)
+	return v, e == nil
+}
+
+// ExperimentalResult returns Vendor-Id and Experimental-Result-Code of Experimental-Result AVP.
+func (m *Message) ExperimentalResult() (uint32, uint32, bool) {
+	a, ok := m.FindAVP(297, 0)
+	if !ok {
+		return 0, 0, false
+	}
+	v, c, e := GetExperimentalResult(a)
+	return v, c, e == nil
+}
+
+// Result returns Vendor-Id and code of Result-Code or Experimental-Result AVP.
+// Vendor-Id is 0 for Result-Code.
+func (m *Message) Result() (uint32, uint32, bool) {
+	avps, _ := m.avpList()
+	return ResultOf(avps)
+}
+
+// ResultOf returns Vendor-Id and code of Result-Code or Experimental-Result AVP in the AVPs.
+// Vendor-Id is 0 for Result-Code.
+func ResultOf(avps []AVP) (venID, code uint32, ok bool) {
+	for _, a := range avps {
+		if a.VendorID != 0 {
+			continue
+		}
+		var e error
+		switch a.Code {
+		case 268:
+			code, e = GetResultCode(a)
+		case 297:
+			venID, code, e = GetExperimentalResult(a)
+		default:
+			continue
+		}
+		return venID, code, e == nil
+	}
+	return 0, 0, false
+}
+
+/*
+FailureOf returns FailureAnswer when Result-Code or Experimental-Result in the answer AVPs is not success.
+Error-Message and Failed-AVP are set to the FailureAnswer,
+and Host is Error-Reporting-Host or Origin-Host of the answer.
+nil is returned for success answer.
+*/
+func FailureOf(avps []AVP) error {
+	venID, code, ok := ResultOf(avps)
+	if !ok {
+		return InvalidAVP{Code: MissingAvp, AVP: SetResultCode(0)}
+	}
+	if code/1000 == 2 {
+		return nil
+	}
+	err := FailureAnswer{Code: code, VenID: venID}
+	var origin Identity
+	for _, a := range avps {
+		if a.VendorID != 0 {
+			continue
+		}
+		switch a.Code {
+		case 281:
+			err.ErrMsg, _ = GetErrorMessage(a)
+		case 279:
+			err.Avps, _ = GetFailedAVP(a)
+		case 294:
+			err.Host, _ = GetErrorReportingHost(a)
+		case 264:
+			origin, _ = GetOriginHost(a)
+		}
+	}
+	if err.Host == "" {
+		err.Host = origin
+	}
+	return err
+}
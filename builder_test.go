@@ -0,0 +1,124 @@
+package diameter
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBuilderRoundTrip(t *testing.T) {
+	b := NewRequest(testCode, testAppID).
+		SessionID("a.local;1;1").
+		Origin("a.local", "local").
+		Destination("", "remote").
+		Add(SetRouteRecord("r1.local"), SetRouteRecord("r2.local")).
+		Group(284, 0, true,
+			SetGenericAVP(280, 0, Identity("p1.local")), SetGenericAVP(33, 0, []byte("s1"))).
+		Add(SetProxyInfo("p2.local", []byte("s2"))).
+		Retransmit(true)
+	m := b.Message()
+
+	buf := new(bytes.Buffer)
+	if err := m.MarshalTo(buf); err != nil {
+		t.Fatal(err)
+	}
+	var r Message
+	if err := r.UnmarshalFrom(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !r.FlgR || !r.FlgP || r.FlgE || !r.FlgT || r.Code != testCode || r.AppID != testAppID ||
+		r.HbHID != m.HbHID || r.EtEID != m.EtEID {
+		t.Errorf("header is not same: %+v", r)
+	}
+
+	avps, err := r.GetAVP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes := []uint32{263, 264, 296, 283, 282, 282, 284, 284}
+	if len(avps) != len(codes) {
+		t.Fatalf("%d AVPs are received, not %d", len(avps), len(codes))
+	}
+	for i, a := range avps {
+		if a.Code != codes[i] {
+			t.Errorf("AVP %d is %d, not %d", i, a.Code, codes[i])
+		}
+	}
+
+	// repeated AVP code
+	rr := r.FindAllAVP(282, 0)
+	if len(rr) != 2 {
+		t.Fatalf("%d Route-Record AVPs are found", len(rr))
+	}
+	for i, want := range []Identity{"r1.local", "r2.local"} {
+		if v, _ := GetRouteRecord(rr[i]); v != want {
+			t.Errorf("Route-Record %d is %s, not %s", i, v, want)
+		}
+	}
+	if _, ok := r.FindAVP(293, 0); ok {
+		t.Error("empty Destination-Host is added")
+	}
+
+	hosts := r.LookupAVP(AVPKey{Code: 284}, AVPKey{Code: 280})
+	if len(hosts) != 2 {
+		t.Fatalf("%d Proxy-Host AVPs are found", len(hosts))
+	}
+	for i, want := range []Identity{"p1.local", "p2.local"} {
+		var v Identity
+		if hosts[i].Decode(&v); v != want {
+			t.Errorf("Proxy-Host %d is %s, not %s", i, v, want)
+		}
+	}
+	if st := r.LookupAVP(AVPKey{Code: 284}, AVPKey{Code: 33}); len(st) != 2 ||
+		!bytes.Equal(st[1].Data, []byte("s2")) {
+		t.Errorf("Proxy-State is %v", st)
+	}
+	if v := r.LookupAVP(AVPKey{Code: 284}, AVPKey{Code: 280, VendorID: 10415}); len(v) != 0 {
+		t.Errorf("AVP of other vendor is found: %v", v)
+	}
+
+	// built message is not changed by later call of the builder
+	b.Add(SetRouteRecord("r3.local"))
+	if n := len(m.FindAllAVP(282, 0)); n != 2 {
+		t.Errorf("%d Route-Record AVPs are found in built message", n)
+	}
+}
+
+func TestLookupAVPCache(t *testing.T) {
+	m := NewRequest(testCode, testAppID).
+		SessionID("a.local;1;1").
+		ResultCode(Success).
+		Message()
+	if v, ok := m.ResultCode(); !ok || v != Success {
+		t.Fatalf("Result-Code is %d", v)
+	}
+
+	// cache is invalidated by SetAVP
+	m.SetAVP([]AVP{SetSessionID("a.local;1;1"), SetResultCode(UnableToComply)})
+	if v, ok := m.ResultCode(); !ok || v != UnableToComply {
+		t.Errorf("Result-Code after SetAVP is %d", v)
+	}
+	m.SetAVP([]AVP{SetSessionID("a.local;1;1"),
+		SetExperimentalResult(10415, UnknownSessionID)})
+	if _, ok := m.ResultCode(); ok {
+		t.Error("removed Result-Code is found")
+	}
+	if v := m.LookupAVP(AVPKey{Code: 297}, AVPKey{Code: 298}); len(v) != 1 {
+		t.Errorf("%d Experimental-Result-Code AVPs are found", len(v))
+	}
+
+	// cache is invalidated by replacing AVPs with same length
+	r := NewRequest(testCode, testAppID).
+		SessionID("a.local;1;1").
+		ExperimentalResult(10415, Success).
+		Message()
+	m.AVPs = r.AVPs
+	if v, c, ok := m.ExperimentalResult(); !ok || v != 10415 || c != Success {
+		t.Errorf("Experimental-Result after replacing is %d/%d", v, c)
+	}
+
+	// invalid AVPs are not found
+	m.AVPs = []byte{0, 0, 1}
+	if v := m.LookupAVP(AVPKey{Code: 263}); len(v) != 0 {
+		t.Errorf("AVP is found in invalid data: %v", v)
+	}
+}
@@ -2,7 +2,10 @@ package diameter
 
 import (
 	"errors"
+	"fmt"
 	"net"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -19,6 +22,11 @@ var (
 
 // Connection of Diameter
 type Connection struct {
+	// Local diameter node, nil means default node.
+	// Package level parameters of default node are read when the connection is started.
+	Local *Node
+	node  atomic.Pointer[Node] // snapshot of default node for the connection
+
 	wdTimer *time.Timer // system message timer
 	wdCount int         // watchdog expired counter
 
@@ -28,52 +36,146 @@ type Connection struct {
 
 	conn   net.Conn        // Transport connection
 	notify chan stateEvent // state change notification queue
+	done   chan struct{}   // closed when state machine is stopped
 	state  conState        // current state
 
-	sndQueue map[uint32]chan Message // Sending Request message queue
-	rcvQueue chan Message            // Receiving Request message queue
+	wQueue  chan Message  // transport write queue
+	wDone   chan struct{} // closed when writer is stopped
+	wClosed bool          // write queue is closed
+	wErr    atomic.Value  // TransportTxError of writer
+	wLen    atomic.Int32  // count of messages in write queue, for reading from other goroutines
+
+	sndQueue  map[uint32]sndRequest        // Sending Request message queue
+	retxQueue map[chan Message]retxRequest // Requests retransmitted to alternate connection
+	rxPending atomic.Int32                 // Received Request message count in handling
 
 	commonApp map[uint32]application
+
+	lock   sync.RWMutex  // lock for status snapshot
+	status conStatus     // status snapshot for reading from other goroutines
+	opened chan struct{} // closed when the connection is opened, guarded by lock
+}
+
+// conStatus is snapshot of Connection that is updated by the state machine.
+type conStatus struct {
+	state   conState
+	host    Identity
+	realm   Identity
+	conn    net.Conn
+	apps    []uint32
+	anyApp  bool
+	txQueue int
+}
+
+func (c *Connection) publish() {
+	s := conStatus{
+		state:   c.state,
+		host:    c.Host,
+		realm:   c.Realm,
+		conn:    c.conn,
+		apps:    make([]uint32, 0, len(c.commonApp)),
+		anyApp:  len(c.commonApp) == 0,
+		txQueue: len(c.sndQueue)}
+	for k := range c.commonApp {
+		s.apps = append(s.apps, k)
+	}
+
+	c.lock.Lock()
+	c.status = s
+	if s.state == open && c.opened != nil {
+		select {
+		case <-c.opened:
+		default:
+			close(c.opened)
+		}
+	}
+	c.lock.Unlock()
+}
+
+func (c *Connection) snapshot() conStatus {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.status
 }
 
 func (c *Connection) DialAndServe(con net.Conn) (e error) {
-	if c.conn != nil || c.state != closed {
+	if c.snapshot().conn != nil {
 		return errors.New("reusing connection is not acceptable")
 	}
+	c.bind()
+	if p := c.local().LookupPeer(c.Host); c.Host != "" && p != nil && p.isOpen() {
+		return errors.New("connection with the peer is already open")
+	}
 	c.conn = con
 	return c.serve()
 }
 
 func (c *Connection) ListenAndServe(con net.Conn) (e error) {
-	if c.conn != nil || c.state != closed {
+	if c.snapshot().conn != nil {
 		return errors.New("reusing connection is not acceptable")
 	}
+	c.bind()
 	c.conn = con
 	c.state = waitCER
+	// transport connection is closed when CER is not received in WDInterval
+	_ = con.SetReadDeadline(time.Now().Add(c.local().WDInterval))
 	return c.serve()
 }
 
 func (c *Connection) serve() error {
 	c.notify = make(chan stateEvent, 16)
-	c.sndQueue = make(map[uint32]chan Message, 65535)
-	c.rcvQueue = make(chan Message, 1024)
+	c.done = make(chan struct{})
+	c.sndQueue = make(map[uint32]sndRequest, 65535)
 	c.commonApp = make(map[uint32]application)
+	c.startWriter()
+	c.publish()
+
+	if ec, ok := c.conn.(eventConn); ok {
+		ec.SetEventNotify(func(ev fmt.Stringer) {
+			n := c.local()
+			if n.TransportEventNotify == nil {
+				return
+			}
+			te, ok := ev.(TransportEvent)
+			if !ok {
+				te = unknownEvent{ev}
+			}
+			n.TransportEventNotify(c, te)
+		})
+	}
 
 	go func() {
 		// read transport socket
+		conn := c.conn
+		r := getReader(conn)
+		defer putReader(r)
 		for {
 			m := Message{}
-			if err := m.UnmarshalFrom(c.conn); err != nil {
+			if err := m.UnmarshalFrom(r); err != nil {
+				if e, ok := c.wErr.Load().(error); ok {
+					err = e
+				}
 				c.notify <- eventPeerDisc{reason: err}
 				break
 			}
-			m.PeerName = c.Host
-			m.PeerRealm = c.Realm
+			s := c.snapshot()
+			m.PeerName = s.host
+			m.PeerRealm = s.realm
+			m.local = c.local()
 
-			if m.AppID == 0 && m.Code == 257 && m.FlgR {
-				c.notify <- eventRcvCER{m}
-			} else if m.AppID == 0 && m.Code == 257 && !m.FlgR {
-				c.notify <- eventRcvCEA{m}
+			if m.AppID == 0 && m.Code == 257 {
+				// wait for TLS upgrade after CER/CEA
+				resume := make(chan net.Conn, 1)
+				if m.FlgR {
+					_ = conn.SetReadDeadline(time.Time{})
+					c.notify <- eventRcvCER{m: m, resume: resume}
+				} else {
+					c.notify <- eventRcvCEA{m: m, resume: resume}
+				}
+				if nc := <-resume; nc != conn {
+					conn = nc
+					r.Reset(conn)
+				}
 			} else if m.AppID == 0 && m.Code == 280 && m.FlgR {
 				c.notify <- eventRcvDWR{m}
 			} else if m.AppID == 0 && m.Code == 280 && !m.FlgR {
@@ -89,18 +191,15 @@ func (c *Connection) serve() error {
 			}
 		}
 	}()
-	go func() {
-		// handle Rx Diameter message
-		for req, ok := <-c.rcvQueue; ok; req, ok = <-c.rcvQueue {
-			handleMsg(req)
-		}
-	}()
 
-	if TraceEvent != nil {
-		TraceEvent(shutdown.String(), c.state.String(), eventInit{}.String(), nil)
+	if n := c.local(); n.TraceEvent != nil {
+		n.TraceEvent(shutdown.String(), c.state.String(), eventInit{}.String(), nil)
 	}
 
 	if c.state != waitCER {
+		if c.Host != "" {
+			c.local().setPeer(c.Host, c)
+		}
 		c.notify <- eventConnect{}
 	}
 
@@ -109,33 +208,56 @@ func (c *Connection) serve() error {
 		event := <-c.notify
 		old = c.state
 		err := event.exec(c)
-		if TraceEvent != nil {
-			TraceEvent(old.String(), c.state.String(), event.String(), err)
+		c.publish()
+
+		n := c.local()
+		if n.TraceEvent != nil {
+			n.TraceEvent(old.String(), c.state.String(), event.String(), err)
+		}
+		if old != c.state && c.state == open && n.ConnectionUpNotify != nil {
+			n.ConnectionUpNotify(c)
 		}
 
 		if _, ok := event.(eventPeerDisc); ok {
 			break
 		}
 	}
+	close(c.done)
+	c.closeWriter()
 
 	var e error
 	if old != closing {
 		e = errors.New("connection aborted")
 	}
 
-	if ConnectionDownNotify != nil {
-		ConnectionDownNotify(c, e)
+	n := c.local()
+	n.delPeer(c.Host, c)
+	if n.ConnectionDownNotify != nil {
+		n.ConnectionDownNotify(c, e)
 	}
 	return e
 }
 
+// post queues the event to state machine from other goroutine.
+// Output is false when the state machine is already stopped.
+func (c *Connection) post(ev stateEvent) bool {
+	select {
+	case c.notify <- ev:
+		return true
+	case <-c.done:
+		return false
+	}
+}
+
 // Close Diameter connection and stop state machine.
 func (c *Connection) Close(cause Enumerated) {
-	if c.state == open {
-		c.notify <- eventLock{}
-		for len(c.rcvQueue) != 0 || len(c.sndQueue) != 0 {
+	if c.snapshot().state == open {
+		if !c.post(eventLock{}) {
+			return
+		}
+		for c.rxPending.Load() != 0 || c.snapshot().txQueue != 0 {
 			time.Sleep(time.Millisecond * 100)
 		}
 	}
-	c.notify <- eventStop{cause}
+	c.post(eventStop{cause})
 }
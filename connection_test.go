@@ -0,0 +1,261 @@
+package diameter
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	testCode  uint32 = 272
+	testAppID uint32 = 4
+)
+
+// testPeers is pair of nodes that are connected with loopback TCP.
+type testPeers struct {
+	a, b   *Node
+	ca, cb *Connection
+	send   ContextHandler
+	done   chan error
+}
+
+// newTestPeers connects node a.local to node b.local.
+// h is handler of b, and default handler answers Success.
+// conf is called to configure nodes before connecting.
+func newTestPeers(t *testing.T, h Handler, conf ...func(a, b *Node)) *testPeers {
+	t.Helper()
+	p := &testPeers{
+		a:    NewNode("a.local", "local"),
+		b:    NewNode("b.local", "local"),
+		done: make(chan error, 2)}
+	t.Cleanup(func() {
+		p.a.Close()
+		p.b.Close()
+	})
+	p.a.WDInterval = time.Second * 5
+	p.b.WDInterval = time.Second * 5
+	for _, f := range conf {
+		f(p.a, p.b)
+	}
+	if h == nil {
+		h = func(_ bool, avp []AVP) (bool, []AVP) {
+			return false, []AVP{avp[0],
+				SetResultCode(Success),
+				SetOriginHost(p.b.Host), SetOriginRealm(p.b.Realm)}
+		}
+	}
+	p.b.HandleContext(testCode, testAppID, 0, h, nil)
+	p.ca = &Connection{Local: p.a, Host: p.b.Host, Realm: p.b.Realm}
+	p.cb = &Connection{Local: p.b}
+	p.send = p.a.HandleContext(testCode, testAppID, 0, nil,
+		func(Message) *Connection { return p.ca })
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		con, err := l.Accept()
+		if err != nil {
+			p.done <- err
+			return
+		}
+		p.done <- p.cb.ListenAndServe(con)
+	}()
+	con, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { p.done <- p.ca.DialAndServe(con) }()
+
+	waitState(t, p.ca, "open")
+	waitState(t, p.cb, "open")
+	return p
+}
+
+// connectNode connects node a to node b with loopback TCP,
+// and returns the connection of a after it is opened.
+func connectNode(t *testing.T, a, b *Node) *Connection {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		if con, err := l.Accept(); err == nil {
+			(&Connection{Local: b}).ListenAndServe(con)
+		}
+	}()
+	con, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &Connection{Local: a, Host: b.Host, Realm: b.Realm}
+	go c.DialAndServe(con)
+	t.Cleanup(func() { c.Close(Rebooting) })
+	waitState(t, c, "open")
+	return c
+}
+
+func waitState(t *testing.T, c *Connection, state string) {
+	t.Helper()
+	for i := 0; i < 500; i++ {
+		if c.State() == state {
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	t.Fatalf("connection is %s, not %s", c.State(), state)
+}
+
+// wait waits for both connections to be closed.
+func (p *testPeers) wait(t *testing.T) {
+	t.Helper()
+	for i := 0; i < 2; i++ {
+		select {
+		case <-p.done:
+		case <-time.After(time.Second * 10):
+			t.Fatal("connection is not closed")
+		}
+	}
+}
+
+func (p *testPeers) request() []AVP {
+	return []AVP{
+		SetSessionID(p.a.NextSession()),
+		SetOriginHost(p.a.Host), SetOriginRealm(p.a.Realm),
+		SetDestinationRealm(p.b.Realm)}
+}
+
+func TestConcurrentSendCloseStatus(t *testing.T) {
+	p := newTestPeers(t, nil)
+
+	stop := make(chan struct{})
+	var poll sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		poll.Add(1)
+		go func() {
+			defer poll.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				for _, c := range []*Connection{p.ca, p.cb} {
+					_ = c.State()
+					_ = c.TxQueue()
+					_ = c.RxQueue()
+					_ = c.WriteQueue()
+					_ = c.PeerHost()
+					_ = c.PeerRealm()
+					_ = c.LocalAddr()
+					_ = c.PeerAddr()
+					_ = c.AvailableApplications()
+				}
+				_ = p.a.Peers()
+			}
+		}()
+	}
+
+	var send sync.WaitGroup
+	var success atomic.Int32
+	for i := 0; i < 200; i++ {
+		send.Add(1)
+		go func() {
+			defer send.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			defer cancel()
+			if _, _, err := p.send(ctx, false, p.request()); err == nil {
+				success.Add(1)
+			}
+		}()
+	}
+	// close while other requests are still sent
+	for i := 0; i < 500 && success.Load() == 0; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	p.ca.Close(Rebooting)
+
+	send.Wait()
+	close(stop)
+	poll.Wait()
+	p.wait(t)
+
+	if success.Load() == 0 {
+		t.Error("no request is answered before close")
+	}
+	if s := p.ca.State(); s != "closed" {
+		t.Errorf("state is %s after close", s)
+	}
+}
+
+func TestSendContextCancel(t *testing.T) {
+	block := make(chan struct{})
+	p := newTestPeers(t, func(_ bool, avp []AVP) (bool, []AVP) {
+		<-block
+		return false, nil
+	})
+	defer close(block)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	_, _, err := p.send(ctx, false, p.request())
+	var ce CanceledRequest
+	if !errors.As(err, &ce) {
+		t.Fatalf("error is %v, not CanceledRequest", err)
+	}
+	// aborted request is removed from Tx queue by state machine
+	for i := 0; i < 100 && p.ca.TxQueue() != 0; i++ {
+		time.Sleep(time.Millisecond * 10)
+	}
+	if n := p.ca.TxQueue(); n != 0 {
+		t.Errorf("Tx queue has %d requests after cancel", n)
+	}
+
+	p.ca.Close(Rebooting)
+	p.wait(t)
+}
+
+func TestSendContextAfterPeerDisc(t *testing.T) {
+	p := newTestPeers(t, nil)
+	if con := p.cb.snapshot().conn; con != nil {
+		con.Close()
+	}
+	p.wait(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	start := time.Now()
+	_, err := p.ca.SendContext(ctx, Message{
+		FlgR: true, Code: testCode, AppID: testAppID,
+		HbHID: nextHbH(), EtEID: nextEtE()})
+	var re RejectTxMessage
+	if !errors.As(err, &re) {
+		t.Errorf("error is %v, not RejectTxMessage", err)
+	}
+	if time.Since(start) > time.Millisecond*500 {
+		t.Error("SendContext is blocked on closed connection")
+	}
+	p.ca.Close(Rebooting)
+}
+
+func TestAbortAfterAnswer(t *testing.T) {
+	c := &Connection{sndQueue: map[uint32]sndRequest{1: {ch: make(chan Message)}}}
+	if err := (eventAbortMsg{1}).exec(c); err != nil {
+		t.Errorf("abort of pending request is failed: %v", err)
+	}
+	if len(c.sndQueue) != 0 {
+		t.Error("aborted request is not removed")
+	}
+	// answer is received before abort
+	if err := (eventAbortMsg{2}).exec(c); err != nil {
+		t.Errorf("abort of answered request is failed: %v", err)
+	}
+}
@@ -1,15 +1,22 @@
 package connector
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/fkgi/diameter"
 	"github.com/fkgi/diameter/sctp"
 )
 
+// SCTPConfig is socket configuration for SCTP transport,
+// that is used by Dial, Listen and Accept.
+// Association setup by Dial times out in 10 seconds by default.
+var SCTPConfig = sctp.Config{Timeout: time.Second * 10}
+
 // Dial parse inputs and connect transport connection.
 // Inputs are string of local(la) and peer(pa) host information with format for ResolveIdentity.
 func Dial(la, pa string) (con net.Conn, host, realm diameter.Identity, err error) {
@@ -28,17 +35,37 @@ func Dial(la, pa string) (con net.Conn, host, realm diameter.Identity, err error
 		return
 	}
 
+	con, err = dial(scheme, host, lips, lport, pips, pport)
+	return
+}
+
+func dial(scheme string, host diameter.Identity,
+	lips []net.IP, lport int, pips []net.IP, pport int) (net.Conn, error) {
 	switch scheme {
 	case "sctp":
-		con, err = sctp.DialSCTP(
-			&sctp.SCTPAddr{IP: lips, Port: lport}, &sctp.SCTPAddr{IP: pips, Port: pport})
+		la := &sctp.SCTPAddr{IP: lips, Port: lport}
+		if len(lips) != 0 {
+		} else if pips[0].To4() != nil {
+			// wildcard address, local address is chosen by OS
+			la.IP = []net.IP{net.IPv4zero}
+		} else {
+			la.IP = []net.IP{net.IPv6unspecified}
+		}
+		return SCTPConfig.Dial(la, &sctp.SCTPAddr{IP: pips, Port: pport})
 	case "tcp", "":
-		con, err = net.DialTCP("tcp",
-			&net.TCPAddr{IP: lips[0], Port: lport}, &net.TCPAddr{IP: pips[0], Port: pport})
-	default:
-		err = errors.New("invalid transport scheme: " + scheme)
+		var la *net.TCPAddr
+		if len(lips) != 0 {
+			la = &net.TCPAddr{IP: lips[0], Port: lport}
+		}
+		return net.DialTCP("tcp", la, &net.TCPAddr{IP: pips[0], Port: pport})
+	case "tls", "aaas":
+		con, err := dial("tcp", host, lips, lport, pips, pport)
+		if err != nil {
+			return nil, err
+		}
+		return tlsClient(con, host)
 	}
-	return
+	return nil, errors.New("invalid transport scheme: " + scheme)
 }
 
 // Listen parse inputs and listen transport listener.
@@ -56,9 +83,15 @@ func Listen(la string) (l net.Listener, err error) {
 
 	switch scheme {
 	case "sctp":
-		l, err = sctp.ListenSCTP(&sctp.SCTPAddr{IP: ips, Port: port})
+		l, err = SCTPConfig.Listen(&sctp.SCTPAddr{IP: ips, Port: port})
 	case "tcp", "":
 		l, err = net.ListenTCP("tcp", &net.TCPAddr{IP: ips[0], Port: port})
+	case "tls", "aaas":
+		if TLSConfig == nil {
+			err = errors.New("TLS configuration is not defined")
+		} else if l, err = net.ListenTCP("tcp", &net.TCPAddr{IP: ips[0], Port: port}); err == nil {
+			l = tls.NewListener(l, TLSConfig)
+		}
 	default:
 		err = errors.New("invalid transport scheme: " + scheme)
 	}
@@ -87,9 +120,9 @@ func Accept(la, pa string) (con net.Conn, host, realm diameter.Identity, err err
 	var dst net.Addr
 	switch scheme {
 	case "sctp":
-		l, err = sctp.ListenSCTP(&sctp.SCTPAddr{IP: lips, Port: lport})
+		l, err = SCTPConfig.Listen(&sctp.SCTPAddr{IP: lips, Port: lport})
 		dst = &sctp.SCTPAddr{IP: pips, Port: pport}
-	case "tcp", "":
+	case "tcp", "", "tls", "aaas":
 		l, err = net.ListenTCP("tcp", &net.TCPAddr{IP: lips[0], Port: lport})
 		dst = &net.TCPAddr{IP: pips[0], Port: pport}
 	default:
@@ -129,6 +162,8 @@ func Accept(la, pa string) (con net.Conn, host, realm diameter.Identity, err err
 	}
 	if err != nil {
 		con.Close()
+	} else if isTLS(scheme) {
+		con, err = tlsServer(con)
 	}
 	return
 }
@@ -13,11 +13,11 @@ import (
 /*
 ResolveIdentity parse Diameter peer parameter.
 
-[tcp|sctp://][realm/]hostname[:port]
+[tcp|sctp|tls|aaas://][realm/]hostname[:port]
 
-Default transport is tcp.
+Default transport is tcp. tls and aaas are TCP with TLS that uses TLSConfig.
 Default realm is generated from hostname (following text after first ".").
-Default port is 3868.
+Default port is 3868, or 5658 for tls and aaas.
 IP addresses are resolved from hostname.
 */
 func ResolveIdentity(uri string) (
@@ -34,7 +34,7 @@ func ResolveIdentity(uri string) (
 		scheme = ""
 	}
 	switch scheme {
-	case "tcp", "sctp", "":
+	case "tcp", "sctp", "tls", "aaas", "":
 	default:
 		err = errors.New("invalid transport protocol")
 		return
@@ -74,7 +74,9 @@ func ResolveIdentity(uri string) (
 	if c := t.Child(idPORT); c != nil {
 		p = string(c.V)
 	}
-	if p == "" {
+	if p == "" && isTLS(scheme) {
+		p = "5658"
+	} else if p == "" {
 		p = "3868"
 	}
 	port, err = strconv.Atoi(p)
@@ -118,7 +120,9 @@ func _uri() abnf.Rule {
 func _scheme() abnf.Rule {
 	return abnf.A(
 		abnf.C(abnf.K(abnf.VS("tcp"), idSCHEME), abnf.VS("://")),
-		abnf.C(abnf.K(abnf.VS("sctp"), idSCHEME), abnf.VS("://")))
+		abnf.C(abnf.K(abnf.VS("sctp"), idSCHEME), abnf.VS("://")),
+		abnf.C(abnf.K(abnf.VS("tls"), idSCHEME), abnf.VS("://")),
+		abnf.C(abnf.K(abnf.VS("aaas"), idSCHEME), abnf.VS("://")))
 }
 
 func _realm() abnf.Rule {
@@ -0,0 +1,417 @@
+package connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/fkgi/diameter"
+)
+
+var (
+	// DefaultTc is default value of Tc timer for reconnecting to peer.
+	DefaultTc = time.Second * 30
+	// DefaultMaxTc is default maximum value of Tc timer after backoff.
+	DefaultMaxTc = time.Minute * 5
+	// DefaultRedirectIdle is default idle time of peer that is added by ConnectRedirect.
+	DefaultRedirectIdle = time.Minute * 5
+)
+
+/*
+PeerTable holds static peer definitions.
+Each peer is connected with DialAndServe and reconnected
+after Tc timer when the connection is closed (RFC 6733 section 2.1, 5.2).
+Tc timer is doubled on every failed attempt up to MaxTc,
+and reset when connection is kept longer than Tc.
+
+Peer that is added by ConnectRedirect is not reconnected.
+It is removed when the connection is closed,
+or when it is not used by redirected request for RedirectIdle.
+*/
+type PeerTable struct {
+	// Local is local transport address with format for ResolveIdentity.
+	// Hostname and realm in the text are ignored, Node values are used for CER.
+	// Empty text means that local address is chosen by OS.
+	Local string
+	// Node is local diameter node of the connection. nil is default node.
+	Node *diameter.Node
+
+	Tc    time.Duration // Tc is initial reconnect timer, zero is DefaultTc
+	MaxTc time.Duration // MaxTc is maximum reconnect timer, zero is DefaultMaxTc
+
+	// RedirectIdle is idle time of peer that is added by ConnectRedirect, zero is DefaultRedirectIdle.
+	RedirectIdle time.Duration
+
+	// PeerDownNotify is called when peer connection is closed or failed to connect.
+	PeerDownNotify func(*Peer, error)
+
+	lock  sync.RWMutex
+	peers map[diameter.Identity]*Peer
+}
+
+// Peer is static peer of PeerTable.
+type Peer struct {
+	Host  diameter.Identity
+	Realm diameter.Identity
+
+	scheme string
+	ips    []net.IP
+	port   int
+
+	table    *PeerTable
+	redirect bool // peer is added by ConnectRedirect
+	stop     chan struct{}
+	stopOnce sync.Once
+
+	lock    sync.RWMutex
+	con     *diameter.Connection
+	tcon    net.Conn
+	err     error
+	changed chan struct{} // closed when con is changed
+	used    time.Time     // last time that is used by redirected request
+}
+
+// Add resolves peer identity and starts connecting to the peer.
+// Input is string of peer host information with format for ResolveIdentity.
+func (t *PeerTable) Add(pa string) (*Peer, error) {
+	return t.add(pa, false)
+}
+
+func (t *PeerTable) add(pa string, redirect bool) (*Peer, error) {
+	scheme, host, realm, ips, port, err := ResolveIdentity(pa)
+	if err != nil {
+		return nil, fmt.Errorf("invalid peer identity: %s", err)
+	}
+	if len(ips) == 0 {
+		return nil, errors.New("no IP address for peer " + host.String())
+	}
+
+	p := &Peer{
+		Host: host, Realm: realm,
+		scheme: scheme, ips: ips, port: port,
+		table: t, redirect: redirect,
+		stop:    make(chan struct{}),
+		changed: make(chan struct{}),
+		used:    time.Now()}
+
+	t.lock.Lock()
+	if _, ok := t.peers[host]; ok {
+		t.lock.Unlock()
+		return nil, errors.New("duplicate peer " + host.String())
+	}
+	if t.peers == nil {
+		t.peers = make(map[diameter.Identity]*Peer)
+	}
+	t.peers[host] = p
+	t.lock.Unlock()
+
+	go p.run()
+	if redirect {
+		go p.watchIdle()
+	}
+	return p, nil
+}
+
+// Remove closes the connection with cause and removes the peer from table.
+func (t *PeerTable) Remove(host diameter.Identity, cause diameter.Enumerated) {
+	t.lock.Lock()
+	p, ok := t.peers[host]
+	delete(t.peers, host)
+	t.lock.Unlock()
+
+	if ok {
+		p.close(cause)
+	}
+}
+
+// remove removes the peer from table if it is registered.
+func (t *PeerTable) remove(p *Peer) {
+	t.lock.Lock()
+	if t.peers[p.Host] == p {
+		delete(t.peers, p.Host)
+	}
+	t.lock.Unlock()
+}
+
+// Close closes all peers in the table with cause.
+func (t *PeerTable) Close(cause diameter.Enumerated) {
+	for _, p := range t.Peers() {
+		t.Remove(p.Host, cause)
+	}
+}
+
+// Lookup returns peer that has the host name.
+func (t *PeerTable) Lookup(host diameter.Identity) *Peer {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.peers[host]
+}
+
+// Peers returns all peers in the table.
+func (t *PeerTable) Peers() []*Peer {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	r := make([]*Peer, 0, len(t.peers))
+	for _, p := range t.peers {
+		r = append(r, p)
+	}
+	return r
+}
+
+// Connection returns current open connection of the peer that has the host name.
+// Connection that is accepted from the peer is returned
+// when the peer wins election of simultaneous connections.
+// nil is returned when no connection is open.
+func (t *PeerTable) Connection(host diameter.Identity) *diameter.Connection {
+	if p := t.Lookup(host); p == nil {
+		return nil
+	} else if c := p.Connection(); c != nil && c.State() == "open" {
+		return c
+	}
+
+	return t.nodePeer(host)
+}
+
+// nodePeer returns open connection of the host that is registered in the node.
+func (t *PeerTable) nodePeer(host diameter.Identity) *diameter.Connection {
+	n := t.Node
+	if n == nil {
+		n = diameter.DefaultNode()
+	}
+	if c := n.LookupPeer(host); c != nil && c.State() == "open" {
+		return c
+	}
+	return nil
+}
+
+// Router returns Router that select current connection of the peer.
+func (t *PeerTable) Router(host diameter.Identity) diameter.Router {
+	return func(diameter.Message) *diameter.Connection {
+		return t.Connection(host)
+	}
+}
+
+// ConnectRedirect returns open connection of Redirect-Host.
+// Redirect-Host that is not connected is added to the table as peer,
+// and it waits for the connection to be opened until ctx is done.
+// It is used as ConnectRedirect of diameter.Node.
+func (t *PeerTable) ConnectRedirect(ctx context.Context, h diameter.URI) *diameter.Connection {
+	if h.Protocol != "" && h.Protocol != "diameter" {
+		return nil
+	}
+	scheme := "tcp"
+	switch {
+	case h.Scheme == "aaas" && (h.Transport == "" || h.Transport == "tcp"):
+		scheme = "aaas"
+	case h.Scheme == "aaas":
+		return nil
+	case h.Transport == "sctp":
+		scheme = "sctp"
+	case h.Transport != "" && h.Transport != "tcp":
+		return nil
+	}
+	pa := scheme + "://" + h.Fqdn.String()
+	if h.Port != 0 {
+		pa += ":" + strconv.Itoa(h.Port)
+	}
+
+	p := t.Lookup(h.Fqdn)
+	if p == nil {
+		// connection that is not managed by the table
+		if c := t.nodePeer(h.Fqdn); c != nil {
+			return c
+		}
+		var err error
+		if p, err = t.add(pa, true); err != nil {
+			// duplicate error means that the peer is added concurrently
+			if p = t.Lookup(h.Fqdn); p == nil {
+				return nil
+			}
+		}
+	}
+	p.lock.Lock()
+	p.used = time.Now()
+	p.lock.Unlock()
+	return p.wait(ctx)
+}
+
+// wait waits for the connection of the peer to be opened until ctx is done.
+func (p *Peer) wait(ctx context.Context) *diameter.Connection {
+	for {
+		if c := p.table.Connection(p.Host); c != nil {
+			return c
+		}
+		p.lock.RLock()
+		c, changed := p.con, p.changed
+		p.lock.RUnlock()
+		var opened <-chan struct{}
+		if c != nil {
+			opened = c.Opened()
+		}
+
+		select {
+		case <-opened:
+		case <-changed:
+		case <-p.stop:
+			// connection that is accepted from the peer may be open
+			return p.table.nodePeer(p.Host)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// watchIdle removes the peer of Redirect-Host
+// when it is not used by redirected request for RedirectIdle.
+func (p *Peer) watchIdle() {
+	idle := p.table.RedirectIdle
+	if idle <= 0 {
+		idle = DefaultRedirectIdle
+	}
+	timer := time.NewTimer(idle)
+	defer timer.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-timer.C:
+		}
+		p.lock.RLock()
+		d := time.Until(p.used.Add(idle))
+		p.lock.RUnlock()
+		if d > 0 {
+			timer.Reset(d)
+			continue
+		}
+		p.table.remove(p)
+		p.close(diameter.DoNotWantToTalkToYou)
+		return
+	}
+}
+
+// Connection returns current connection of the peer.
+// nil is returned while reconnecting.
+func (p *Peer) Connection() *diameter.Connection {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.con
+}
+
+// State returns state of the peer connection.
+func (p *Peer) State() string {
+	if c := p.Connection(); c != nil {
+		return c.State()
+	}
+	return "closed"
+}
+
+// Err returns error of last connection attempt.
+func (p *Peer) Err() error {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.err
+}
+
+func (p *Peer) close(cause diameter.Enumerated) {
+	p.stopOnce.Do(func() { close(p.stop) })
+
+	p.lock.RLock()
+	c, tcon := p.con, p.tcon
+	p.lock.RUnlock()
+
+	// Connection before open can not be closed with DPR,
+	// so transport connection is closed directly.
+	if c != nil && c.State() == "open" {
+		c.Close(cause)
+	} else if tcon != nil {
+		tcon.Close()
+	}
+}
+
+func (p *Peer) run() {
+	t := p.table
+	minTc := t.Tc
+	if minTc <= 0 {
+		minTc = DefaultTc
+	}
+	maxTc := t.MaxTc
+	if maxTc <= 0 {
+		maxTc = DefaultMaxTc
+	}
+	if maxTc < minTc {
+		maxTc = minTc
+	}
+
+	for tc := minTc; ; {
+		start := time.Now()
+		err := p.connect()
+		p.lock.Lock()
+		p.err = err
+		p.lock.Unlock()
+		if t.PeerDownNotify != nil {
+			t.PeerDownNotify(p, err)
+		}
+		if p.redirect {
+			// peer of Redirect-Host is not reconnected
+			t.remove(p)
+			p.close(diameter.DoNotWantToTalkToYou)
+			return
+		}
+
+		if time.Since(start) > minTc {
+			tc = minTc
+		}
+		select {
+		case <-p.stop:
+			return
+		case <-time.After(tc):
+		}
+		if tc *= 2; tc > maxTc {
+			tc = maxTc
+		}
+	}
+}
+
+func (p *Peer) connect() error {
+	var lips []net.IP
+	var lport int
+	if la := p.table.Local; la != "" {
+		var err error
+		if _, _, _, lips, lport, err = ResolveIdentity(la); err != nil {
+			return fmt.Errorf("invalid local identity: %s", err)
+		}
+	}
+
+	con, err := dial(p.scheme, p.Host, lips, lport, p.ips, p.port)
+	if err != nil {
+		return err
+	}
+
+	c := &diameter.Connection{
+		Host: p.Host, Realm: p.Realm, Local: p.table.Node}
+	p.setConnection(c, con)
+
+	select {
+	case <-p.stop:
+		con.Close()
+		err = errors.New("peer is removed")
+	default:
+		err = c.DialAndServe(con)
+	}
+
+	p.setConnection(nil, nil)
+	return err
+}
+
+// setConnection sets current connection and notifies the change to waiters.
+func (p *Peer) setConnection(c *diameter.Connection, tcon net.Conn) {
+	p.lock.Lock()
+	p.con, p.tcon = c, tcon
+	close(p.changed)
+	p.changed = make(chan struct{})
+	p.lock.Unlock()
+}
@@ -0,0 +1,228 @@
+package connector
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/fkgi/diameter"
+)
+
+// testListener accepts connections of peer and closes each connection after hold.
+// Accepted and closed time of each connection are recorded.
+type testListener struct {
+	l      net.Listener
+	hold   func(int) time.Duration
+	accept chan time.Time
+	closed chan time.Time
+}
+
+func newTestListener(t *testing.T, hold func(int) time.Duration) *testListener {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tl := &testListener{
+		l: l, hold: hold,
+		accept: make(chan time.Time, 100),
+		closed: make(chan time.Time, 100)}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for i := 0; ; i++ {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			tl.accept <- time.Now()
+			time.Sleep(tl.hold(i))
+			c.Close()
+			tl.closed <- time.Now()
+		}
+	}()
+	return tl
+}
+
+func (tl *testListener) addr() string {
+	return "tcp://local/localhost:" + strconv.Itoa(tl.l.Addr().(*net.TCPAddr).Port)
+}
+
+// next returns interval from close of previous connection to accept of next connection.
+func (tl *testListener) next(t *testing.T) time.Duration {
+	t.Helper()
+	c := <-tl.closed
+	select {
+	case a := <-tl.accept:
+		return a.Sub(c)
+	case <-time.After(time.Second * 5):
+		t.Fatal("peer is not reconnected")
+	}
+	return 0
+}
+
+func newTestTable(t *testing.T, tc, maxTc time.Duration) *PeerTable {
+	n := diameter.NewNode("a.local", "local")
+	n.WDInterval = time.Second * 5
+	tb := &PeerTable{Node: n, Tc: tc, MaxTc: maxTc}
+	t.Cleanup(func() {
+		tb.Close(diameter.Rebooting)
+		n.Close()
+	})
+	return tb
+}
+
+func TestPeerBackoff(t *testing.T) {
+	const tc = time.Millisecond * 100
+	// connection 4 is kept longer than Tc
+	tl := newTestListener(t, func(i int) time.Duration {
+		if i == 4 {
+			return tc * 3
+		}
+		return 0
+	})
+	tb := newTestTable(t, tc, tc*4)
+	if _, err := tb.Add(tl.addr()); err != nil {
+		t.Fatal(err)
+	}
+	<-tl.accept
+
+	for i, min := range []time.Duration{tc, tc * 2, tc * 4, tc * 4} {
+		if d := tl.next(t); d < min-time.Millisecond*5 {
+			t.Errorf("Tc of attempt %d is %s, not %s", i+1, d, min)
+		}
+	}
+	// Tc is reset after connection that is kept longer than Tc
+	if d := tl.next(t); d < tc-time.Millisecond*5 || d >= tc*2 {
+		t.Errorf("Tc after long connection is %s, not %s", d, tc)
+	}
+}
+
+func TestPeerRemove(t *testing.T) {
+	tl := newTestListener(t, func(int) time.Duration { return 0 })
+	tb := newTestTable(t, time.Millisecond*30, time.Millisecond*30)
+	p, err := tb.Add(tl.addr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-tl.accept
+	<-tl.closed
+
+	tb.Remove(p.Host, diameter.Rebooting)
+	if tb.Lookup(p.Host) != nil {
+		t.Error("peer is not removed from table")
+	}
+	select {
+	case <-tl.accept:
+		t.Error("removed peer is reconnected")
+	case <-time.After(time.Millisecond * 200):
+	}
+	if c := tb.Connection(p.Host); c != nil {
+		t.Error("connection of removed peer is returned")
+	}
+}
+
+// newTestServer serves Diameter connection as host 127.0.0.1 and returns URI of the server.
+func newTestServer(t *testing.T) diameter.URI {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := diameter.NewNode("127.0.0.1", "0.0.1")
+	t.Cleanup(func() {
+		l.Close()
+		n.Close()
+	})
+	go func() {
+		for {
+			con, err := l.Accept()
+			if err != nil {
+				return
+			}
+			c := &diameter.Connection{Local: n}
+			go c.ListenAndServe(con)
+		}
+	}()
+	return diameter.URI{
+		Scheme: "aaa", Fqdn: "127.0.0.1",
+		Port: l.Addr().(*net.TCPAddr).Port}
+}
+
+func TestPeerConnectRedirect(t *testing.T) {
+	h := newTestServer(t)
+	tb := newTestTable(t, time.Second, time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	start := time.Now()
+	c := tb.ConnectRedirect(ctx, h)
+	if c == nil || c.State() != "open" {
+		t.Fatal("connection of Redirect-Host is not opened")
+	}
+	if d := time.Since(start); d > time.Millisecond*500 {
+		t.Errorf("connection is returned after %s", d)
+	}
+	if p := tb.Lookup(h.Fqdn); p == nil || p.Connection() != c {
+		t.Error("Redirect-Host is not added to table")
+	}
+	if c2 := tb.ConnectRedirect(ctx, h); c2 != c {
+		t.Error("connection of Redirect-Host is not reused")
+	}
+}
+
+func TestPeerRedirectIdle(t *testing.T) {
+	h := newTestServer(t)
+	tb := newTestTable(t, time.Second, time.Second)
+	tb.RedirectIdle = time.Millisecond * 200
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	c := tb.ConnectRedirect(ctx, h)
+	if c == nil {
+		t.Fatal("connection of Redirect-Host is not opened")
+	}
+	// idle timer is extended by redirected request
+	time.Sleep(tb.RedirectIdle / 2)
+	tb.ConnectRedirect(ctx, h)
+	time.Sleep(tb.RedirectIdle * 3 / 4)
+	if tb.Lookup(h.Fqdn) == nil {
+		t.Fatal("used Redirect-Host is removed")
+	}
+
+	time.Sleep(tb.RedirectIdle * 2)
+	if tb.Lookup(h.Fqdn) != nil {
+		t.Error("idle Redirect-Host is not removed")
+	}
+	for i := 0; c.State() != "closed"; i++ {
+		if i == 100 {
+			t.Fatal("connection of idle Redirect-Host is not closed")
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+}
+
+func TestPeerRedirectNotReconnected(t *testing.T) {
+	tl := newTestListener(t, func(int) time.Duration { return 0 })
+	tb := newTestTable(t, time.Millisecond*30, time.Millisecond*30)
+	h := diameter.URI{
+		Scheme: "aaa", Fqdn: "127.0.0.1",
+		Port: tl.l.Addr().(*net.TCPAddr).Port}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	if c := tb.ConnectRedirect(ctx, h); c != nil {
+		t.Error("closed connection is returned")
+	}
+	<-tl.accept
+	<-tl.closed
+	if tb.Lookup(h.Fqdn) != nil {
+		t.Error("closed Redirect-Host is not removed")
+	}
+	select {
+	case <-tl.accept:
+		t.Error("Redirect-Host is reconnected")
+	case <-time.After(time.Millisecond * 200):
+	}
+}
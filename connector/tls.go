@@ -0,0 +1,96 @@
+package connector
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"os"
+	"time"
+
+	"github.com/fkgi/diameter"
+)
+
+// TLSConfig is TLS configuration for tls:// and aaas:// transport.
+// Certificate of the peer is verified with hostname of the peer identity on Dial,
+// and with Origin-Host in CER/CEA by the connection.
+var TLSConfig *tls.Config
+
+// TLSHandshakeTimeout is timeout of TLS handshake for tls:// and aaas:// transport.
+var TLSHandshakeTimeout = time.Second * 10
+
+/*
+LoadTLSConfig makes TLS configuration from PEM files.
+cert and key are certificate and private key of local host.
+ca is CA certificates for verifying peer certificate,
+and certificate of the peer is required for mutual authentication when it is not empty.
+Accepted peer without ca does not present certificate,
+so that it is rejected by the connection unless AcceptAnonymousPeer of the node is enabled.
+*/
+func LoadTLSConfig(cert, key, ca string) (*tls.Config, error) {
+	c := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cert != "" || key != "" {
+		crt, err := tls.LoadX509KeyPair(cert, key)
+		if err != nil {
+			return nil, err
+		}
+		c.Certificates = []tls.Certificate{crt}
+	}
+	if ca != "" {
+		data, err := os.ReadFile(ca)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, errors.New("no certificate in CA file " + ca)
+		}
+		c.RootCAs = pool
+		c.ClientCAs = pool
+		c.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return c, nil
+}
+
+func isTLS(scheme string) bool {
+	return scheme == "tls" || scheme == "aaas"
+}
+
+// tlsClient starts TLS on the connection and verifies certificate of the peer with host.
+func tlsClient(con net.Conn, host diameter.Identity) (net.Conn, error) {
+	if TLSConfig == nil {
+		con.Close()
+		return nil, errors.New("TLS configuration is not defined")
+	}
+	c := TLSConfig.Clone()
+	if c.ServerName == "" {
+		c.ServerName = string(host)
+	}
+	tc := tls.Client(con, c)
+	if err := handshake(tc); err != nil {
+		con.Close()
+		return nil, err
+	}
+	return tc, nil
+}
+
+// tlsServer starts TLS on the accepted connection.
+func tlsServer(con net.Conn) (net.Conn, error) {
+	if TLSConfig == nil {
+		con.Close()
+		return nil, errors.New("TLS configuration is not defined")
+	}
+	tc := tls.Server(con, TLSConfig)
+	if err := handshake(tc); err != nil {
+		con.Close()
+		return nil, err
+	}
+	return tc, nil
+}
+
+func handshake(tc *tls.Conn) error {
+	ctx, cancel := context.WithTimeout(context.Background(), TLSHandshakeTimeout)
+	defer cancel()
+	return tc.HandshakeContext(ctx)
+}
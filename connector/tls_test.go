@@ -0,0 +1,41 @@
+package connector
+
+import (
+	"crypto/tls"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestTLSHandshakeTimeout(t *testing.T) {
+	conf, timeo := TLSConfig, TLSHandshakeTimeout
+	defer func() { TLSConfig, TLSHandshakeTimeout = conf, timeo }()
+	TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	TLSHandshakeTimeout = time.Millisecond * 100
+
+	// peer that sends nothing
+	for name, f := range map[string]func(net.Conn) (net.Conn, error){
+		"client": func(c net.Conn) (net.Conn, error) { return tlsClient(c, "b.local") },
+		"server": tlsServer,
+	} {
+		c, p := net.Pipe()
+		go func() {
+			// drain ClientHello without answer
+			b := make([]byte, 1024)
+			for {
+				if _, e := p.Read(b); e != nil {
+					return
+				}
+			}
+		}()
+
+		start := time.Now()
+		if _, err := f(c); err == nil {
+			t.Errorf("%s handshake is succeeded without peer", name)
+		}
+		if d := time.Since(start); d > time.Second {
+			t.Errorf("%s handshake is not timed out in %s", name, d)
+		}
+		p.Close()
+	}
+}
@@ -1,7 +1,6 @@
 package dictionary
 
 import (
-	"bytes"
 	"encoding/hex"
 	"errors"
 	"net"
@@ -75,13 +74,9 @@ func decFloat64(avp *diameter.AVP) (any, error) {
 
 func decGrouped(avp *diameter.AVP) (any, error) {
 	result := make(map[string][]any)
-	for buf := bytes.NewBuffer(avp.Data); buf.Len() != 0; {
-		a := diameter.AVP{}
-		e := a.UnmarshalFrom(buf)
-		if e != nil {
-			return nil, e
-		}
-		n, v, e := DecodeAVP(a)
+	it := diameter.NewAVPIterator(avp.Data)
+	for it.Next() {
+		n, v, e := DecodeAVP(it.AVP())
 		if e != nil {
 			return nil, e
 		}
@@ -91,6 +86,9 @@ func decGrouped(avp *diameter.AVP) (any, error) {
 			result[n] = []any{v}
 		}
 	}
+	if e := it.Err(); e != nil {
+		return nil, e
+	}
 
 	compat := make(map[string]any, len(result))
 	for k, v := range result {
@@ -16,28 +16,37 @@ type XDictionary struct {
 		N string `xml:"name,attr"`
 		I uint32 `xml:"id,attr"`
 		P []struct {
-			N string `xml:"name,attr"`
-			I uint32 `xml:"id,attr"`
-			C []struct {
-				N string `xml:"name,attr"`
-				I uint32 `xml:"id,attr"`
-			} `xml:"command"`
+			N string     `xml:"name,attr"`
+			I uint32     `xml:"id,attr"`
+			C []XCommand `xml:"command"`
 		} `xml:"application"`
-		V []struct {
-			N string `xml:"name,attr"`
-			I uint32 `xml:"id,attr"`
-			T string `xml:"type,attr"`
-			M bool   `xml:"mandatory,attr"`
-			P bool   `xml:"protected,attr"`
-			R bool   `xml:"reserved,attr"`
-			E []struct {
-				I int32  `xml:"value,attr"`
-				V string `xml:",chardata"`
-			} `xml:"enum"`
-		} `xml:"avp"`
+		V []XAVP `xml:"avp"`
 	} `xml:"vendor"`
 }
 
+// XCommand is command definition with optional request and answer grammar.
+type XCommand struct {
+	N string    `xml:"name,attr"`
+	I uint32    `xml:"id,attr"`
+	Q *XGrammar `xml:"request"`
+	A *XGrammar `xml:"answer"`
+}
+
+// XAVP is AVP definition. Grammar is available for Grouped AVP.
+type XAVP struct {
+	N string `xml:"name,attr"`
+	I uint32 `xml:"id,attr"`
+	T string `xml:"type,attr"`
+	M bool   `xml:"mandatory,attr"`
+	P bool   `xml:"protected,attr"`
+	R bool   `xml:"reserved,attr"`
+	E []struct {
+		I int32  `xml:"value,attr"`
+		V string `xml:",chardata"`
+	} `xml:"enum"`
+	XGrammar
+}
+
 var (
 	encAVPs    = make(map[string]func(any) (diameter.AVP, error))
 	decAVPs    = make(map[uint64]func(diameter.AVP) (string, any, error))
@@ -160,6 +169,11 @@ func LoadDictionary(data []byte) (XDictionary, error) {
 		return xd, e
 	}
 
+	// grammars are built in copy and replaced only when all grammars are compiled
+	gs := <-grammars
+	defer func() { grammars <- gs }()
+	next := gs.clone()
+
 	for _, vnd := range xd.V {
 		for _, app := range vnd.P {
 			for _, cmd := range app.C {
@@ -248,6 +262,7 @@ func LoadDictionary(data []byte) (XDictionary, error) {
 			mflg := avp.M
 			pflg := avp.P
 			rflg := avp.R
+			next.examples[avp.N] = exampleAVP(code, vid, mflg, avp.T)
 			encAVPs[avp.N] = func(v any) (diameter.AVP, error) {
 				a := diameter.AVP{
 					Code:      code,
@@ -263,10 +278,42 @@ func LoadDictionary(data []byte) (XDictionary, error) {
 			decAVPs[(uint64(vnd.I)<<32)|uint64(avp.I)] =
 				func(a diameter.AVP) (string, any, error) {
 					v, e := decf(&a)
+					if e != nil {
+						e = diameter.InvalidAVP{
+							Code: diameter.InvalidAvpValue, AVP: a, E: e}
+					}
 					return n, v, e
 				}
 		}
 	}
 
+	for _, vnd := range xd.V {
+		for _, app := range vnd.P {
+			for _, cmd := range app.C {
+				for _, q := range []*XGrammar{cmd.Q, cmd.A} {
+					if q == nil {
+						continue
+					}
+					g, e := next.compile(*q)
+					if e != nil {
+						return xd, e
+					}
+					next.cmds[cmdKey{app: app.I, code: cmd.I, req: q == cmd.Q}] = g
+				}
+			}
+		}
+		for _, avp := range vnd.V {
+			if len(avp.F)+len(avp.Q)+len(avp.O) == 0 {
+				continue
+			}
+			g, e := next.compile(avp.XGrammar)
+			if e != nil {
+				return xd, e
+			}
+			next.avps[(uint64(vnd.I)<<32)|uint64(avp.I)] = g
+		}
+	}
+
+	gs = next
 	return xd, nil
 }
@@ -0,0 +1,5 @@
+package dictionary
+
+//go:generate go run ./generator -p s6a -o s6a/s6a.go s6a.xml
+//go:generate go run ./generator -p s6c -o s6c/s6c.go s6c.xml
+//go:generate go run ./generator -p sgd -o sgd/sgd.go sgd.xml
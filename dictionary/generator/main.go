@@ -0,0 +1,575 @@
+/*
+Generator makes typed Go AVP and command definitions from dictionary XML.
+
+	go run github.com/fkgi/diameter/dictionary/generator -p s6a -o s6a/s6a.go s6a.xml
+
+Each AVP is generated as named type with ToAVP and FromAVP method,
+and Enumerated values are generated as constants of the type.
+Each Grouped AVP and each command of application (Request and Answer)
+is generated as struct that has field of each AVP rule in the grammar.
+Field of single AVP is value, field of optional single AVP is pointer,
+and field of multiple AVPs is slice. Rule "AVP" is field AVP of []diameter.AVP.
+Grouped AVP without grammar has only the field AVP.
+*/
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/fkgi/diameter/dictionary"
+)
+
+func main() {
+	pkg := flag.String("p", "", "generated package `name`")
+	out := flag.String("o", "", "output file `path`")
+	flag.Parse()
+	if *pkg == "" || *out == "" || flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatalln("[ERROR]", "failed to open dictionary file:", err)
+	}
+	var xd dictionary.XDictionary
+	if err = xml.Unmarshal(data, &xd); err != nil {
+		log.Fatalln("[ERROR]", "failed to read dictionary file:", err)
+	}
+
+	src, err := generate(*pkg, flag.Arg(0), xd)
+	if err != nil {
+		log.Fatalln("[ERROR]", "failed to generate code:", err)
+	}
+	if err = os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatalln("[ERROR]", "failed to write output file:", err)
+	}
+}
+
+// goTypes is Go type of each AVP data type.
+var goTypes = map[string]string{
+	"OctetString":      "[]byte",
+	"Integer32":        "int32",
+	"Integer64":        "int64",
+	"Unsigned32":       "uint32",
+	"Unsigned64":       "uint64",
+	"Float32":          "float32",
+	"Float64":          "float64",
+	"Grouped":          "struct",
+	"Address":          "net.IP",
+	"Time":             "time.Time",
+	"UTF8String":       "string",
+	"DiameterIdentity": "diameter.Identity",
+	"DiameterURI":      "diameter.URI",
+	"Enumerated":       "diameter.Enumerated",
+	"IPFilterRule":     "diameter.IPFilterRule",
+}
+
+type generator struct {
+	buf   bytes.Buffer
+	names map[string]string
+	avps  map[string]avpDef
+}
+
+// avpDef is AVP definition that is referred from grammar.
+type avpDef struct {
+	vid uint32
+	dictionary.XAVP
+}
+
+// anyGrammar is grammar of Grouped AVP or command that has no rule.
+var anyGrammar = dictionary.XGrammar{
+	O: []dictionary.XRule{{N: "AVP", Max: "*"}}}
+
+func (g *generator) printf(format string, a ...any) {
+	fmt.Fprintf(&g.buf, format, a...)
+}
+
+// declare registers Go identifier and returns error when it is duplicated.
+func (g *generator) declare(id, src string) error {
+	if o, ok := g.names[id]; ok {
+		return fmt.Errorf("identifier %s of %s is duplicated with %s", id, src, o)
+	}
+	g.names[id] = src
+	return nil
+}
+
+func generate(pkg, file string, xd dictionary.XDictionary) ([]byte, error) {
+	g := &generator{
+		names: make(map[string]string),
+		avps:  make(map[string]avpDef)}
+	for _, id := range []string{
+		"checkAVP", "decodeAVP", "checkMessage", "avpPtr",
+		"decodeOne", "decodeOptional", "decodeMulti", "missingAVP"} {
+		g.names[id] = "helper function"
+	}
+	for _, vnd := range xd.V {
+		for _, avp := range vnd.V {
+			g.avps[strings.TrimSpace(avp.N)] = avpDef{vid: vnd.I, XAVP: avp}
+		}
+	}
+
+	g.printf("// Code generated by generator from %s. DO NOT EDIT.\n\n", file)
+	g.printf("package %s\n\n", pkg)
+	g.printf("import (\n\t\"fmt\"\n\t\"io\"\n")
+	imports := map[string]bool{}
+	for _, vnd := range xd.V {
+		for _, avp := range vnd.V {
+			imports[avp.T] = true
+		}
+	}
+	if imports["Address"] {
+		g.printf("\t\"net\"\n")
+	}
+	if imports["Time"] {
+		g.printf("\t\"time\"\n")
+	}
+	g.printf("\n\t\"github.com/fkgi/diameter\"\n)\n\n")
+
+	for _, vnd := range xd.V {
+		if vnd.I == 0 {
+			continue
+		}
+		for _, app := range vnd.P {
+			if err := g.application(app.N, app.I, app.C); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, vnd := range xd.V {
+		for _, avp := range vnd.V {
+			if err := g.avp(vnd.I, avp); err != nil {
+				return nil, err
+			}
+		}
+	}
+	g.helpers()
+
+	return format.Source(g.buf.Bytes())
+}
+
+func (g *generator) application(name string, id uint32, cmds []dictionary.XCommand) error {
+	appID := goName(name, false) + "ApplicationID"
+	if err := g.declare(appID, name); err != nil {
+		return err
+	}
+	g.printf("// %s is Application-ID of %s.\n", appID, name)
+	g.printf("const %s uint32 = %d\n\n", appID, id)
+
+	for _, cmd := range cmds {
+		n := goName(cmd.N, false)
+		code := n + "Code"
+		if err := g.declare(code, cmd.N); err != nil {
+			return err
+		}
+		g.printf("// %s is Command-Code of %s.\n", code, cmd.N)
+		g.printf("const %s uint32 = %d\n\n", code, cmd.I)
+
+		for _, req := range []bool{true, false} {
+			t := n + "Answer"
+			if req {
+				t = n + "Request"
+			}
+			full := cmd.N + "-Answer"
+			if req {
+				full = cmd.N + "-Request"
+			}
+			if err := g.declare(t, full); err != nil {
+				return err
+			}
+			gr := cmd.A
+			if req {
+				gr = cmd.Q
+			}
+			if gr == nil {
+				gr = &anyGrammar
+			}
+			g.printf("// %s is %s message of %s.\n", t, full, name)
+			if err := g.grammar(t, full, *gr); err != nil {
+				return err
+			}
+
+			if req {
+				g.printf("// ToMessage makes %s message.\n", full)
+				g.printf("func (v %s) ToMessage() diameter.Message {\n", t)
+				g.printf("\treturn diameter.NewRequest(%s, %s).Add(v.ToAVPs()...).Message()\n}\n\n", code, appID)
+			} else {
+				g.printf("// ToMessage makes %s message for the request.\n", full)
+				g.printf("func (v %s) ToMessage(req diameter.Message) diameter.Message {\n", t)
+				g.printf("\treturn diameter.NewAnswer(req).Add(v.ToAVPs()...).Message()\n}\n\n")
+			}
+			g.printf("// FromMessage reads %s message.\n", full)
+			g.printf("func (v *%s) FromMessage(m diameter.Message) error {\n", t)
+			g.printf("\tif e := checkMessage(m, %t, %s, %s); e != nil {\n\t\treturn e\n\t}\n", req, code, appID)
+			g.printf("\tavps, e := m.GetAVP()\n\tif e != nil {\n\t\treturn e\n\t}\n")
+			g.printf("\treturn v.FromAVPs(avps)\n}\n\n")
+		}
+	}
+	return nil
+}
+
+func (g *generator) avp(vid uint32, def dictionary.XAVP) error {
+	gt, ok := goTypes[def.T]
+	if !ok {
+		return fmt.Errorf("invalid AVP type %s of %s", def.T, def.N)
+	}
+	t := goName(def.N, false)
+	if err := g.declare(t, def.N); err != nil {
+		return err
+	}
+	name := strings.TrimSpace(def.N)
+
+	g.printf("// %s is %s AVP (code %d, vendor %d).\n", t, name, def.I, vid)
+	if gt == "struct" {
+		gr := def.XGrammar
+		if len(gr.F)+len(gr.Q)+len(gr.O) == 0 {
+			gr = anyGrammar
+		}
+		if err := g.grammar(t, name, gr); err != nil {
+			return err
+		}
+		gt = "[]diameter.AVP"
+	} else {
+		g.printf("type %s %s\n\n", t, gt)
+	}
+
+	if len(def.E) != 0 {
+		g.printf("const (\n")
+		for _, e := range def.E {
+			c := t + goName(e.V, true)
+			if g.declare(c, name+" "+e.V) != nil {
+				c = fmt.Sprintf("%s%d", c, e.I)
+				if err := g.declare(c, name+" "+e.V); err != nil {
+					return err
+				}
+			}
+			g.printf("\t%s %s = %d // %s\n", c, t, e.I, strings.TrimSpace(e.V))
+		}
+		g.printf(")\n\n")
+
+		g.printf("func (v %s) String() string {\n\tswitch v {\n", t)
+		seen := make(map[int32]bool)
+		for _, e := range def.E {
+			if !seen[e.I] {
+				seen[e.I] = true
+				g.printf("\tcase %d:\n\t\treturn %q\n", e.I, strings.TrimSpace(e.V))
+			}
+		}
+		g.printf("\t}\n\treturn fmt.Sprintf(\"%s(%%d)\", v)\n}\n\n", t)
+	}
+
+	g.printf("// ToAVP makes %s AVP.\n", name)
+	g.printf("func (v %s) ToAVP() diameter.AVP {\n", t)
+	g.printf("\ta := diameter.AVP{Code: %d, VendorID: %d, Mandatory: %t, Protected: %t}\n", def.I, vid, def.M, def.P)
+	if def.T == "Grouped" {
+		g.printf("\ta.Encode(v.ToAVPs())\n\treturn a\n}\n\n")
+	} else {
+		g.printf("\ta.Encode(%s(v))\n\treturn a\n}\n\n", gt)
+	}
+
+	g.printf("// FromAVP reads %s AVP.\n", name)
+	g.printf("func (v *%s) FromAVP(a diameter.AVP) error {\n", t)
+	g.printf("\tvar d %s\n", gt)
+	g.printf("\tif e := checkAVP(a, %d, %d, %t); e != nil {\n\t\treturn e\n\t}", def.I, vid, def.M)
+	g.printf(" else if e = decodeAVP(a, &d); e != nil {\n\t\treturn e\n\t}\n")
+	if def.T == "Grouped" {
+		g.printf("\treturn v.FromAVPs(d)\n}\n\n")
+	} else {
+		g.printf("\t*v = %s(d)\n\treturn nil\n}\n\n", t)
+	}
+	return nil
+}
+
+// field is struct field of AVP rule.
+type field struct {
+	name, typ, note string
+	def             avpDef
+	fixed           int // position of fixed AVP, -1 for not fixed
+	min, max        int // max is -1 for no limit
+}
+
+func (f field) single() bool   { return f.max == 1 }
+func (f field) required() bool { return f.min > 0 }
+
+// fields makes struct fields from AVP rules of the grammar.
+func (g *generator) fields(src string, gr dictionary.XGrammar) ([]field, error) {
+	var fs []field
+	names := make(map[string]bool)
+	add := func(rules []dictionary.XRule, fixed bool, min int, format string) error {
+		for _, r := range rules {
+			n := strings.TrimSpace(r.N)
+			f := field{name: goName(n, false), fixed: -1, min: min, max: 1}
+			if fixed {
+				f.fixed = len(fs)
+			}
+			if n == "AVP" {
+				f.typ = "[]diameter.AVP"
+			} else if d, ok := g.avps[n]; !ok {
+				return fmt.Errorf("AVP %s in grammar of %s is not defined", n, src)
+			} else {
+				f.def = d
+				f.typ = f.name
+			}
+			if names[f.name] {
+				return fmt.Errorf("AVP %s in grammar of %s is duplicated", n, src)
+			}
+			names[f.name] = true
+
+			var err error
+			if r.Min != "" {
+				if f.min, err = strconv.Atoi(r.Min); err != nil {
+					return fmt.Errorf("invalid min of %s in grammar of %s: %v", n, src, err)
+				}
+			}
+			if r.Max == "*" {
+				f.max = -1
+			} else if r.Max != "" {
+				if f.max, err = strconv.Atoi(r.Max); err != nil {
+					return fmt.Errorf("invalid max of %s in grammar of %s: %v", n, src, err)
+				}
+			}
+			if fixed && !f.single() {
+				return fmt.Errorf("fixed AVP %s in grammar of %s is not single", n, src)
+			}
+			f.note = fmt.Sprintf(format, n)
+			if !f.single() {
+				f.note = "*" + f.note
+			}
+			fs = append(fs, f)
+		}
+		return nil
+	}
+	if err := add(gr.F, true, 1, "< %s >"); err != nil {
+		return nil, err
+	}
+	if err := add(gr.Q, false, 1, "{ %s }"); err != nil {
+		return nil, err
+	}
+	if err := add(gr.O, false, 0, "[ %s ]"); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+// grammar generates struct t of the grammar with ToAVPs and FromAVPs method.
+func (g *generator) grammar(t, src string, gr dictionary.XGrammar) error {
+	fs, err := g.fields(src, gr)
+	if err != nil {
+		return err
+	}
+
+	g.printf("type %s struct {\n", t)
+	for _, f := range fs {
+		switch {
+		case f.typ == "[]diameter.AVP":
+			g.printf("\t%s %s // %s\n", f.name, f.typ, f.note)
+		case !f.single():
+			g.printf("\t%s []%s // %s\n", f.name, f.typ, f.note)
+		case f.required():
+			g.printf("\t%s %s // %s\n", f.name, f.typ, f.note)
+		default:
+			g.printf("\t%s *%s // %s\n", f.name, f.typ, f.note)
+		}
+	}
+	g.printf("}\n\n")
+
+	g.printf("// ToAVPs returns AVPs of %s.\n", src)
+	g.printf("func (v %s) ToAVPs() []diameter.AVP {\n", t)
+	g.printf("\tavps := make([]diameter.AVP, 0, %d)\n", len(fs))
+	for _, f := range fs {
+		switch {
+		case f.typ == "[]diameter.AVP":
+			g.printf("\tavps = append(avps, v.%s...)\n", f.name)
+		case !f.single():
+			g.printf("\tfor _, a := range v.%s {\n\t\tavps = append(avps, a.ToAVP())\n\t}\n", f.name)
+		case f.required():
+			g.printf("\tavps = append(avps, v.%s.ToAVP())\n", f.name)
+		default:
+			g.printf("\tif v.%s != nil {\n\t\tavps = append(avps, v.%s.ToAVP())\n\t}\n", f.name, f.name)
+		}
+	}
+	g.printf("\treturn avps\n}\n\n")
+
+	var rest string
+	var fixed, seen int
+	for _, f := range fs {
+		if f.typ == "[]diameter.AVP" {
+			rest = f.name
+		} else if f.single() && f.required() {
+			seen++
+		}
+		if f.fixed >= 0 {
+			fixed++
+		}
+	}
+
+	g.printf("// FromAVPs reads AVPs of %s.\n", src)
+	g.printf("func (v *%s) FromAVPs(avps []diameter.AVP) error {\n", t)
+	g.printf("\t*v = %s{}\n", t)
+	if seen != 0 {
+		g.printf("\tvar seen [%d]bool\n", seen)
+	}
+	if fixed != 0 {
+		g.printf("\tfor i, a := range avps {\n")
+	} else {
+		g.printf("\tfor _, a := range avps {\n")
+	}
+	g.printf("\t\tvar e error\n")
+	g.printf("\t\tswitch uint64(a.VendorID)<<32 | uint64(a.Code) {\n")
+	idx := 0
+	for _, f := range fs {
+		if f.typ == "[]diameter.AVP" {
+			continue
+		}
+		if f.def.vid == 0 {
+			g.printf("\t\tcase %d: // %s\n", f.def.I, strings.TrimSpace(f.def.N))
+		} else {
+			g.printf("\t\tcase %d<<32 | %d: // %s\n", f.def.vid, f.def.I, strings.TrimSpace(f.def.N))
+		}
+		if f.fixed >= 0 {
+			g.printf("\t\t\tif i != %d {\n", f.fixed)
+			g.printf("\t\t\t\treturn diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}\n\t\t\t}\n")
+		}
+		switch {
+		case !f.single():
+			g.printf("\t\t\te = decodeMulti(a, &v.%s, %d)\n", f.name, f.max)
+		case f.required():
+			g.printf("\t\t\te = decodeOne(a, &v.%s, &seen[%d])\n", f.name, idx)
+			idx++
+		default:
+			g.printf("\t\t\te = decodeOptional(a, &v.%s)\n", f.name)
+		}
+	}
+	g.printf("\t\tdefault:\n")
+	if rest != "" {
+		g.printf("\t\t\tv.%s = append(v.%s, a)\n", rest, rest)
+	} else {
+		g.printf("\t\t\te = diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}\n")
+	}
+	g.printf("\t\t}\n\t\tif e != nil {\n\t\t\treturn e\n\t\t}\n\t}\n\n")
+
+	idx = 0
+	for _, f := range fs {
+		if f.typ == "[]diameter.AVP" {
+			if f.min > 0 {
+				g.printf("\tif len(v.%s) < %d {\n", f.name, f.min)
+				g.printf("\t\treturn diameter.InvalidAVP{Code: diameter.MissingAvp}\n\t}\n")
+			}
+			continue
+		}
+		switch {
+		case !f.single() && f.min > 0:
+			g.printf("\tif len(v.%s) < %d {\n", f.name, f.min)
+		case f.single() && f.required():
+			g.printf("\tif !seen[%d] {\n", idx)
+			idx++
+		default:
+			continue
+		}
+		g.printf("\t\treturn missingAVP(%d, %d, %t)\n\t}\n", f.def.I, f.def.vid, f.def.M)
+	}
+	g.printf("\treturn nil\n}\n\n")
+	return nil
+}
+
+func (g *generator) helpers() {
+	g.printf(`func checkAVP(a diameter.AVP, code, vid uint32, m bool) error {
+	if a.Code != code || a.VendorID != vid {
+		return fmt.Errorf("AVP code %%d (vendor %%d) is not %%d (vendor %%d)",
+			a.Code, a.VendorID, code, vid)
+	}
+	if m && !a.Mandatory {
+		return diameter.InvalidAVP{Code: diameter.InvalidAvpBits, AVP: a}
+	}
+	return nil
+}
+
+func decodeAVP(a diameter.AVP, d any) error {
+	err := a.Decode(d)
+	if err == io.EOF {
+		err = diameter.InvalidAVP{Code: diameter.InvalidAvpLength, AVP: a}
+	} else if err != nil {
+		err = diameter.InvalidAVP{Code: diameter.InvalidAvpValue, AVP: a, E: err}
+	}
+	return err
+}
+
+// avpPtr is pointer of generated AVP type.
+type avpPtr[T any] interface {
+	*T
+	FromAVP(diameter.AVP) error
+}
+
+func decodeOne[T any, P avpPtr[T]](a diameter.AVP, v *T, seen *bool) error {
+	if *seen {
+		return diameter.InvalidAVP{Code: diameter.AvpOccursTooManyTimes, AVP: a}
+	}
+	*seen = true
+	return P(v).FromAVP(a)
+}
+
+func decodeOptional[T any, P avpPtr[T]](a diameter.AVP, v **T) error {
+	if *v != nil {
+		return diameter.InvalidAVP{Code: diameter.AvpOccursTooManyTimes, AVP: a}
+	}
+	*v = new(T)
+	return P(*v).FromAVP(a)
+}
+
+func decodeMulti[T any, P avpPtr[T]](a diameter.AVP, v *[]T, max int) error {
+	if max >= 0 && len(*v) >= max {
+		return diameter.InvalidAVP{Code: diameter.AvpOccursTooManyTimes, AVP: a}
+	}
+	var d T
+	if e := P(&d).FromAVP(a); e != nil {
+		return e
+	}
+	*v = append(*v, d)
+	return nil
+}
+
+func missingAVP(code, vid uint32, m bool) error {
+	return diameter.InvalidAVP{Code: diameter.MissingAvp,
+		AVP: diameter.AVP{Code: code, VendorID: vid, Mandatory: m}}
+}
+
+func checkMessage(m diameter.Message, req bool, code, appID uint32) error {
+	if m.FlgR != req || m.Code != code || m.AppID != appID {
+		return diameter.InvalidMessage{
+			Code:   diameter.CommandUnspported,
+			ErrMsg: fmt.Sprintf("message (R=%%t, code=%%d, app=%%d) is not expected", m.FlgR, m.Code, m.AppID)}
+	}
+	return nil
+}
+`)
+}
+
+// goName returns exported Go identifier of the name.
+// Each word of upper case name is changed to title case when lower is true.
+func goName(name string, lower bool) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	upper := strings.ToUpper(name) == name
+	b := new(strings.Builder)
+	for _, w := range words {
+		if lower && upper {
+			w = strings.ToLower(w)
+		}
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	s := b.String()
+	if s == "" || unicode.IsDigit(rune(s[0])) {
+		s = "AVP" + s
+	}
+	return s
+}
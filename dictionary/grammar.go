@@ -0,0 +1,271 @@
+package dictionary
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/fkgi/diameter"
+)
+
+/*
+XGrammar is AVP rules of command or Grouped AVP (RFC 6733 section 3.2).
+Name "AVP" means any AVP. Default occurrence is 1 for fixed and required,
+and 0 to 1 for optional. "*" of max means no upper limit.
+
+	<request>
+		<fixed name="Session-Id" />
+		<required name="Origin-Host" />
+		<optional name="Supported-Features" max="*" />
+		<optional name="AVP" max="*" />
+	</request>
+*/
+type XGrammar struct {
+	F []XRule `xml:"fixed"`
+	Q []XRule `xml:"required"`
+	O []XRule `xml:"optional"`
+}
+
+// XRule is AVP rule in grammar.
+type XRule struct {
+	N   string `xml:"name,attr"`
+	Min string `xml:"min,attr"`
+	Max string `xml:"max,attr"`
+}
+
+type rule struct {
+	key      uint64
+	name     string
+	example  diameter.AVP // AVP with zero filled value of minimum length
+	min, max int          // max is -1 for no limit
+}
+
+type grammar struct {
+	fixed []rule
+	rules []rule
+	any   bool
+}
+
+type cmdKey struct {
+	app, code uint32
+	req       bool
+}
+
+// grammarSet is grammars of loaded dictionary.
+// Maps are not modified after the set is stored in grammars.
+type grammarSet struct {
+	examples map[string]diameter.AVP // AVP with zero filled value, keyed by AVP name
+	cmds     map[cmdKey]*grammar
+	avps     map[uint64]*grammar
+}
+
+// Grammars of loaded dictionary. LoadDictionary replaces the set.
+var grammars = make(chan grammarSet, 1)
+
+func init() {
+	grammars <- grammarSet{
+		examples: make(map[string]diameter.AVP),
+		cmds:     make(map[cmdKey]*grammar),
+		avps:     make(map[uint64]*grammar)}
+}
+
+func loadGrammars() grammarSet {
+	gs := <-grammars
+	grammars <- gs
+	return gs
+}
+
+// clone returns copy of the set for adding new grammars.
+func (gs grammarSet) clone() grammarSet {
+	n := grammarSet{
+		examples: make(map[string]diameter.AVP, len(gs.examples)),
+		cmds:     make(map[cmdKey]*grammar, len(gs.cmds)),
+		avps:     make(map[uint64]*grammar, len(gs.avps))}
+	for k, v := range gs.examples {
+		n.examples[k] = v
+	}
+	for k, v := range gs.cmds {
+		n.cmds[k] = v
+	}
+	for k, v := range gs.avps {
+		n.avps[k] = v
+	}
+	return n
+}
+
+func (gs grammarSet) compile(xg XGrammar) (*grammar, error) {
+	g := &grammar{}
+	for i, l := range [][]XRule{xg.F, xg.Q, xg.O} {
+		for _, xr := range l {
+			if xr.N == "AVP" {
+				g.any = true
+				continue
+			}
+			r := rule{name: xr.N, min: 1, max: 1}
+			if i == 2 {
+				r.min = 0
+			}
+			var ok bool
+			if r.example, ok = gs.examples[xr.N]; !ok {
+				return nil, errors.New("unknown AVP in grammar: " + xr.N)
+			}
+			r.key = avpKey(r.example)
+			if xr.Min != "" {
+				v, e := strconv.Atoi(xr.Min)
+				if e != nil || v < 0 {
+					return nil, fmt.Errorf("invalid min of %s: %s", xr.N, xr.Min)
+				}
+				r.min = v
+			}
+			if xr.Max == "*" {
+				r.max = -1
+			} else if xr.Max != "" {
+				v, e := strconv.Atoi(xr.Max)
+				if e != nil || v < 0 {
+					return nil, fmt.Errorf("invalid max of %s: %s", xr.N, xr.Max)
+				}
+				r.max = v
+			}
+			if r.max >= 0 && r.min > r.max {
+				return nil, fmt.Errorf("min of %s is larger than max", xr.N)
+			}
+			if i == 0 {
+				g.fixed = append(g.fixed, r)
+			}
+			g.rules = append(g.rules, r)
+		}
+	}
+	return g, nil
+}
+
+/*
+Validate checks AVPs of the message with grammar of the command in dictionary.
+avps is parsed from the message when it is nil.
+Content of Grouped AVP is also checked when grammar of the AVP is defined.
+Output error is diameter.InvalidAVP with MissingAvp, AvpOccursTooManyTimes,
+AvpNotAllowed or AvpUnsupported code, and its AVP is value for Failed-AVP.
+nil is returned for command without grammar.
+*/
+func Validate(m diameter.Message, avps []diameter.AVP) error {
+	gs := loadGrammars()
+	g, ok := gs.cmds[cmdKey{app: m.AppID, code: m.Code, req: m.FlgR}]
+	if !ok {
+		return nil
+	}
+	if avps == nil {
+		var e error
+		if avps, e = m.GetAVP(); e != nil {
+			return e
+		}
+	}
+	return g.validate(gs, avps)
+}
+
+// ValidateAVPs checks AVPs with grammar of the command in dictionary.
+// Output error is same as Validate.
+func ValidateAVPs(code, appID uint32, req bool, avps []diameter.AVP) error {
+	gs := loadGrammars()
+	g, ok := gs.cmds[cmdKey{app: appID, code: code, req: req}]
+	if !ok {
+		return nil
+	}
+	return g.validate(gs, avps)
+}
+
+func (g *grammar) validate(gs grammarSet, avps []diameter.AVP) error {
+	count := make(map[uint64]int, len(avps))
+	for _, a := range avps {
+		count[avpKey(a)]++
+	}
+
+	for i, r := range g.fixed {
+		if count[r.key] == 0 {
+			continue
+		}
+		if i >= len(avps) || avpKey(avps[i]) != r.key {
+			// fixed AVP is present at other position
+			return diameter.InvalidAVP{
+				Code: diameter.AvpNotAllowed, AVP: occurrence(avps, r.key, 0),
+				E: fmt.Errorf("%s must be at position %d", r.name, i+1)}
+		}
+	}
+
+	for _, r := range g.rules {
+		c := count[r.key]
+		if c < r.min {
+			return diameter.InvalidAVP{
+				Code: diameter.MissingAvp, AVP: r.example,
+				E: errors.New(r.name + " is missing")}
+		}
+		if r.max >= 0 && c > r.max {
+			code := diameter.AvpOccursTooManyTimes
+			if r.max == 0 {
+				code = diameter.AvpNotAllowed
+			}
+			return diameter.InvalidAVP{
+				Code: code, AVP: occurrence(avps, r.key, r.max),
+				E: fmt.Errorf("%s occurs %d times", r.name, c)}
+		}
+		delete(count, r.key)
+	}
+
+	for _, a := range avps {
+		if _, ok := count[avpKey(a)]; ok && !g.any && a.Mandatory {
+			return diameter.InvalidAVP{
+				Code: diameter.AvpUnsupported, AVP: a,
+				E: errors.New("AVP is not defined in grammar")}
+		}
+
+		sub, ok := gs.avps[avpKey(a)]
+		if !ok {
+			continue
+		}
+		var inner []diameter.AVP
+		if e := a.Decode(&inner); e != nil {
+			return diameter.InvalidAVP{Code: diameter.InvalidAvpValue, AVP: a, E: e}
+		}
+		if e := sub.validate(gs, inner); e != nil {
+			// Failed-AVP contains entire Grouped AVP hierarchy
+			if iavp, ok := e.(diameter.InvalidAVP); ok {
+				a.Encode([]diameter.AVP{iavp.AVP})
+				iavp.AVP = a
+				e = iavp
+			}
+			return e
+		}
+	}
+	return nil
+}
+
+func avpKey(a diameter.AVP) uint64 {
+	return (uint64(a.VendorID) << 32) | uint64(a.Code)
+}
+
+// occurrence returns AVP of the key that exceeds max.
+func occurrence(avps []diameter.AVP, key uint64, max int) diameter.AVP {
+	for _, a := range avps {
+		if avpKey(a) != key {
+			continue
+		}
+		if max == 0 {
+			return a
+		}
+		max--
+	}
+	return diameter.AVP{}
+}
+
+// exampleAVP returns AVP with zero filled value of minimum length for the type.
+func exampleAVP(code, vid uint32, m bool, typ string) diameter.AVP {
+	l := 0
+	switch typ {
+	case "Integer32", "Unsigned32", "Float32", "Enumerated", "Time":
+		l = 4
+	case "Integer64", "Unsigned64", "Float64":
+		l = 8
+	case "Address":
+		l = 6
+	}
+	return diameter.AVP{
+		Code: code, VendorID: vid, Mandatory: m, Data: make([]byte, l)}
+}
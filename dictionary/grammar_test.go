@@ -0,0 +1,91 @@
+package dictionary
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/fkgi/diameter"
+)
+
+func TestValidateMisplacedFixed(t *testing.T) {
+	sid := diameter.SetSessionID("a.local;1;2")
+	host := diameter.SetOriginHost("a.local")
+	r := rule{key: avpKey(sid), name: "Session-Id",
+		example: exampleAVP(263, 0, true, "UTF8String"), min: 1, max: 1}
+	g := &grammar{fixed: []rule{r}, rules: []rule{r}, any: true}
+
+	if e := g.validate(grammarSet{}, []diameter.AVP{sid, host}); e != nil {
+		t.Fatalf("valid AVPs are rejected: %v", e)
+	}
+
+	var iavp diameter.InvalidAVP
+	if e := g.validate(grammarSet{}, []diameter.AVP{host, sid}); !errors.As(e, &iavp) {
+		t.Fatalf("error is %v, not InvalidAVP", e)
+	}
+	if iavp.Code != diameter.AvpNotAllowed {
+		t.Errorf("result is %d, not AvpNotAllowed", iavp.Code)
+	}
+	if iavp.AVP.Code != sid.Code || string(iavp.AVP.Data) != string(sid.Data) {
+		t.Errorf("Failed-AVP is %v, not misplaced Session-Id", iavp.AVP)
+	}
+
+	if e := g.validate(grammarSet{}, []diameter.AVP{host}); !errors.As(e, &iavp) ||
+		iavp.Code != diameter.MissingAvp {
+		t.Errorf("missing Session-Id is not reported: %v", e)
+	}
+}
+
+func TestLoadDictionaryGrammar(t *testing.T) {
+	dict := func(vid int, cmd, avp, rule string) []byte {
+		return []byte(fmt.Sprintf(`<dictionary>
+	<vendor name="Test%[1]d" id="%[1]d">
+		<application name="Test%[1]d" id="%[1]d">
+			<command name="Test%[1]d-%[2]s" id="1">
+				<request><required name="%[4]s" /></request>
+			</command>
+		</application>
+		%[3]s
+	</vendor>
+</dictionary>`, vid, cmd, avp, rule))
+	}
+
+	if _, e := LoadDictionary(dict(99, "A",
+		`<avp name="Test99-AVP" id="1" type="Unsigned32" mandatory="true" />`,
+		"Test99-AVP")); e != nil {
+		t.Fatal(e)
+	}
+	var iavp diameter.InvalidAVP
+	if e := ValidateAVPs(1, 99, true, nil); !errors.As(e, &iavp) || iavp.Code != diameter.MissingAvp {
+		t.Errorf("grammar is not loaded: %v", e)
+	}
+
+	// grammars are validated while other dictionary is loaded
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			ValidateAVPs(1, 99, true, nil)
+		}
+	}()
+	if _, e := LoadDictionary(dict(98, "A",
+		`<avp name="Test98-AVP" id="1" type="Unsigned32" mandatory="true" />`,
+		"Test98-AVP")); e != nil {
+		t.Fatal(e)
+	}
+	<-done
+	if e := ValidateAVPs(1, 98, true, nil); !errors.As(e, &iavp) || iavp.Code != diameter.MissingAvp {
+		t.Errorf("grammar is not loaded: %v", e)
+	}
+
+	// failed dictionary does not change grammars
+	if _, e := LoadDictionary(dict(97, "A", "", "Unknown-AVP")); e == nil {
+		t.Fatal("grammar with unknown AVP is loaded")
+	}
+	if e := ValidateAVPs(1, 97, true, nil); e != nil {
+		t.Errorf("grammar of failed dictionary is loaded: %v", e)
+	}
+	if e := ValidateAVPs(1, 99, true, nil); e == nil {
+		t.Error("grammar is removed by failed dictionary")
+	}
+}
@@ -2,7 +2,9 @@ package dictionary
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 
@@ -38,8 +40,20 @@ func registerHandler(p Post, path string, cid, aid, vid uint32, rt diameter.Rout
 			}
 		}
 
+		var iavp diameter.InvalidAVP
+		if e := ValidateAVPs(cid, aid, true, avps); errors.As(e, &iavp) {
+			return diameterErr(avps, iavp.Code,
+				"invalid AVP for the command: "+e.Error(), iavp.AVP)
+		} else if e != nil {
+			return diameterErr(avps, diameter.InvalidAvpValue,
+				"invalid AVP for the command: "+e.Error())
+		}
+
 		data, e := DecodeAVPs(avps)
-		if e != nil {
+		if errors.As(e, &iavp) {
+			return diameterErr(avps, iavp.Code,
+				"unable to decode Diameter AVP by dictionary: "+e.Error(), iavp.AVP)
+		} else if e != nil {
 			return diameterErr(avps, diameter.InvalidAvpValue,
 				"unable to decode Diameter AVP by dictionary: "+e.Error())
 		}
@@ -100,7 +114,7 @@ func registerHandler(p Post, path string, cid, aid, vid uint32, rt diameter.Rout
 
 		return false, avps
 	}
-	handleTx := diameter.Handle(cid, aid, vid, serveDiameter, rt)
+	handleTx := diameter.HandleContext(cid, aid, vid, serveDiameter, rt)
 
 	serveHttp := func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
@@ -151,12 +165,31 @@ func registerHandler(p Post, path string, cid, aid, vid uint32, rt diameter.Rout
 		if route != "" {
 			avps = append(avps, diameter.SetRouteRecord(route))
 		}
+		if e = ValidateAVPs(cid, aid, true, avps); e != nil {
+			httpErr("invalid Diameter AVPs for the command", e.Error(),
+				http.StatusBadRequest, w)
+			return
+		}
 
 		retry := false
 		if r.Header.Get("X-Retry") == "true" {
 			retry = true
 		}
-		_, avps = handleTx(retry, avps)
+		ctx, cancel := context.WithTimeout(r.Context(), diameter.WDInterval)
+		defer cancel()
+		_, avps, e = handleTx(ctx, retry, avps)
+		if errors.Is(e, context.Canceled) {
+			if NotifyHandlerError != nil {
+				NotifyHandlerError("HTTP", "request is canceled by client: "+e.Error())
+			}
+			return
+		} else if errors.Is(e, context.DeadlineExceeded) {
+			_, avps = diameterErr(nil, diameter.TooBusy,
+				"no answer from Diameter peer: "+e.Error())
+		} else if e != nil {
+			_, avps = diameterErr(nil, diameter.UnableToDeliver,
+				"unable to send Diameter request: "+e.Error())
+		}
 
 		if data, e = DecodeAVPs(avps); e != nil {
 			httpErr("unable to decode Diameter AVP by dictionary", e.Error(),
@@ -191,7 +224,7 @@ func httpErr(title, detail string, code int, w http.ResponseWriter) {
 	w.Write(data)
 }
 
-func diameterErr(avp []diameter.AVP, code uint32, err string) (bool, []diameter.AVP) {
+func diameterErr(avp []diameter.AVP, code uint32, err string, failed ...diameter.AVP) (bool, []diameter.AVP) {
 	if NotifyHandlerError != nil {
 		NotifyHandlerError("Diameter", err)
 	}
@@ -212,6 +245,10 @@ func diameterErr(avp []diameter.AVP, code uint32, err string) (bool, []diameter.
 	ret = append(ret, diameter.SetOriginHost(diameter.Host))
 	ret = append(ret, diameter.SetOriginRealm(diameter.Realm))
 	ret = append(ret, diameter.SetErrorMessage(err))
+	if len(failed) != 0 {
+		ret = append(ret, diameter.SetFailedAVP(failed))
+	}
 
-	return true, ret
+	// E bit is set only for protocol error
+	return diameter.IsProtocolError(code), ret
 }
@@ -45,6 +45,14 @@ func TraceMessageVarbose(prefix string, msg diameter.Message) string {
 		"%sHop-by-Hop-ID=%#x, End-to-End-ID=%#x",
 		prefix, msg.HbHID, msg.EtEID)
 	fmt.Fprintln(buf)
+	if msg.FlgR {
+	} else if vid, code, ok := msg.Result(); ok && vid != 0 {
+		fmt.Fprintf(buf, "%sExperimental-Result=%d (Vendor-Id=%d)", prefix, code, vid)
+		fmt.Fprintln(buf)
+	} else if ok {
+		fmt.Fprintf(buf, "%sResult-Code=%d", prefix, code)
+		fmt.Fprintln(buf)
+	}
 
 	avps, e := msg.GetAVP()
 	if e != nil {
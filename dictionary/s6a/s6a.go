@@ -0,0 +1,13534 @@
+// Code generated by generator from s6a.xml. DO NOT EDIT.
+
+package s6a
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/fkgi/diameter"
+)
+
+// S6aApplicationID is Application-ID of S6a.
+const S6aApplicationID uint32 = 16777251
+
+// UpdateLocationCode is Command-Code of Update-Location.
+const UpdateLocationCode uint32 = 316
+
+// UpdateLocationRequest is Update-Location-Request message of S6a.
+type UpdateLocationRequest struct {
+	SessionId                                  SessionId                                   // < Session-Id >
+	AuthSessionState                           AuthSessionState                            // { Auth-Session-State }
+	OriginHost                                 OriginHost                                  // { Origin-Host }
+	OriginRealm                                OriginRealm                                 // { Origin-Realm }
+	DestinationRealm                           DestinationRealm                            // { Destination-Realm }
+	UserName                                   UserName                                    // { User-Name }
+	RATType                                    RATType                                     // { RAT-Type }
+	ULRFlags                                   ULRFlags                                    // { ULR-Flags }
+	VisitedPLMNId                              VisitedPLMNId                               // { Visited-PLMN-Id }
+	DRMP                                       *DRMP                                       // [ DRMP ]
+	VendorSpecificApplicationId                *VendorSpecificApplicationId                // [ Vendor-Specific-Application-Id ]
+	DestinationHost                            *DestinationHost                            // [ Destination-Host ]
+	OCSupportedFeatures                        *OCSupportedFeatures                        // [ OC-Supported-Features ]
+	SupportedFeatures                          []SupportedFeatures                         // *[ Supported-Features ]
+	TerminalInformation                        *TerminalInformation                        // [ Terminal-Information ]
+	UESRVCCCapability                          *UESRVCCCapability                          // [ UE-SRVCC-Capability ]
+	SGSNNumber                                 *SGSNNumber                                 // [ SGSN-Number ]
+	HomogeneousSupportOfIMSVoiceOverPSSessions *HomogeneousSupportOfIMSVoiceOverPSSessions // [ Homogeneous-Support-of-IMS-Voice-Over-PS-Sessions ]
+	GMLCAddress                                *GMLCAddress                                // [ GMLC-Address ]
+	ActiveAPN                                  []ActiveAPN                                 // *[ Active-APN ]
+	MMENumberForMTSMS                          *MMENumberForMTSMS                          // [ MME-Number-for-MT-SMS ]
+	SMSRegisterRequest                         *SMSRegisterRequest                         // [ SMS-Register-Request ]
+	SGsMMEIdentity                             *SGsMMEIdentity                             // [ SGs-MME-Identity ]
+	CoupledNodeDiameterID                      *CoupledNodeDiameterID                      // [ Coupled-Node-Diameter-ID ]
+	AdjacentPLMNs                              *AdjacentPLMNs                              // [ Adjacent-PLMNs ]
+	SupportedServices                          *SupportedServices                          // [ Supported-Services ]
+	AVP                                        []diameter.AVP                              // *[ AVP ]
+	ProxyInfo                                  []ProxyInfo                                 // *[ Proxy-Info ]
+	RouteRecord                                []RouteRecord                               // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Update-Location-Request.
+func (v UpdateLocationRequest) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 29)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	avps = append(avps, v.DestinationRealm.ToAVP())
+	avps = append(avps, v.UserName.ToAVP())
+	avps = append(avps, v.RATType.ToAVP())
+	avps = append(avps, v.ULRFlags.ToAVP())
+	avps = append(avps, v.VisitedPLMNId.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	if v.DestinationHost != nil {
+		avps = append(avps, v.DestinationHost.ToAVP())
+	}
+	if v.OCSupportedFeatures != nil {
+		avps = append(avps, v.OCSupportedFeatures.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.TerminalInformation != nil {
+		avps = append(avps, v.TerminalInformation.ToAVP())
+	}
+	if v.UESRVCCCapability != nil {
+		avps = append(avps, v.UESRVCCCapability.ToAVP())
+	}
+	if v.SGSNNumber != nil {
+		avps = append(avps, v.SGSNNumber.ToAVP())
+	}
+	if v.HomogeneousSupportOfIMSVoiceOverPSSessions != nil {
+		avps = append(avps, v.HomogeneousSupportOfIMSVoiceOverPSSessions.ToAVP())
+	}
+	if v.GMLCAddress != nil {
+		avps = append(avps, v.GMLCAddress.ToAVP())
+	}
+	for _, a := range v.ActiveAPN {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.MMENumberForMTSMS != nil {
+		avps = append(avps, v.MMENumberForMTSMS.ToAVP())
+	}
+	if v.SMSRegisterRequest != nil {
+		avps = append(avps, v.SMSRegisterRequest.ToAVP())
+	}
+	if v.SGsMMEIdentity != nil {
+		avps = append(avps, v.SGsMMEIdentity.ToAVP())
+	}
+	if v.CoupledNodeDiameterID != nil {
+		avps = append(avps, v.CoupledNodeDiameterID.ToAVP())
+	}
+	if v.AdjacentPLMNs != nil {
+		avps = append(avps, v.AdjacentPLMNs.ToAVP())
+	}
+	if v.SupportedServices != nil {
+		avps = append(avps, v.SupportedServices.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Update-Location-Request.
+func (v *UpdateLocationRequest) FromAVPs(avps []diameter.AVP) error {
+	*v = UpdateLocationRequest{}
+	var seen [9]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 283: // Destination-Realm
+			e = decodeOne(a, &v.DestinationRealm, &seen[4])
+		case 1: // User-Name
+			e = decodeOne(a, &v.UserName, &seen[5])
+		case 10415<<32 | 1032: // RAT-Type
+			e = decodeOne(a, &v.RATType, &seen[6])
+		case 10415<<32 | 1405: // ULR-Flags
+			e = decodeOne(a, &v.ULRFlags, &seen[7])
+		case 10415<<32 | 1407: // Visited-PLMN-Id
+			e = decodeOne(a, &v.VisitedPLMNId, &seen[8])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 293: // Destination-Host
+			e = decodeOptional(a, &v.DestinationHost)
+		case 621: // OC-Supported-Features
+			e = decodeOptional(a, &v.OCSupportedFeatures)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 1401: // Terminal-Information
+			e = decodeOptional(a, &v.TerminalInformation)
+		case 10415<<32 | 1615: // UE-SRVCC-Capability
+			e = decodeOptional(a, &v.UESRVCCCapability)
+		case 10415<<32 | 1489: // SGSN-Number
+			e = decodeOptional(a, &v.SGSNNumber)
+		case 10415<<32 | 1493: // Homogeneous-Support-of-IMS-Voice-Over-PS-Sessions
+			e = decodeOptional(a, &v.HomogeneousSupportOfIMSVoiceOverPSSessions)
+		case 10415<<32 | 2405: // GMLC-Address
+			e = decodeOptional(a, &v.GMLCAddress)
+		case 10415<<32 | 1612: // Active-APN
+			e = decodeMulti(a, &v.ActiveAPN, -1)
+		case 10415<<32 | 1645: // MME-Number-for-MT-SMS
+			e = decodeOptional(a, &v.MMENumberForMTSMS)
+		case 10415<<32 | 1648: // SMS-Register-Request
+			e = decodeOptional(a, &v.SMSRegisterRequest)
+		case 10415<<32 | 1664: // SGs-MME-Identity
+			e = decodeOptional(a, &v.SGsMMEIdentity)
+		case 10415<<32 | 1666: // Coupled-Node-Diameter-ID
+			e = decodeOptional(a, &v.CoupledNodeDiameterID)
+		case 10415<<32 | 1672: // Adjacent-PLMNs
+			e = decodeOptional(a, &v.AdjacentPLMNs)
+		case 10415<<32 | 3143: // Supported-Services
+			e = decodeOptional(a, &v.SupportedServices)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	if !seen[4] {
+		return missingAVP(283, 0, true)
+	}
+	if !seen[5] {
+		return missingAVP(1, 0, true)
+	}
+	if !seen[6] {
+		return missingAVP(1032, 10415, true)
+	}
+	if !seen[7] {
+		return missingAVP(1405, 10415, true)
+	}
+	if !seen[8] {
+		return missingAVP(1407, 10415, true)
+	}
+	return nil
+}
+
+// ToMessage makes Update-Location-Request message.
+func (v UpdateLocationRequest) ToMessage() diameter.Message {
+	return diameter.NewRequest(UpdateLocationCode, S6aApplicationID).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Update-Location-Request message.
+func (v *UpdateLocationRequest) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, true, UpdateLocationCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// UpdateLocationAnswer is Update-Location-Answer message of S6a.
+type UpdateLocationAnswer struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	ResultCode                  *ResultCode                  // [ Result-Code ]
+	ExperimentalResult          *ExperimentalResult          // [ Experimental-Result ]
+	ErrorDiagnostic             *ErrorDiagnostic             // [ Error-Diagnostic ]
+	OCSupportedFeatures         *OCSupportedFeatures         // [ OC-Supported-Features ]
+	OCOLR                       *OCOLR                       // [ OC-OLR ]
+	Load                        []Load                       // *[ Load ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	ULAFlags                    *ULAFlags                    // [ ULA-Flags ]
+	SubscriptionData            *SubscriptionData            // [ Subscription-Data ]
+	ResetID                     []ResetID                    // *[ Reset-ID ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	FailedAVP                   *FailedAVP                   // [ Failed-AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Update-Location-Answer.
+func (v UpdateLocationAnswer) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 20)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	if v.ResultCode != nil {
+		avps = append(avps, v.ResultCode.ToAVP())
+	}
+	if v.ExperimentalResult != nil {
+		avps = append(avps, v.ExperimentalResult.ToAVP())
+	}
+	if v.ErrorDiagnostic != nil {
+		avps = append(avps, v.ErrorDiagnostic.ToAVP())
+	}
+	if v.OCSupportedFeatures != nil {
+		avps = append(avps, v.OCSupportedFeatures.ToAVP())
+	}
+	if v.OCOLR != nil {
+		avps = append(avps, v.OCOLR.ToAVP())
+	}
+	for _, a := range v.Load {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.ULAFlags != nil {
+		avps = append(avps, v.ULAFlags.ToAVP())
+	}
+	if v.SubscriptionData != nil {
+		avps = append(avps, v.SubscriptionData.ToAVP())
+	}
+	for _, a := range v.ResetID {
+		avps = append(avps, a.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	if v.FailedAVP != nil {
+		avps = append(avps, v.FailedAVP.ToAVP())
+	}
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Update-Location-Answer.
+func (v *UpdateLocationAnswer) FromAVPs(avps []diameter.AVP) error {
+	*v = UpdateLocationAnswer{}
+	var seen [4]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 268: // Result-Code
+			e = decodeOptional(a, &v.ResultCode)
+		case 297: // Experimental-Result
+			e = decodeOptional(a, &v.ExperimentalResult)
+		case 10415<<32 | 1614: // Error-Diagnostic
+			e = decodeOptional(a, &v.ErrorDiagnostic)
+		case 621: // OC-Supported-Features
+			e = decodeOptional(a, &v.OCSupportedFeatures)
+		case 623: // OC-OLR
+			e = decodeOptional(a, &v.OCOLR)
+		case 650: // Load
+			e = decodeMulti(a, &v.Load, -1)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 1406: // ULA-Flags
+			e = decodeOptional(a, &v.ULAFlags)
+		case 10415<<32 | 1400: // Subscription-Data
+			e = decodeOptional(a, &v.SubscriptionData)
+		case 10415<<32 | 1670: // Reset-ID
+			e = decodeMulti(a, &v.ResetID, -1)
+		case 279: // Failed-AVP
+			e = decodeOptional(a, &v.FailedAVP)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	return nil
+}
+
+// ToMessage makes Update-Location-Answer message for the request.
+func (v UpdateLocationAnswer) ToMessage(req diameter.Message) diameter.Message {
+	return diameter.NewAnswer(req).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Update-Location-Answer message.
+func (v *UpdateLocationAnswer) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, false, UpdateLocationCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// CancelLocationCode is Command-Code of Cancel-Location.
+const CancelLocationCode uint32 = 317
+
+// CancelLocationRequest is Cancel-Location-Request message of S6a.
+type CancelLocationRequest struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DestinationHost             DestinationHost              // { Destination-Host }
+	DestinationRealm            DestinationRealm             // { Destination-Realm }
+	UserName                    UserName                     // { User-Name }
+	CancellationType            CancellationType             // { Cancellation-Type }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	CLRFlags                    *CLRFlags                    // [ CLR-Flags ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Cancel-Location-Request.
+func (v CancelLocationRequest) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 15)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	avps = append(avps, v.DestinationHost.ToAVP())
+	avps = append(avps, v.DestinationRealm.ToAVP())
+	avps = append(avps, v.UserName.ToAVP())
+	avps = append(avps, v.CancellationType.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.CLRFlags != nil {
+		avps = append(avps, v.CLRFlags.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Cancel-Location-Request.
+func (v *CancelLocationRequest) FromAVPs(avps []diameter.AVP) error {
+	*v = CancelLocationRequest{}
+	var seen [8]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 293: // Destination-Host
+			e = decodeOne(a, &v.DestinationHost, &seen[4])
+		case 283: // Destination-Realm
+			e = decodeOne(a, &v.DestinationRealm, &seen[5])
+		case 1: // User-Name
+			e = decodeOne(a, &v.UserName, &seen[6])
+		case 10415<<32 | 1420: // Cancellation-Type
+			e = decodeOne(a, &v.CancellationType, &seen[7])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 1638: // CLR-Flags
+			e = decodeOptional(a, &v.CLRFlags)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	if !seen[4] {
+		return missingAVP(293, 0, true)
+	}
+	if !seen[5] {
+		return missingAVP(283, 0, true)
+	}
+	if !seen[6] {
+		return missingAVP(1, 0, true)
+	}
+	if !seen[7] {
+		return missingAVP(1420, 10415, true)
+	}
+	return nil
+}
+
+// ToMessage makes Cancel-Location-Request message.
+func (v CancelLocationRequest) ToMessage() diameter.Message {
+	return diameter.NewRequest(CancelLocationCode, S6aApplicationID).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Cancel-Location-Request message.
+func (v *CancelLocationRequest) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, true, CancelLocationCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// CancelLocationAnswer is Cancel-Location-Answer message of S6a.
+type CancelLocationAnswer struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	ResultCode                  *ResultCode                  // [ Result-Code ]
+	ExperimentalResult          *ExperimentalResult          // [ Experimental-Result ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	FailedAVP                   *FailedAVP                   // [ Failed-AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Cancel-Location-Answer.
+func (v CancelLocationAnswer) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 13)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.ResultCode != nil {
+		avps = append(avps, v.ResultCode.ToAVP())
+	}
+	if v.ExperimentalResult != nil {
+		avps = append(avps, v.ExperimentalResult.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	if v.FailedAVP != nil {
+		avps = append(avps, v.FailedAVP.ToAVP())
+	}
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Cancel-Location-Answer.
+func (v *CancelLocationAnswer) FromAVPs(avps []diameter.AVP) error {
+	*v = CancelLocationAnswer{}
+	var seen [4]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 268: // Result-Code
+			e = decodeOptional(a, &v.ResultCode)
+		case 297: // Experimental-Result
+			e = decodeOptional(a, &v.ExperimentalResult)
+		case 279: // Failed-AVP
+			e = decodeOptional(a, &v.FailedAVP)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	return nil
+}
+
+// ToMessage makes Cancel-Location-Answer message for the request.
+func (v CancelLocationAnswer) ToMessage(req diameter.Message) diameter.Message {
+	return diameter.NewAnswer(req).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Cancel-Location-Answer message.
+func (v *CancelLocationAnswer) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, false, CancelLocationCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// AuthenticationInformationCode is Command-Code of Authentication-Information.
+const AuthenticationInformationCode uint32 = 318
+
+// AuthenticationInformationRequest is Authentication-Information-Request message of S6a.
+type AuthenticationInformationRequest struct {
+	SessionId                             SessionId                              // < Session-Id >
+	AuthSessionState                      AuthSessionState                       // { Auth-Session-State }
+	OriginHost                            OriginHost                             // { Origin-Host }
+	OriginRealm                           OriginRealm                            // { Origin-Realm }
+	DestinationRealm                      DestinationRealm                       // { Destination-Realm }
+	UserName                              UserName                               // { User-Name }
+	VisitedPLMNId                         VisitedPLMNId                          // { Visited-PLMN-Id }
+	DRMP                                  *DRMP                                  // [ DRMP ]
+	VendorSpecificApplicationId           *VendorSpecificApplicationId           // [ Vendor-Specific-Application-Id ]
+	DestinationHost                       *DestinationHost                       // [ Destination-Host ]
+	OCSupportedFeatures                   *OCSupportedFeatures                   // [ OC-Supported-Features ]
+	SupportedFeatures                     []SupportedFeatures                    // *[ Supported-Features ]
+	RequestedEUTRANAuthenticationInfo     *RequestedEUTRANAuthenticationInfo     // [ Requested-EUTRAN-Authentication-Info ]
+	RequestedUTRANGERANAuthenticationInfo *RequestedUTRANGERANAuthenticationInfo // [ Requested-UTRAN-GERAN-Authentication-Info ]
+	AIRFlags                              *AIRFlags                              // [ AIR-Flags ]
+	AVP                                   []diameter.AVP                         // *[ AVP ]
+	ProxyInfo                             []ProxyInfo                            // *[ Proxy-Info ]
+	RouteRecord                           []RouteRecord                          // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Authentication-Information-Request.
+func (v AuthenticationInformationRequest) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 18)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	avps = append(avps, v.DestinationRealm.ToAVP())
+	avps = append(avps, v.UserName.ToAVP())
+	avps = append(avps, v.VisitedPLMNId.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	if v.DestinationHost != nil {
+		avps = append(avps, v.DestinationHost.ToAVP())
+	}
+	if v.OCSupportedFeatures != nil {
+		avps = append(avps, v.OCSupportedFeatures.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.RequestedEUTRANAuthenticationInfo != nil {
+		avps = append(avps, v.RequestedEUTRANAuthenticationInfo.ToAVP())
+	}
+	if v.RequestedUTRANGERANAuthenticationInfo != nil {
+		avps = append(avps, v.RequestedUTRANGERANAuthenticationInfo.ToAVP())
+	}
+	if v.AIRFlags != nil {
+		avps = append(avps, v.AIRFlags.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Authentication-Information-Request.
+func (v *AuthenticationInformationRequest) FromAVPs(avps []diameter.AVP) error {
+	*v = AuthenticationInformationRequest{}
+	var seen [7]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 283: // Destination-Realm
+			e = decodeOne(a, &v.DestinationRealm, &seen[4])
+		case 1: // User-Name
+			e = decodeOne(a, &v.UserName, &seen[5])
+		case 10415<<32 | 1407: // Visited-PLMN-Id
+			e = decodeOne(a, &v.VisitedPLMNId, &seen[6])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 293: // Destination-Host
+			e = decodeOptional(a, &v.DestinationHost)
+		case 621: // OC-Supported-Features
+			e = decodeOptional(a, &v.OCSupportedFeatures)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 1408: // Requested-EUTRAN-Authentication-Info
+			e = decodeOptional(a, &v.RequestedEUTRANAuthenticationInfo)
+		case 10415<<32 | 1409: // Requested-UTRAN-GERAN-Authentication-Info
+			e = decodeOptional(a, &v.RequestedUTRANGERANAuthenticationInfo)
+		case 10415<<32 | 1679: // AIR-Flags
+			e = decodeOptional(a, &v.AIRFlags)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	if !seen[4] {
+		return missingAVP(283, 0, true)
+	}
+	if !seen[5] {
+		return missingAVP(1, 0, true)
+	}
+	if !seen[6] {
+		return missingAVP(1407, 10415, true)
+	}
+	return nil
+}
+
+// ToMessage makes Authentication-Information-Request message.
+func (v AuthenticationInformationRequest) ToMessage() diameter.Message {
+	return diameter.NewRequest(AuthenticationInformationCode, S6aApplicationID).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Authentication-Information-Request message.
+func (v *AuthenticationInformationRequest) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, true, AuthenticationInformationCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// AuthenticationInformationAnswer is Authentication-Information-Answer message of S6a.
+type AuthenticationInformationAnswer struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	ResultCode                  *ResultCode                  // [ Result-Code ]
+	ExperimentalResult          *ExperimentalResult          // [ Experimental-Result ]
+	ErrorDiagnostic             *ErrorDiagnostic             // [ Error-Diagnostic ]
+	OCSupportedFeatures         *OCSupportedFeatures         // [ OC-Supported-Features ]
+	OCOLR                       *OCOLR                       // [ OC-OLR ]
+	Load                        []Load                       // *[ Load ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	AuthenticationInfo          *AuthenticationInfo          // [ Authentication-Info ]
+	UEUsageType                 *UEUsageType                 // [ UE-Usage-Type ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	FailedAVP                   *FailedAVP                   // [ Failed-AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Authentication-Information-Answer.
+func (v AuthenticationInformationAnswer) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 19)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	if v.ResultCode != nil {
+		avps = append(avps, v.ResultCode.ToAVP())
+	}
+	if v.ExperimentalResult != nil {
+		avps = append(avps, v.ExperimentalResult.ToAVP())
+	}
+	if v.ErrorDiagnostic != nil {
+		avps = append(avps, v.ErrorDiagnostic.ToAVP())
+	}
+	if v.OCSupportedFeatures != nil {
+		avps = append(avps, v.OCSupportedFeatures.ToAVP())
+	}
+	if v.OCOLR != nil {
+		avps = append(avps, v.OCOLR.ToAVP())
+	}
+	for _, a := range v.Load {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.AuthenticationInfo != nil {
+		avps = append(avps, v.AuthenticationInfo.ToAVP())
+	}
+	if v.UEUsageType != nil {
+		avps = append(avps, v.UEUsageType.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	if v.FailedAVP != nil {
+		avps = append(avps, v.FailedAVP.ToAVP())
+	}
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Authentication-Information-Answer.
+func (v *AuthenticationInformationAnswer) FromAVPs(avps []diameter.AVP) error {
+	*v = AuthenticationInformationAnswer{}
+	var seen [4]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 268: // Result-Code
+			e = decodeOptional(a, &v.ResultCode)
+		case 297: // Experimental-Result
+			e = decodeOptional(a, &v.ExperimentalResult)
+		case 10415<<32 | 1614: // Error-Diagnostic
+			e = decodeOptional(a, &v.ErrorDiagnostic)
+		case 621: // OC-Supported-Features
+			e = decodeOptional(a, &v.OCSupportedFeatures)
+		case 623: // OC-OLR
+			e = decodeOptional(a, &v.OCOLR)
+		case 650: // Load
+			e = decodeMulti(a, &v.Load, -1)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 1413: // Authentication-Info
+			e = decodeOptional(a, &v.AuthenticationInfo)
+		case 10415<<32 | 1680: // UE-Usage-Type
+			e = decodeOptional(a, &v.UEUsageType)
+		case 279: // Failed-AVP
+			e = decodeOptional(a, &v.FailedAVP)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	return nil
+}
+
+// ToMessage makes Authentication-Information-Answer message for the request.
+func (v AuthenticationInformationAnswer) ToMessage(req diameter.Message) diameter.Message {
+	return diameter.NewAnswer(req).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Authentication-Information-Answer message.
+func (v *AuthenticationInformationAnswer) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, false, AuthenticationInformationCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// InsertSubscriberDataCode is Command-Code of Insert-Subscriber-Data.
+const InsertSubscriberDataCode uint32 = 319
+
+// InsertSubscriberDataRequest is Insert-Subscriber-Data-Request message of S6a.
+type InsertSubscriberDataRequest struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DestinationHost             DestinationHost              // { Destination-Host }
+	DestinationRealm            DestinationRealm             // { Destination-Realm }
+	UserName                    UserName                     // { User-Name }
+	SubscriptionData            SubscriptionData             // { Subscription-Data }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	IDRFlags                    *IDRFlags                    // [ IDR-Flags ]
+	ResetID                     []ResetID                    // *[ Reset-ID ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Insert-Subscriber-Data-Request.
+func (v InsertSubscriberDataRequest) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 16)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	avps = append(avps, v.DestinationHost.ToAVP())
+	avps = append(avps, v.DestinationRealm.ToAVP())
+	avps = append(avps, v.UserName.ToAVP())
+	avps = append(avps, v.SubscriptionData.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.IDRFlags != nil {
+		avps = append(avps, v.IDRFlags.ToAVP())
+	}
+	for _, a := range v.ResetID {
+		avps = append(avps, a.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Insert-Subscriber-Data-Request.
+func (v *InsertSubscriberDataRequest) FromAVPs(avps []diameter.AVP) error {
+	*v = InsertSubscriberDataRequest{}
+	var seen [8]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 293: // Destination-Host
+			e = decodeOne(a, &v.DestinationHost, &seen[4])
+		case 283: // Destination-Realm
+			e = decodeOne(a, &v.DestinationRealm, &seen[5])
+		case 1: // User-Name
+			e = decodeOne(a, &v.UserName, &seen[6])
+		case 10415<<32 | 1400: // Subscription-Data
+			e = decodeOne(a, &v.SubscriptionData, &seen[7])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 1490: // IDR-Flags
+			e = decodeOptional(a, &v.IDRFlags)
+		case 10415<<32 | 1670: // Reset-ID
+			e = decodeMulti(a, &v.ResetID, -1)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	if !seen[4] {
+		return missingAVP(293, 0, true)
+	}
+	if !seen[5] {
+		return missingAVP(283, 0, true)
+	}
+	if !seen[6] {
+		return missingAVP(1, 0, true)
+	}
+	if !seen[7] {
+		return missingAVP(1400, 10415, true)
+	}
+	return nil
+}
+
+// ToMessage makes Insert-Subscriber-Data-Request message.
+func (v InsertSubscriberDataRequest) ToMessage() diameter.Message {
+	return diameter.NewRequest(InsertSubscriberDataCode, S6aApplicationID).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Insert-Subscriber-Data-Request message.
+func (v *InsertSubscriberDataRequest) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, true, InsertSubscriberDataCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// InsertSubscriberDataAnswer is Insert-Subscriber-Data-Answer message of S6a.
+type InsertSubscriberDataAnswer struct {
+	SessionId                       SessionId                        // < Session-Id >
+	AuthSessionState                AuthSessionState                 // { Auth-Session-State }
+	OriginHost                      OriginHost                       // { Origin-Host }
+	OriginRealm                     OriginRealm                      // { Origin-Realm }
+	DRMP                            *DRMP                            // [ DRMP ]
+	VendorSpecificApplicationId     *VendorSpecificApplicationId     // [ Vendor-Specific-Application-Id ]
+	SupportedFeatures               []SupportedFeatures              // *[ Supported-Features ]
+	ResultCode                      *ResultCode                      // [ Result-Code ]
+	ExperimentalResult              *ExperimentalResult              // [ Experimental-Result ]
+	IMSVoiceOverPSSessionsSupported *IMSVoiceOverPSSessionsSupported // [ IMS-Voice-Over-PS-Sessions-Supported ]
+	LastUEActivityTime              *LastUEActivityTime              // [ Last-UE-Activity-Time ]
+	RATType                         *RATType                         // [ RAT-Type ]
+	IDAFlags                        *IDAFlags                        // [ IDA-Flags ]
+	EPSUserState                    *EPSUserState                    // [ EPS-User-State ]
+	EPSLocationInformation          *EPSLocationInformation          // [ EPS-Location-Information ]
+	LocalTimeZone                   *LocalTimeZone                   // [ Local-Time-Zone ]
+	SupportedServices               *SupportedServices               // [ Supported-Services ]
+	MonitoringEventReport           []MonitoringEventReport          // *[ Monitoring-Event-Report ]
+	MonitoringEventConfigStatus     []MonitoringEventConfigStatus    // *[ Monitoring-Event-Config-Status ]
+	AVP                             []diameter.AVP                   // *[ AVP ]
+	FailedAVP                       *FailedAVP                       // [ Failed-AVP ]
+	ProxyInfo                       []ProxyInfo                      // *[ Proxy-Info ]
+	RouteRecord                     []RouteRecord                    // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Insert-Subscriber-Data-Answer.
+func (v InsertSubscriberDataAnswer) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 23)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.ResultCode != nil {
+		avps = append(avps, v.ResultCode.ToAVP())
+	}
+	if v.ExperimentalResult != nil {
+		avps = append(avps, v.ExperimentalResult.ToAVP())
+	}
+	if v.IMSVoiceOverPSSessionsSupported != nil {
+		avps = append(avps, v.IMSVoiceOverPSSessionsSupported.ToAVP())
+	}
+	if v.LastUEActivityTime != nil {
+		avps = append(avps, v.LastUEActivityTime.ToAVP())
+	}
+	if v.RATType != nil {
+		avps = append(avps, v.RATType.ToAVP())
+	}
+	if v.IDAFlags != nil {
+		avps = append(avps, v.IDAFlags.ToAVP())
+	}
+	if v.EPSUserState != nil {
+		avps = append(avps, v.EPSUserState.ToAVP())
+	}
+	if v.EPSLocationInformation != nil {
+		avps = append(avps, v.EPSLocationInformation.ToAVP())
+	}
+	if v.LocalTimeZone != nil {
+		avps = append(avps, v.LocalTimeZone.ToAVP())
+	}
+	if v.SupportedServices != nil {
+		avps = append(avps, v.SupportedServices.ToAVP())
+	}
+	for _, a := range v.MonitoringEventReport {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.MonitoringEventConfigStatus {
+		avps = append(avps, a.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	if v.FailedAVP != nil {
+		avps = append(avps, v.FailedAVP.ToAVP())
+	}
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Insert-Subscriber-Data-Answer.
+func (v *InsertSubscriberDataAnswer) FromAVPs(avps []diameter.AVP) error {
+	*v = InsertSubscriberDataAnswer{}
+	var seen [4]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 268: // Result-Code
+			e = decodeOptional(a, &v.ResultCode)
+		case 297: // Experimental-Result
+			e = decodeOptional(a, &v.ExperimentalResult)
+		case 10415<<32 | 1492: // IMS-Voice-Over-PS-Sessions-Supported
+			e = decodeOptional(a, &v.IMSVoiceOverPSSessionsSupported)
+		case 10415<<32 | 1494: // Last-UE-Activity-Time
+			e = decodeOptional(a, &v.LastUEActivityTime)
+		case 10415<<32 | 1032: // RAT-Type
+			e = decodeOptional(a, &v.RATType)
+		case 10415<<32 | 1441: // IDA-Flags
+			e = decodeOptional(a, &v.IDAFlags)
+		case 10415<<32 | 1495: // EPS-User-State
+			e = decodeOptional(a, &v.EPSUserState)
+		case 10415<<32 | 1496: // EPS-Location-Information
+			e = decodeOptional(a, &v.EPSLocationInformation)
+		case 10415<<32 | 1649: // Local-Time-Zone
+			e = decodeOptional(a, &v.LocalTimeZone)
+		case 10415<<32 | 3143: // Supported-Services
+			e = decodeOptional(a, &v.SupportedServices)
+		case 10415<<32 | 3123: // Monitoring-Event-Report
+			e = decodeMulti(a, &v.MonitoringEventReport, -1)
+		case 10415<<32 | 3142: // Monitoring-Event-Config-Status
+			e = decodeMulti(a, &v.MonitoringEventConfigStatus, -1)
+		case 279: // Failed-AVP
+			e = decodeOptional(a, &v.FailedAVP)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	return nil
+}
+
+// ToMessage makes Insert-Subscriber-Data-Answer message for the request.
+func (v InsertSubscriberDataAnswer) ToMessage(req diameter.Message) diameter.Message {
+	return diameter.NewAnswer(req).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Insert-Subscriber-Data-Answer message.
+func (v *InsertSubscriberDataAnswer) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, false, InsertSubscriberDataCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// DeleteSubscriberDataCode is Command-Code of Delete-Subscriber-Data.
+const DeleteSubscriberDataCode uint32 = 320
+
+// DeleteSubscriberDataRequest is Delete-Subscriber-Data-Request message of S6a.
+type DeleteSubscriberDataRequest struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DestinationHost             DestinationHost              // { Destination-Host }
+	DestinationRealm            DestinationRealm             // { Destination-Realm }
+	UserName                    UserName                     // { User-Name }
+	DSRFlags                    DSRFlags                     // { DSR-Flags }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	SCEFID                      *SCEFID                      // [ SCEF-ID ]
+	ContextIdentifier           []ContextIdentifier          // *[ Context-Identifier ]
+	TraceReference              *TraceReference              // [ Trace-Reference ]
+	TSCode                      []TSCode                     // *[ TS-Code ]
+	SSCode                      []SSCode                     // *[ SS-Code ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Delete-Subscriber-Data-Request.
+func (v DeleteSubscriberDataRequest) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 19)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	avps = append(avps, v.DestinationHost.ToAVP())
+	avps = append(avps, v.DestinationRealm.ToAVP())
+	avps = append(avps, v.UserName.ToAVP())
+	avps = append(avps, v.DSRFlags.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.SCEFID != nil {
+		avps = append(avps, v.SCEFID.ToAVP())
+	}
+	for _, a := range v.ContextIdentifier {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.TraceReference != nil {
+		avps = append(avps, v.TraceReference.ToAVP())
+	}
+	for _, a := range v.TSCode {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.SSCode {
+		avps = append(avps, a.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Delete-Subscriber-Data-Request.
+func (v *DeleteSubscriberDataRequest) FromAVPs(avps []diameter.AVP) error {
+	*v = DeleteSubscriberDataRequest{}
+	var seen [8]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 293: // Destination-Host
+			e = decodeOne(a, &v.DestinationHost, &seen[4])
+		case 283: // Destination-Realm
+			e = decodeOne(a, &v.DestinationRealm, &seen[5])
+		case 1: // User-Name
+			e = decodeOne(a, &v.UserName, &seen[6])
+		case 10415<<32 | 1421: // DSR-Flags
+			e = decodeOne(a, &v.DSRFlags, &seen[7])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 3125: // SCEF-ID
+			e = decodeOptional(a, &v.SCEFID)
+		case 10415<<32 | 1423: // Context-Identifier
+			e = decodeMulti(a, &v.ContextIdentifier, -1)
+		case 10415<<32 | 1459: // Trace-Reference
+			e = decodeOptional(a, &v.TraceReference)
+		case 10415<<32 | 1487: // TS-Code
+			e = decodeMulti(a, &v.TSCode, -1)
+		case 10415<<32 | 1476: // SS-Code
+			e = decodeMulti(a, &v.SSCode, -1)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	if !seen[4] {
+		return missingAVP(293, 0, true)
+	}
+	if !seen[5] {
+		return missingAVP(283, 0, true)
+	}
+	if !seen[6] {
+		return missingAVP(1, 0, true)
+	}
+	if !seen[7] {
+		return missingAVP(1421, 10415, true)
+	}
+	return nil
+}
+
+// ToMessage makes Delete-Subscriber-Data-Request message.
+func (v DeleteSubscriberDataRequest) ToMessage() diameter.Message {
+	return diameter.NewRequest(DeleteSubscriberDataCode, S6aApplicationID).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Delete-Subscriber-Data-Request message.
+func (v *DeleteSubscriberDataRequest) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, true, DeleteSubscriberDataCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// DeleteSubscriberDataAnswer is Delete-Subscriber-Data-Answer message of S6a.
+type DeleteSubscriberDataAnswer struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	ResultCode                  *ResultCode                  // [ Result-Code ]
+	ExperimentalResult          *ExperimentalResult          // [ Experimental-Result ]
+	DSAFlags                    *DSAFlags                    // [ DSA-Flags ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	FailedAVP                   *FailedAVP                   // [ Failed-AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Delete-Subscriber-Data-Answer.
+func (v DeleteSubscriberDataAnswer) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 14)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.ResultCode != nil {
+		avps = append(avps, v.ResultCode.ToAVP())
+	}
+	if v.ExperimentalResult != nil {
+		avps = append(avps, v.ExperimentalResult.ToAVP())
+	}
+	if v.DSAFlags != nil {
+		avps = append(avps, v.DSAFlags.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	if v.FailedAVP != nil {
+		avps = append(avps, v.FailedAVP.ToAVP())
+	}
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Delete-Subscriber-Data-Answer.
+func (v *DeleteSubscriberDataAnswer) FromAVPs(avps []diameter.AVP) error {
+	*v = DeleteSubscriberDataAnswer{}
+	var seen [4]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 268: // Result-Code
+			e = decodeOptional(a, &v.ResultCode)
+		case 297: // Experimental-Result
+			e = decodeOptional(a, &v.ExperimentalResult)
+		case 10415<<32 | 1422: // DSA-Flags
+			e = decodeOptional(a, &v.DSAFlags)
+		case 279: // Failed-AVP
+			e = decodeOptional(a, &v.FailedAVP)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	return nil
+}
+
+// ToMessage makes Delete-Subscriber-Data-Answer message for the request.
+func (v DeleteSubscriberDataAnswer) ToMessage(req diameter.Message) diameter.Message {
+	return diameter.NewAnswer(req).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Delete-Subscriber-Data-Answer message.
+func (v *DeleteSubscriberDataAnswer) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, false, DeleteSubscriberDataCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// PurgeUECode is Command-Code of Purge-UE.
+const PurgeUECode uint32 = 321
+
+// PurgeUERequest is Purge-UE-Request message of S6a.
+type PurgeUERequest struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DestinationRealm            DestinationRealm             // { Destination-Realm }
+	UserName                    UserName                     // { User-Name }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	DestinationHost             *DestinationHost             // [ Destination-Host ]
+	OCSupportedFeatures         *OCSupportedFeatures         // [ OC-Supported-Features ]
+	PURFlags                    *PURFlags                    // [ PUR-Flags ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	EPSLocationInformation      *EPSLocationInformation      // [ EPS-Location-Information ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Purge-UE-Request.
+func (v PurgeUERequest) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 16)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	avps = append(avps, v.DestinationRealm.ToAVP())
+	avps = append(avps, v.UserName.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	if v.DestinationHost != nil {
+		avps = append(avps, v.DestinationHost.ToAVP())
+	}
+	if v.OCSupportedFeatures != nil {
+		avps = append(avps, v.OCSupportedFeatures.ToAVP())
+	}
+	if v.PURFlags != nil {
+		avps = append(avps, v.PURFlags.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.EPSLocationInformation != nil {
+		avps = append(avps, v.EPSLocationInformation.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Purge-UE-Request.
+func (v *PurgeUERequest) FromAVPs(avps []diameter.AVP) error {
+	*v = PurgeUERequest{}
+	var seen [6]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 283: // Destination-Realm
+			e = decodeOne(a, &v.DestinationRealm, &seen[4])
+		case 1: // User-Name
+			e = decodeOne(a, &v.UserName, &seen[5])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 293: // Destination-Host
+			e = decodeOptional(a, &v.DestinationHost)
+		case 621: // OC-Supported-Features
+			e = decodeOptional(a, &v.OCSupportedFeatures)
+		case 10415<<32 | 1635: // PUR-Flags
+			e = decodeOptional(a, &v.PURFlags)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 1496: // EPS-Location-Information
+			e = decodeOptional(a, &v.EPSLocationInformation)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	if !seen[4] {
+		return missingAVP(283, 0, true)
+	}
+	if !seen[5] {
+		return missingAVP(1, 0, true)
+	}
+	return nil
+}
+
+// ToMessage makes Purge-UE-Request message.
+func (v PurgeUERequest) ToMessage() diameter.Message {
+	return diameter.NewRequest(PurgeUECode, S6aApplicationID).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Purge-UE-Request message.
+func (v *PurgeUERequest) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, true, PurgeUECode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// PurgeUEAnswer is Purge-UE-Answer message of S6a.
+type PurgeUEAnswer struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	ResultCode                  *ResultCode                  // [ Result-Code ]
+	ExperimentalResult          *ExperimentalResult          // [ Experimental-Result ]
+	OCSupportedFeatures         *OCSupportedFeatures         // [ OC-Supported-Features ]
+	OCOLR                       *OCOLR                       // [ OC-OLR ]
+	Load                        []Load                       // *[ Load ]
+	PUAFlags                    *PUAFlags                    // [ PUA-Flags ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	FailedAVP                   *FailedAVP                   // [ Failed-AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Purge-UE-Answer.
+func (v PurgeUEAnswer) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 17)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.ResultCode != nil {
+		avps = append(avps, v.ResultCode.ToAVP())
+	}
+	if v.ExperimentalResult != nil {
+		avps = append(avps, v.ExperimentalResult.ToAVP())
+	}
+	if v.OCSupportedFeatures != nil {
+		avps = append(avps, v.OCSupportedFeatures.ToAVP())
+	}
+	if v.OCOLR != nil {
+		avps = append(avps, v.OCOLR.ToAVP())
+	}
+	for _, a := range v.Load {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.PUAFlags != nil {
+		avps = append(avps, v.PUAFlags.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	if v.FailedAVP != nil {
+		avps = append(avps, v.FailedAVP.ToAVP())
+	}
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Purge-UE-Answer.
+func (v *PurgeUEAnswer) FromAVPs(avps []diameter.AVP) error {
+	*v = PurgeUEAnswer{}
+	var seen [4]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 268: // Result-Code
+			e = decodeOptional(a, &v.ResultCode)
+		case 297: // Experimental-Result
+			e = decodeOptional(a, &v.ExperimentalResult)
+		case 621: // OC-Supported-Features
+			e = decodeOptional(a, &v.OCSupportedFeatures)
+		case 623: // OC-OLR
+			e = decodeOptional(a, &v.OCOLR)
+		case 650: // Load
+			e = decodeMulti(a, &v.Load, -1)
+		case 10415<<32 | 1442: // PUA-Flags
+			e = decodeOptional(a, &v.PUAFlags)
+		case 279: // Failed-AVP
+			e = decodeOptional(a, &v.FailedAVP)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	return nil
+}
+
+// ToMessage makes Purge-UE-Answer message for the request.
+func (v PurgeUEAnswer) ToMessage(req diameter.Message) diameter.Message {
+	return diameter.NewAnswer(req).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Purge-UE-Answer message.
+func (v *PurgeUEAnswer) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, false, PurgeUECode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// ResetCode is Command-Code of Reset.
+const ResetCode uint32 = 322
+
+// ResetRequest is Reset-Request message of S6a.
+type ResetRequest struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DestinationHost             DestinationHost              // { Destination-Host }
+	DestinationRealm            DestinationRealm             // { Destination-Realm }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	UserId                      []UserId                     // *[ User-Id ]
+	ResetID                     []ResetID                    // *[ Reset-ID ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Reset-Request.
+func (v ResetRequest) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 14)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	avps = append(avps, v.DestinationHost.ToAVP())
+	avps = append(avps, v.DestinationRealm.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.UserId {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.ResetID {
+		avps = append(avps, a.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Reset-Request.
+func (v *ResetRequest) FromAVPs(avps []diameter.AVP) error {
+	*v = ResetRequest{}
+	var seen [6]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 293: // Destination-Host
+			e = decodeOne(a, &v.DestinationHost, &seen[4])
+		case 283: // Destination-Realm
+			e = decodeOne(a, &v.DestinationRealm, &seen[5])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 1444: // User-Id
+			e = decodeMulti(a, &v.UserId, -1)
+		case 10415<<32 | 1670: // Reset-ID
+			e = decodeMulti(a, &v.ResetID, -1)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	if !seen[4] {
+		return missingAVP(293, 0, true)
+	}
+	if !seen[5] {
+		return missingAVP(283, 0, true)
+	}
+	return nil
+}
+
+// ToMessage makes Reset-Request message.
+func (v ResetRequest) ToMessage() diameter.Message {
+	return diameter.NewRequest(ResetCode, S6aApplicationID).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Reset-Request message.
+func (v *ResetRequest) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, true, ResetCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// ResetAnswer is Reset-Answer message of S6a.
+type ResetAnswer struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	ResultCode                  *ResultCode                  // [ Result-Code ]
+	ExperimentalResult          *ExperimentalResult          // [ Experimental-Result ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	FailedAVP                   *FailedAVP                   // [ Failed-AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Reset-Answer.
+func (v ResetAnswer) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 13)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.ResultCode != nil {
+		avps = append(avps, v.ResultCode.ToAVP())
+	}
+	if v.ExperimentalResult != nil {
+		avps = append(avps, v.ExperimentalResult.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	if v.FailedAVP != nil {
+		avps = append(avps, v.FailedAVP.ToAVP())
+	}
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Reset-Answer.
+func (v *ResetAnswer) FromAVPs(avps []diameter.AVP) error {
+	*v = ResetAnswer{}
+	var seen [4]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 268: // Result-Code
+			e = decodeOptional(a, &v.ResultCode)
+		case 297: // Experimental-Result
+			e = decodeOptional(a, &v.ExperimentalResult)
+		case 279: // Failed-AVP
+			e = decodeOptional(a, &v.FailedAVP)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	return nil
+}
+
+// ToMessage makes Reset-Answer message for the request.
+func (v ResetAnswer) ToMessage(req diameter.Message) diameter.Message {
+	return diameter.NewAnswer(req).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Reset-Answer message.
+func (v *ResetAnswer) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, false, ResetCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// NotifyCode is Command-Code of Notify.
+const NotifyCode uint32 = 323
+
+// NotifyRequest is Notify-Request message of S6a.
+type NotifyRequest struct {
+	SessionId                                  SessionId                                   // < Session-Id >
+	AuthSessionState                           AuthSessionState                            // { Auth-Session-State }
+	OriginHost                                 OriginHost                                  // { Origin-Host }
+	OriginRealm                                OriginRealm                                 // { Origin-Realm }
+	DestinationRealm                           DestinationRealm                            // { Destination-Realm }
+	UserName                                   UserName                                    // { User-Name }
+	DRMP                                       *DRMP                                       // [ DRMP ]
+	VendorSpecificApplicationId                *VendorSpecificApplicationId                // [ Vendor-Specific-Application-Id ]
+	DestinationHost                            *DestinationHost                            // [ Destination-Host ]
+	OCSupportedFeatures                        *OCSupportedFeatures                        // [ OC-Supported-Features ]
+	SupportedFeatures                          []SupportedFeatures                         // *[ Supported-Features ]
+	TerminalInformation                        *TerminalInformation                        // [ Terminal-Information ]
+	MIP6AgentInfo                              *MIP6AgentInfo                              // [ MIP6-Agent-Info ]
+	VisitedNetworkIdentifier                   *VisitedNetworkIdentifier                   // [ Visited-Network-Identifier ]
+	ContextIdentifier                          *ContextIdentifier                          // [ Context-Identifier ]
+	ServiceSelection                           *ServiceSelection                           // [ Service-Selection ]
+	AlertReason                                *AlertReason                                // [ Alert-Reason ]
+	UESRVCCCapability                          *UESRVCCCapability                          // [ UE-SRVCC-Capability ]
+	NORFlags                                   *NORFlags                                   // [ NOR-Flags ]
+	HomogeneousSupportOfIMSVoiceOverPSSessions *HomogeneousSupportOfIMSVoiceOverPSSessions // [ Homogeneous-Support-of-IMS-Voice-Over-PS-Sessions ]
+	MaximumUEAvailabilityTime                  *MaximumUEAvailabilityTime                  // [ Maximum-UE-Availability-Time ]
+	MonitoringEventConfigStatus                []MonitoringEventConfigStatus               // *[ Monitoring-Event-Config-Status ]
+	EmergencyServices                          *EmergencyServices                          // [ Emergency-Services ]
+	AVP                                        []diameter.AVP                              // *[ AVP ]
+	ProxyInfo                                  []ProxyInfo                                 // *[ Proxy-Info ]
+	RouteRecord                                []RouteRecord                               // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Notify-Request.
+func (v NotifyRequest) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 26)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	avps = append(avps, v.DestinationRealm.ToAVP())
+	avps = append(avps, v.UserName.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	if v.DestinationHost != nil {
+		avps = append(avps, v.DestinationHost.ToAVP())
+	}
+	if v.OCSupportedFeatures != nil {
+		avps = append(avps, v.OCSupportedFeatures.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.TerminalInformation != nil {
+		avps = append(avps, v.TerminalInformation.ToAVP())
+	}
+	if v.MIP6AgentInfo != nil {
+		avps = append(avps, v.MIP6AgentInfo.ToAVP())
+	}
+	if v.VisitedNetworkIdentifier != nil {
+		avps = append(avps, v.VisitedNetworkIdentifier.ToAVP())
+	}
+	if v.ContextIdentifier != nil {
+		avps = append(avps, v.ContextIdentifier.ToAVP())
+	}
+	if v.ServiceSelection != nil {
+		avps = append(avps, v.ServiceSelection.ToAVP())
+	}
+	if v.AlertReason != nil {
+		avps = append(avps, v.AlertReason.ToAVP())
+	}
+	if v.UESRVCCCapability != nil {
+		avps = append(avps, v.UESRVCCCapability.ToAVP())
+	}
+	if v.NORFlags != nil {
+		avps = append(avps, v.NORFlags.ToAVP())
+	}
+	if v.HomogeneousSupportOfIMSVoiceOverPSSessions != nil {
+		avps = append(avps, v.HomogeneousSupportOfIMSVoiceOverPSSessions.ToAVP())
+	}
+	if v.MaximumUEAvailabilityTime != nil {
+		avps = append(avps, v.MaximumUEAvailabilityTime.ToAVP())
+	}
+	for _, a := range v.MonitoringEventConfigStatus {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.EmergencyServices != nil {
+		avps = append(avps, v.EmergencyServices.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Notify-Request.
+func (v *NotifyRequest) FromAVPs(avps []diameter.AVP) error {
+	*v = NotifyRequest{}
+	var seen [6]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 283: // Destination-Realm
+			e = decodeOne(a, &v.DestinationRealm, &seen[4])
+		case 1: // User-Name
+			e = decodeOne(a, &v.UserName, &seen[5])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 293: // Destination-Host
+			e = decodeOptional(a, &v.DestinationHost)
+		case 621: // OC-Supported-Features
+			e = decodeOptional(a, &v.OCSupportedFeatures)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 1401: // Terminal-Information
+			e = decodeOptional(a, &v.TerminalInformation)
+		case 486: // MIP6-Agent-Info
+			e = decodeOptional(a, &v.MIP6AgentInfo)
+		case 10415<<32 | 600: // Visited-Network-Identifier
+			e = decodeOptional(a, &v.VisitedNetworkIdentifier)
+		case 10415<<32 | 1423: // Context-Identifier
+			e = decodeOptional(a, &v.ContextIdentifier)
+		case 493: // Service-Selection
+			e = decodeOptional(a, &v.ServiceSelection)
+		case 10415<<32 | 1434: // Alert-Reason
+			e = decodeOptional(a, &v.AlertReason)
+		case 10415<<32 | 1615: // UE-SRVCC-Capability
+			e = decodeOptional(a, &v.UESRVCCCapability)
+		case 10415<<32 | 1443: // NOR-Flags
+			e = decodeOptional(a, &v.NORFlags)
+		case 10415<<32 | 1493: // Homogeneous-Support-of-IMS-Voice-Over-PS-Sessions
+			e = decodeOptional(a, &v.HomogeneousSupportOfIMSVoiceOverPSSessions)
+		case 10415<<32 | 3329: // Maximum-UE-Availability-Time
+			e = decodeOptional(a, &v.MaximumUEAvailabilityTime)
+		case 10415<<32 | 3142: // Monitoring-Event-Config-Status
+			e = decodeMulti(a, &v.MonitoringEventConfigStatus, -1)
+		case 10415<<32 | 1538: // Emergency-Services
+			e = decodeOptional(a, &v.EmergencyServices)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	if !seen[4] {
+		return missingAVP(283, 0, true)
+	}
+	if !seen[5] {
+		return missingAVP(1, 0, true)
+	}
+	return nil
+}
+
+// ToMessage makes Notify-Request message.
+func (v NotifyRequest) ToMessage() diameter.Message {
+	return diameter.NewRequest(NotifyCode, S6aApplicationID).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Notify-Request message.
+func (v *NotifyRequest) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, true, NotifyCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// NotifyAnswer is Notify-Answer message of S6a.
+type NotifyAnswer struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	ResultCode                  *ResultCode                  // [ Result-Code ]
+	ExperimentalResult          *ExperimentalResult          // [ Experimental-Result ]
+	OCSupportedFeatures         *OCSupportedFeatures         // [ OC-Supported-Features ]
+	OCOLR                       *OCOLR                       // [ OC-OLR ]
+	Load                        []Load                       // *[ Load ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	FailedAVP                   *FailedAVP                   // [ Failed-AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of Notify-Answer.
+func (v NotifyAnswer) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 16)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	if v.ResultCode != nil {
+		avps = append(avps, v.ResultCode.ToAVP())
+	}
+	if v.ExperimentalResult != nil {
+		avps = append(avps, v.ExperimentalResult.ToAVP())
+	}
+	if v.OCSupportedFeatures != nil {
+		avps = append(avps, v.OCSupportedFeatures.ToAVP())
+	}
+	if v.OCOLR != nil {
+		avps = append(avps, v.OCOLR.ToAVP())
+	}
+	for _, a := range v.Load {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	if v.FailedAVP != nil {
+		avps = append(avps, v.FailedAVP.ToAVP())
+	}
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Notify-Answer.
+func (v *NotifyAnswer) FromAVPs(avps []diameter.AVP) error {
+	*v = NotifyAnswer{}
+	var seen [4]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 268: // Result-Code
+			e = decodeOptional(a, &v.ResultCode)
+		case 297: // Experimental-Result
+			e = decodeOptional(a, &v.ExperimentalResult)
+		case 621: // OC-Supported-Features
+			e = decodeOptional(a, &v.OCSupportedFeatures)
+		case 623: // OC-OLR
+			e = decodeOptional(a, &v.OCOLR)
+		case 650: // Load
+			e = decodeMulti(a, &v.Load, -1)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 279: // Failed-AVP
+			e = decodeOptional(a, &v.FailedAVP)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	return nil
+}
+
+// ToMessage makes Notify-Answer message for the request.
+func (v NotifyAnswer) ToMessage(req diameter.Message) diameter.Message {
+	return diameter.NewAnswer(req).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads Notify-Answer message.
+func (v *NotifyAnswer) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, false, NotifyCode, S6aApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// SubscriptionData is Subscription-Data AVP (code 1400, vendor 10415).
+type SubscriptionData struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Subscription-Data.
+func (v SubscriptionData) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Subscription-Data.
+func (v *SubscriptionData) FromAVPs(avps []diameter.AVP) error {
+	*v = SubscriptionData{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Subscription-Data AVP.
+func (v SubscriptionData) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1400, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Subscription-Data AVP.
+func (v *SubscriptionData) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1400, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// TerminalInformation is Terminal-Information AVP (code 1401, vendor 10415).
+type TerminalInformation struct {
+	IMEI            *IMEI            // [ IMEI ]
+	AVP3GPP2MEID    *AVP3GPP2MEID    // [ 3GPP2-MEID ]
+	SoftwareVersion *SoftwareVersion // [ Software-Version ]
+	AVP             []diameter.AVP   // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Terminal-Information.
+func (v TerminalInformation) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 4)
+	if v.IMEI != nil {
+		avps = append(avps, v.IMEI.ToAVP())
+	}
+	if v.AVP3GPP2MEID != nil {
+		avps = append(avps, v.AVP3GPP2MEID.ToAVP())
+	}
+	if v.SoftwareVersion != nil {
+		avps = append(avps, v.SoftwareVersion.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Terminal-Information.
+func (v *TerminalInformation) FromAVPs(avps []diameter.AVP) error {
+	*v = TerminalInformation{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 10415<<32 | 1402: // IMEI
+			e = decodeOptional(a, &v.IMEI)
+		case 10415<<32 | 1471: // 3GPP2-MEID
+			e = decodeOptional(a, &v.AVP3GPP2MEID)
+		case 10415<<32 | 1403: // Software-Version
+			e = decodeOptional(a, &v.SoftwareVersion)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Terminal-Information AVP.
+func (v TerminalInformation) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1401, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Terminal-Information AVP.
+func (v *TerminalInformation) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1401, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// IMEI is IMEI AVP (code 1402, vendor 10415).
+type IMEI string
+
+// ToAVP makes IMEI AVP.
+func (v IMEI) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1402, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads IMEI AVP.
+func (v *IMEI) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 1402, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = IMEI(d)
+	return nil
+}
+
+// SoftwareVersion is Software-Version AVP (code 1403, vendor 10415).
+type SoftwareVersion string
+
+// ToAVP makes Software-Version AVP.
+func (v SoftwareVersion) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1403, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Software-Version AVP.
+func (v *SoftwareVersion) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 1403, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SoftwareVersion(d)
+	return nil
+}
+
+// QoSSubscribed is QoS-Subscribed AVP (code 1404, vendor 10415).
+type QoSSubscribed []byte
+
+// ToAVP makes QoS-Subscribed AVP.
+func (v QoSSubscribed) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1404, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads QoS-Subscribed AVP.
+func (v *QoSSubscribed) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1404, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = QoSSubscribed(d)
+	return nil
+}
+
+// ULRFlags is ULR-Flags AVP (code 1405, vendor 10415).
+type ULRFlags uint32
+
+// ToAVP makes ULR-Flags AVP.
+func (v ULRFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1405, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads ULR-Flags AVP.
+func (v *ULRFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1405, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ULRFlags(d)
+	return nil
+}
+
+// ULAFlags is ULA-Flags AVP (code 1406, vendor 10415).
+type ULAFlags uint32
+
+// ToAVP makes ULA-Flags AVP.
+func (v ULAFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1406, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads ULA-Flags AVP.
+func (v *ULAFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1406, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ULAFlags(d)
+	return nil
+}
+
+// VisitedPLMNId is Visited-PLMN-Id AVP (code 1407, vendor 10415).
+type VisitedPLMNId []byte
+
+// ToAVP makes Visited-PLMN-Id AVP.
+func (v VisitedPLMNId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1407, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Visited-PLMN-Id AVP.
+func (v *VisitedPLMNId) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1407, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = VisitedPLMNId(d)
+	return nil
+}
+
+// RequestedEUTRANAuthenticationInfo is Requested-EUTRAN-Authentication-Info AVP (code 1408, vendor 10415).
+type RequestedEUTRANAuthenticationInfo struct {
+	NumberOfRequestedVectors   *NumberOfRequestedVectors   // [ Number-Of-Requested-Vectors ]
+	ImmediateResponsePreferred *ImmediateResponsePreferred // [ Immediate-Response-Preferred ]
+	ReSynchronizationInfo      *ReSynchronizationInfo      // [ Re-Synchronization-Info ]
+	AVP                        []diameter.AVP              // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Requested-EUTRAN-Authentication-Info.
+func (v RequestedEUTRANAuthenticationInfo) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 4)
+	if v.NumberOfRequestedVectors != nil {
+		avps = append(avps, v.NumberOfRequestedVectors.ToAVP())
+	}
+	if v.ImmediateResponsePreferred != nil {
+		avps = append(avps, v.ImmediateResponsePreferred.ToAVP())
+	}
+	if v.ReSynchronizationInfo != nil {
+		avps = append(avps, v.ReSynchronizationInfo.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Requested-EUTRAN-Authentication-Info.
+func (v *RequestedEUTRANAuthenticationInfo) FromAVPs(avps []diameter.AVP) error {
+	*v = RequestedEUTRANAuthenticationInfo{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 10415<<32 | 1410: // Number-Of-Requested-Vectors
+			e = decodeOptional(a, &v.NumberOfRequestedVectors)
+		case 10415<<32 | 1412: // Immediate-Response-Preferred
+			e = decodeOptional(a, &v.ImmediateResponsePreferred)
+		case 10415<<32 | 1411: // Re-Synchronization-Info
+			e = decodeOptional(a, &v.ReSynchronizationInfo)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Requested-EUTRAN-Authentication-Info AVP.
+func (v RequestedEUTRANAuthenticationInfo) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1408, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Requested-EUTRAN-Authentication-Info AVP.
+func (v *RequestedEUTRANAuthenticationInfo) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1408, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// RequestedUTRANGERANAuthenticationInfo is Requested-UTRAN-GERAN-Authentication-Info AVP (code 1409, vendor 10415).
+type RequestedUTRANGERANAuthenticationInfo struct {
+	NumberOfRequestedVectors   *NumberOfRequestedVectors   // [ Number-Of-Requested-Vectors ]
+	ImmediateResponsePreferred *ImmediateResponsePreferred // [ Immediate-Response-Preferred ]
+	ReSynchronizationInfo      *ReSynchronizationInfo      // [ Re-Synchronization-Info ]
+	AVP                        []diameter.AVP              // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Requested-UTRAN-GERAN-Authentication-Info.
+func (v RequestedUTRANGERANAuthenticationInfo) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 4)
+	if v.NumberOfRequestedVectors != nil {
+		avps = append(avps, v.NumberOfRequestedVectors.ToAVP())
+	}
+	if v.ImmediateResponsePreferred != nil {
+		avps = append(avps, v.ImmediateResponsePreferred.ToAVP())
+	}
+	if v.ReSynchronizationInfo != nil {
+		avps = append(avps, v.ReSynchronizationInfo.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Requested-UTRAN-GERAN-Authentication-Info.
+func (v *RequestedUTRANGERANAuthenticationInfo) FromAVPs(avps []diameter.AVP) error {
+	*v = RequestedUTRANGERANAuthenticationInfo{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 10415<<32 | 1410: // Number-Of-Requested-Vectors
+			e = decodeOptional(a, &v.NumberOfRequestedVectors)
+		case 10415<<32 | 1412: // Immediate-Response-Preferred
+			e = decodeOptional(a, &v.ImmediateResponsePreferred)
+		case 10415<<32 | 1411: // Re-Synchronization-Info
+			e = decodeOptional(a, &v.ReSynchronizationInfo)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Requested-UTRAN-GERAN-Authentication-Info AVP.
+func (v RequestedUTRANGERANAuthenticationInfo) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1409, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Requested-UTRAN-GERAN-Authentication-Info AVP.
+func (v *RequestedUTRANGERANAuthenticationInfo) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1409, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// NumberOfRequestedVectors is Number-Of-Requested-Vectors AVP (code 1410, vendor 10415).
+type NumberOfRequestedVectors uint32
+
+// ToAVP makes Number-Of-Requested-Vectors AVP.
+func (v NumberOfRequestedVectors) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1410, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Number-Of-Requested-Vectors AVP.
+func (v *NumberOfRequestedVectors) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1410, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = NumberOfRequestedVectors(d)
+	return nil
+}
+
+// ReSynchronizationInfo is Re-Synchronization-Info AVP (code 1411, vendor 10415).
+type ReSynchronizationInfo []byte
+
+// ToAVP makes Re-Synchronization-Info AVP.
+func (v ReSynchronizationInfo) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1411, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Re-Synchronization-Info AVP.
+func (v *ReSynchronizationInfo) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1411, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ReSynchronizationInfo(d)
+	return nil
+}
+
+// ImmediateResponsePreferred is Immediate-Response-Preferred AVP (code 1412, vendor 10415).
+type ImmediateResponsePreferred uint32
+
+// ToAVP makes Immediate-Response-Preferred AVP.
+func (v ImmediateResponsePreferred) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1412, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Immediate-Response-Preferred AVP.
+func (v *ImmediateResponsePreferred) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1412, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ImmediateResponsePreferred(d)
+	return nil
+}
+
+// AuthenticationInfo is Authentication-Info AVP (code 1413, vendor 10415).
+type AuthenticationInfo struct {
+	EUTRANVector []EUTRANVector // *[ E-UTRAN-Vector ]
+	UTRANVector  []UTRANVector  // *[ UTRAN-Vector ]
+	GERANVector  []GERANVector  // *[ GERAN-Vector ]
+	AVP          []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Authentication-Info.
+func (v AuthenticationInfo) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 4)
+	for _, a := range v.EUTRANVector {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.UTRANVector {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.GERANVector {
+		avps = append(avps, a.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Authentication-Info.
+func (v *AuthenticationInfo) FromAVPs(avps []diameter.AVP) error {
+	*v = AuthenticationInfo{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 10415<<32 | 1414: // E-UTRAN-Vector
+			e = decodeMulti(a, &v.EUTRANVector, -1)
+		case 10415<<32 | 1415: // UTRAN-Vector
+			e = decodeMulti(a, &v.UTRANVector, -1)
+		case 10415<<32 | 1416: // GERAN-Vector
+			e = decodeMulti(a, &v.GERANVector, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Authentication-Info AVP.
+func (v AuthenticationInfo) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1413, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Authentication-Info AVP.
+func (v *AuthenticationInfo) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1413, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// EUTRANVector is E-UTRAN-Vector AVP (code 1414, vendor 10415).
+type EUTRANVector struct {
+	RAND       RAND           // { RAND }
+	XRES       XRES           // { XRES }
+	AUTN       AUTN           // { AUTN }
+	KASME      KASME          // { KASME }
+	ItemNumber *ItemNumber    // [ Item-Number ]
+	AVP        []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of E-UTRAN-Vector.
+func (v EUTRANVector) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 6)
+	avps = append(avps, v.RAND.ToAVP())
+	avps = append(avps, v.XRES.ToAVP())
+	avps = append(avps, v.AUTN.ToAVP())
+	avps = append(avps, v.KASME.ToAVP())
+	if v.ItemNumber != nil {
+		avps = append(avps, v.ItemNumber.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of E-UTRAN-Vector.
+func (v *EUTRANVector) FromAVPs(avps []diameter.AVP) error {
+	*v = EUTRANVector{}
+	var seen [4]bool
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 10415<<32 | 1447: // RAND
+			e = decodeOne(a, &v.RAND, &seen[0])
+		case 10415<<32 | 1448: // XRES
+			e = decodeOne(a, &v.XRES, &seen[1])
+		case 10415<<32 | 1449: // AUTN
+			e = decodeOne(a, &v.AUTN, &seen[2])
+		case 10415<<32 | 1450: // KASME
+			e = decodeOne(a, &v.KASME, &seen[3])
+		case 10415<<32 | 1419: // Item-Number
+			e = decodeOptional(a, &v.ItemNumber)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(1447, 10415, true)
+	}
+	if !seen[1] {
+		return missingAVP(1448, 10415, true)
+	}
+	if !seen[2] {
+		return missingAVP(1449, 10415, true)
+	}
+	if !seen[3] {
+		return missingAVP(1450, 10415, true)
+	}
+	return nil
+}
+
+// ToAVP makes E-UTRAN-Vector AVP.
+func (v EUTRANVector) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1414, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads E-UTRAN-Vector AVP.
+func (v *EUTRANVector) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1414, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// UTRANVector is UTRAN-Vector AVP (code 1415, vendor 10415).
+type UTRANVector struct {
+	RAND               RAND               // { RAND }
+	XRES               XRES               // { XRES }
+	AUTN               AUTN               // { AUTN }
+	ConfidentialityKey ConfidentialityKey // { Confidentiality-Key }
+	IntegrityKey       IntegrityKey       // { Integrity-Key }
+	ItemNumber         *ItemNumber        // [ Item-Number ]
+	AVP                []diameter.AVP     // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of UTRAN-Vector.
+func (v UTRANVector) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 7)
+	avps = append(avps, v.RAND.ToAVP())
+	avps = append(avps, v.XRES.ToAVP())
+	avps = append(avps, v.AUTN.ToAVP())
+	avps = append(avps, v.ConfidentialityKey.ToAVP())
+	avps = append(avps, v.IntegrityKey.ToAVP())
+	if v.ItemNumber != nil {
+		avps = append(avps, v.ItemNumber.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of UTRAN-Vector.
+func (v *UTRANVector) FromAVPs(avps []diameter.AVP) error {
+	*v = UTRANVector{}
+	var seen [5]bool
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 10415<<32 | 1447: // RAND
+			e = decodeOne(a, &v.RAND, &seen[0])
+		case 10415<<32 | 1448: // XRES
+			e = decodeOne(a, &v.XRES, &seen[1])
+		case 10415<<32 | 1449: // AUTN
+			e = decodeOne(a, &v.AUTN, &seen[2])
+		case 10415<<32 | 625: // Confidentiality-Key
+			e = decodeOne(a, &v.ConfidentialityKey, &seen[3])
+		case 10415<<32 | 626: // Integrity-Key
+			e = decodeOne(a, &v.IntegrityKey, &seen[4])
+		case 10415<<32 | 1419: // Item-Number
+			e = decodeOptional(a, &v.ItemNumber)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(1447, 10415, true)
+	}
+	if !seen[1] {
+		return missingAVP(1448, 10415, true)
+	}
+	if !seen[2] {
+		return missingAVP(1449, 10415, true)
+	}
+	if !seen[3] {
+		return missingAVP(625, 10415, true)
+	}
+	if !seen[4] {
+		return missingAVP(626, 10415, true)
+	}
+	return nil
+}
+
+// ToAVP makes UTRAN-Vector AVP.
+func (v UTRANVector) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1415, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads UTRAN-Vector AVP.
+func (v *UTRANVector) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1415, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// GERANVector is GERAN-Vector AVP (code 1416, vendor 10415).
+type GERANVector struct {
+	RAND       RAND           // { RAND }
+	SRES       SRES           // { SRES }
+	Kc         Kc             // { Kc }
+	ItemNumber *ItemNumber    // [ Item-Number ]
+	AVP        []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of GERAN-Vector.
+func (v GERANVector) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 5)
+	avps = append(avps, v.RAND.ToAVP())
+	avps = append(avps, v.SRES.ToAVP())
+	avps = append(avps, v.Kc.ToAVP())
+	if v.ItemNumber != nil {
+		avps = append(avps, v.ItemNumber.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of GERAN-Vector.
+func (v *GERANVector) FromAVPs(avps []diameter.AVP) error {
+	*v = GERANVector{}
+	var seen [3]bool
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 10415<<32 | 1447: // RAND
+			e = decodeOne(a, &v.RAND, &seen[0])
+		case 10415<<32 | 1454: // SRES
+			e = decodeOne(a, &v.SRES, &seen[1])
+		case 10415<<32 | 1453: // Kc
+			e = decodeOne(a, &v.Kc, &seen[2])
+		case 10415<<32 | 1419: // Item-Number
+			e = decodeOptional(a, &v.ItemNumber)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(1447, 10415, true)
+	}
+	if !seen[1] {
+		return missingAVP(1454, 10415, true)
+	}
+	if !seen[2] {
+		return missingAVP(1453, 10415, true)
+	}
+	return nil
+}
+
+// ToAVP makes GERAN-Vector AVP.
+func (v GERANVector) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1416, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads GERAN-Vector AVP.
+func (v *GERANVector) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1416, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// NetworkAccessMode is Network-Access-Mode AVP (code 1417, vendor 10415).
+type NetworkAccessMode diameter.Enumerated
+
+const (
+	NetworkAccessModePacketAndCircuit NetworkAccessMode = 0 // PACKET_AND_CIRCUIT
+	NetworkAccessModeOnlyPacket       NetworkAccessMode = 2 // ONLY_PACKET
+)
+
+func (v NetworkAccessMode) String() string {
+	switch v {
+	case 0:
+		return "PACKET_AND_CIRCUIT"
+	case 2:
+		return "ONLY_PACKET"
+	}
+	return fmt.Sprintf("NetworkAccessMode(%d)", v)
+}
+
+// ToAVP makes Network-Access-Mode AVP.
+func (v NetworkAccessMode) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1417, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Network-Access-Mode AVP.
+func (v *NetworkAccessMode) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1417, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = NetworkAccessMode(d)
+	return nil
+}
+
+// HPLMNODB is HPLMN-ODB AVP (code 1418, vendor 10415).
+type HPLMNODB uint32
+
+// ToAVP makes HPLMN-ODB AVP.
+func (v HPLMNODB) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1418, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads HPLMN-ODB AVP.
+func (v *HPLMNODB) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1418, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = HPLMNODB(d)
+	return nil
+}
+
+// ItemNumber is Item-Number AVP (code 1419, vendor 10415).
+type ItemNumber uint32
+
+// ToAVP makes Item-Number AVP.
+func (v ItemNumber) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1419, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Item-Number AVP.
+func (v *ItemNumber) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1419, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ItemNumber(d)
+	return nil
+}
+
+// CancellationType is Cancellation-Type AVP (code 1420, vendor 10415).
+type CancellationType diameter.Enumerated
+
+const (
+	CancellationTypeMmeUpdateProcedure     CancellationType = 0 // MME_UPDATE_PROCEDURE
+	CancellationTypeSgsnUpdateProcedure    CancellationType = 1 // SGSN_UPDATE_PROCEDURE
+	CancellationTypeSubscriptionWithdrawal CancellationType = 2 // SUBSCRIPTION_WITHDRAWAL
+	CancellationTypeUpdateProcedureIwf     CancellationType = 3 // UPDATE_PROCEDURE_IWF
+	CancellationTypeInitialAttachProcedure CancellationType = 4 // INITIAL_ATTACH_PROCEDURE
+)
+
+func (v CancellationType) String() string {
+	switch v {
+	case 0:
+		return "MME_UPDATE_PROCEDURE"
+	case 1:
+		return "SGSN_UPDATE_PROCEDURE"
+	case 2:
+		return "SUBSCRIPTION_WITHDRAWAL"
+	case 3:
+		return "UPDATE_PROCEDURE_IWF"
+	case 4:
+		return "INITIAL_ATTACH_PROCEDURE"
+	}
+	return fmt.Sprintf("CancellationType(%d)", v)
+}
+
+// ToAVP makes Cancellation-Type AVP.
+func (v CancellationType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1420, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Cancellation-Type AVP.
+func (v *CancellationType) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1420, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = CancellationType(d)
+	return nil
+}
+
+// DSRFlags is DSR-Flags AVP (code 1421, vendor 10415).
+type DSRFlags uint32
+
+// ToAVP makes DSR-Flags AVP.
+func (v DSRFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1421, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads DSR-Flags AVP.
+func (v *DSRFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1421, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = DSRFlags(d)
+	return nil
+}
+
+// DSAFlags is DSA-Flags AVP (code 1422, vendor 10415).
+type DSAFlags uint32
+
+// ToAVP makes DSA-Flags AVP.
+func (v DSAFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1422, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads DSA-Flags AVP.
+func (v *DSAFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1422, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = DSAFlags(d)
+	return nil
+}
+
+// ContextIdentifier is Context-Identifier AVP (code 1423, vendor 10415).
+type ContextIdentifier uint32
+
+// ToAVP makes Context-Identifier AVP.
+func (v ContextIdentifier) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1423, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Context-Identifier AVP.
+func (v *ContextIdentifier) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1423, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ContextIdentifier(d)
+	return nil
+}
+
+// SubscriberStatus is Subscriber-Status AVP (code 1424, vendor 10415).
+type SubscriberStatus diameter.Enumerated
+
+const (
+	SubscriberStatusServiceGranted            SubscriberStatus = 0 // SERVICE_GRANTED
+	SubscriberStatusOperatorDeterminedBarring SubscriberStatus = 1 // OPERATOR_DETERMINED_BARRING
+)
+
+func (v SubscriberStatus) String() string {
+	switch v {
+	case 0:
+		return "SERVICE_GRANTED"
+	case 1:
+		return "OPERATOR_DETERMINED_BARRING"
+	}
+	return fmt.Sprintf("SubscriberStatus(%d)", v)
+}
+
+// ToAVP makes Subscriber-Status AVP.
+func (v SubscriberStatus) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1424, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Subscriber-Status AVP.
+func (v *SubscriberStatus) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1424, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SubscriberStatus(d)
+	return nil
+}
+
+// OperatorDeterminedBarring is Operator-Determined-Barring AVP (code 1425, vendor 10415).
+type OperatorDeterminedBarring uint32
+
+// ToAVP makes Operator-Determined-Barring AVP.
+func (v OperatorDeterminedBarring) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1425, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Operator-Determined-Barring AVP.
+func (v *OperatorDeterminedBarring) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1425, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = OperatorDeterminedBarring(d)
+	return nil
+}
+
+// AccessRestrictionData is Access-Restriction-Data AVP (code 1426, vendor 10415).
+type AccessRestrictionData uint32
+
+// ToAVP makes Access-Restriction-Data AVP.
+func (v AccessRestrictionData) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1426, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Access-Restriction-Data AVP.
+func (v *AccessRestrictionData) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1426, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AccessRestrictionData(d)
+	return nil
+}
+
+// APNOIReplacement is APN-OI-Replacement AVP (code 1427, vendor 10415).
+type APNOIReplacement string
+
+// ToAVP makes APN-OI-Replacement AVP.
+func (v APNOIReplacement) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1427, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads APN-OI-Replacement AVP.
+func (v *APNOIReplacement) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 1427, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = APNOIReplacement(d)
+	return nil
+}
+
+// AllAPNConfigurationsIncludedIndicator is All-APN-Configurations-Included-Indicator AVP (code 1428, vendor 10415).
+type AllAPNConfigurationsIncludedIndicator diameter.Enumerated
+
+const (
+	AllAPNConfigurationsIncludedIndicatorAllAPNCONFIGURATIONSINCLUDED           AllAPNConfigurationsIncludedIndicator = 0 // All_APN_CONFIGURATIONS_INCLUDED
+	AllAPNConfigurationsIncludedIndicatorModifiedAddedApnConfigurationsIncluded AllAPNConfigurationsIncludedIndicator = 1 // MODIFIED_ADDED_APN_CONFIGURATIONS_INCLUDED
+)
+
+func (v AllAPNConfigurationsIncludedIndicator) String() string {
+	switch v {
+	case 0:
+		return "All_APN_CONFIGURATIONS_INCLUDED"
+	case 1:
+		return "MODIFIED_ADDED_APN_CONFIGURATIONS_INCLUDED"
+	}
+	return fmt.Sprintf("AllAPNConfigurationsIncludedIndicator(%d)", v)
+}
+
+// ToAVP makes All-APN-Configurations-Included-Indicator AVP.
+func (v AllAPNConfigurationsIncludedIndicator) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1428, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads All-APN-Configurations-Included-Indicator AVP.
+func (v *AllAPNConfigurationsIncludedIndicator) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1428, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AllAPNConfigurationsIncludedIndicator(d)
+	return nil
+}
+
+// APNConfigurationProfile is APN-Configuration-Profile AVP (code 1429, vendor 10415).
+type APNConfigurationProfile struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of APN-Configuration-Profile.
+func (v APNConfigurationProfile) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of APN-Configuration-Profile.
+func (v *APNConfigurationProfile) FromAVPs(avps []diameter.AVP) error {
+	*v = APNConfigurationProfile{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes APN-Configuration-Profile AVP.
+func (v APNConfigurationProfile) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1429, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads APN-Configuration-Profile AVP.
+func (v *APNConfigurationProfile) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1429, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// APNConfiguration is APN-Configuration AVP (code 1430, vendor 10415).
+type APNConfiguration struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of APN-Configuration.
+func (v APNConfiguration) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of APN-Configuration.
+func (v *APNConfiguration) FromAVPs(avps []diameter.AVP) error {
+	*v = APNConfiguration{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes APN-Configuration AVP.
+func (v APNConfiguration) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1430, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads APN-Configuration AVP.
+func (v *APNConfiguration) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1430, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// EPSSubscribedQoSProfile is EPS-Subscribed-QoS-Profile AVP (code 1431, vendor 10415).
+type EPSSubscribedQoSProfile struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of EPS-Subscribed-QoS-Profile.
+func (v EPSSubscribedQoSProfile) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of EPS-Subscribed-QoS-Profile.
+func (v *EPSSubscribedQoSProfile) FromAVPs(avps []diameter.AVP) error {
+	*v = EPSSubscribedQoSProfile{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes EPS-Subscribed-QoS-Profile AVP.
+func (v EPSSubscribedQoSProfile) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1431, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads EPS-Subscribed-QoS-Profile AVP.
+func (v *EPSSubscribedQoSProfile) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1431, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// VPLMNDynamicAddressAllowed is VPLMN-Dynamic-Address-Allowed AVP (code 1432, vendor 10415).
+type VPLMNDynamicAddressAllowed diameter.Enumerated
+
+const (
+	VPLMNDynamicAddressAllowedNotallowed VPLMNDynamicAddressAllowed = 0 // NOTALLOWED
+	VPLMNDynamicAddressAllowedAllowed    VPLMNDynamicAddressAllowed = 1 // ALLOWED
+)
+
+func (v VPLMNDynamicAddressAllowed) String() string {
+	switch v {
+	case 0:
+		return "NOTALLOWED"
+	case 1:
+		return "ALLOWED"
+	}
+	return fmt.Sprintf("VPLMNDynamicAddressAllowed(%d)", v)
+}
+
+// ToAVP makes VPLMN-Dynamic-Address-Allowed AVP.
+func (v VPLMNDynamicAddressAllowed) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1432, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads VPLMN-Dynamic-Address-Allowed AVP.
+func (v *VPLMNDynamicAddressAllowed) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1432, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = VPLMNDynamicAddressAllowed(d)
+	return nil
+}
+
+// STNSR is STN-SR AVP (code 1433, vendor 10415).
+type STNSR []byte
+
+// ToAVP makes STN-SR AVP.
+func (v STNSR) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1433, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads STN-SR AVP.
+func (v *STNSR) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1433, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = STNSR(d)
+	return nil
+}
+
+// AlertReason is Alert-Reason AVP (code 1434, vendor 10415).
+type AlertReason diameter.Enumerated
+
+const (
+	AlertReasonUePresent         AlertReason = 0 // UE_PRESENT
+	AlertReasonUeMemoryAvailable AlertReason = 1 // UE_MEMORY_AVAILABLE
+)
+
+func (v AlertReason) String() string {
+	switch v {
+	case 0:
+		return "UE_PRESENT"
+	case 1:
+		return "UE_MEMORY_AVAILABLE"
+	}
+	return fmt.Sprintf("AlertReason(%d)", v)
+}
+
+// ToAVP makes Alert-Reason AVP.
+func (v AlertReason) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1434, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Alert-Reason AVP.
+func (v *AlertReason) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1434, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AlertReason(d)
+	return nil
+}
+
+// AMBR is AMBR AVP (code 1435, vendor 10415).
+type AMBR struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of AMBR.
+func (v AMBR) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of AMBR.
+func (v *AMBR) FromAVPs(avps []diameter.AVP) error {
+	*v = AMBR{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes AMBR AVP.
+func (v AMBR) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1435, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads AMBR AVP.
+func (v *AMBR) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1435, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// CSGSubscriptionData is CSG-Subscription-Data AVP (code 1436, vendor 10415).
+type CSGSubscriptionData struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of CSG-Subscription-Data.
+func (v CSGSubscriptionData) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of CSG-Subscription-Data.
+func (v *CSGSubscriptionData) FromAVPs(avps []diameter.AVP) error {
+	*v = CSGSubscriptionData{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes CSG-Subscription-Data AVP.
+func (v CSGSubscriptionData) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1436, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads CSG-Subscription-Data AVP.
+func (v *CSGSubscriptionData) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1436, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// CSGId is CSG-Id AVP (code 1437, vendor 10415).
+type CSGId uint32
+
+// ToAVP makes CSG-Id AVP.
+func (v CSGId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1437, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads CSG-Id AVP.
+func (v *CSGId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1437, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = CSGId(d)
+	return nil
+}
+
+// PDNGWAllocationType is PDN-GW-Allocation-Type AVP (code 1438, vendor 10415).
+type PDNGWAllocationType diameter.Enumerated
+
+const (
+	PDNGWAllocationTypeStatic  PDNGWAllocationType = 0 // STATIC
+	PDNGWAllocationTypeDynamic PDNGWAllocationType = 1 // DYNAMIC
+)
+
+func (v PDNGWAllocationType) String() string {
+	switch v {
+	case 0:
+		return "STATIC"
+	case 1:
+		return "DYNAMIC"
+	}
+	return fmt.Sprintf("PDNGWAllocationType(%d)", v)
+}
+
+// ToAVP makes PDN-GW-Allocation-Type AVP.
+func (v PDNGWAllocationType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1438, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads PDN-GW-Allocation-Type AVP.
+func (v *PDNGWAllocationType) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1438, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PDNGWAllocationType(d)
+	return nil
+}
+
+// ExpirationDate is Expiration-Date AVP (code 1439, vendor 10415).
+type ExpirationDate time.Time
+
+// ToAVP makes Expiration-Date AVP.
+func (v ExpirationDate) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1439, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(time.Time(v))
+	return a
+}
+
+// FromAVP reads Expiration-Date AVP.
+func (v *ExpirationDate) FromAVP(a diameter.AVP) error {
+	var d time.Time
+	if e := checkAVP(a, 1439, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ExpirationDate(d)
+	return nil
+}
+
+// RATFrequencySelectionPriorityID is RAT-Frequency-Selection-Priority-ID AVP (code 1440, vendor 10415).
+type RATFrequencySelectionPriorityID uint32
+
+// ToAVP makes RAT-Frequency-Selection-Priority-ID AVP.
+func (v RATFrequencySelectionPriorityID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1440, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads RAT-Frequency-Selection-Priority-ID AVP.
+func (v *RATFrequencySelectionPriorityID) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1440, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RATFrequencySelectionPriorityID(d)
+	return nil
+}
+
+// IDAFlags is IDA-Flags AVP (code 1441, vendor 10415).
+type IDAFlags uint32
+
+// ToAVP makes IDA-Flags AVP.
+func (v IDAFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1441, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads IDA-Flags AVP.
+func (v *IDAFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1441, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = IDAFlags(d)
+	return nil
+}
+
+// PUAFlags is PUA-Flags AVP (code 1442, vendor 10415).
+type PUAFlags uint32
+
+// ToAVP makes PUA-Flags AVP.
+func (v PUAFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1442, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads PUA-Flags AVP.
+func (v *PUAFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1442, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PUAFlags(d)
+	return nil
+}
+
+// NORFlags is NOR-Flags AVP (code 1443, vendor 10415).
+type NORFlags uint32
+
+// ToAVP makes NOR-Flags AVP.
+func (v NORFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1443, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads NOR-Flags AVP.
+func (v *NORFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1443, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = NORFlags(d)
+	return nil
+}
+
+// UserId is User-Id AVP (code 1444, vendor 10415).
+type UserId string
+
+// ToAVP makes User-Id AVP.
+func (v UserId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1444, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads User-Id AVP.
+func (v *UserId) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 1444, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = UserId(d)
+	return nil
+}
+
+// EquipmentStatus is Equipment-Status AVP (code 1445, vendor 10415).
+type EquipmentStatus diameter.Enumerated
+
+const (
+	EquipmentStatusWhitelisted EquipmentStatus = 0 // WHITELISTED
+	EquipmentStatusBlacklisted EquipmentStatus = 1 // BLACKLISTED
+)
+
+func (v EquipmentStatus) String() string {
+	switch v {
+	case 0:
+		return "WHITELISTED"
+	case 1:
+		return "BLACKLISTED"
+	}
+	return fmt.Sprintf("EquipmentStatus(%d)", v)
+}
+
+// ToAVP makes Equipment-Status AVP.
+func (v EquipmentStatus) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1445, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Equipment-Status AVP.
+func (v *EquipmentStatus) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1445, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = EquipmentStatus(d)
+	return nil
+}
+
+// RegionalSubscriptionZoneCode is Regional-Subscription-Zone-Code AVP (code 1446, vendor 10415).
+type RegionalSubscriptionZoneCode []byte
+
+// ToAVP makes Regional-Subscription-Zone-Code AVP.
+func (v RegionalSubscriptionZoneCode) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1446, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Regional-Subscription-Zone-Code AVP.
+func (v *RegionalSubscriptionZoneCode) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1446, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RegionalSubscriptionZoneCode(d)
+	return nil
+}
+
+// RAND is RAND AVP (code 1447, vendor 10415).
+type RAND []byte
+
+// ToAVP makes RAND AVP.
+func (v RAND) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1447, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads RAND AVP.
+func (v *RAND) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1447, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RAND(d)
+	return nil
+}
+
+// XRES is XRES AVP (code 1448, vendor 10415).
+type XRES []byte
+
+// ToAVP makes XRES AVP.
+func (v XRES) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1448, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads XRES AVP.
+func (v *XRES) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1448, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = XRES(d)
+	return nil
+}
+
+// AUTN is AUTN AVP (code 1449, vendor 10415).
+type AUTN []byte
+
+// ToAVP makes AUTN AVP.
+func (v AUTN) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1449, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads AUTN AVP.
+func (v *AUTN) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1449, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AUTN(d)
+	return nil
+}
+
+// KASME is KASME AVP (code 1450, vendor 10415).
+type KASME []byte
+
+// ToAVP makes KASME AVP.
+func (v KASME) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1450, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads KASME AVP.
+func (v *KASME) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1450, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = KASME(d)
+	return nil
+}
+
+// TraceCollectionEntity is Trace-Collection-Entity AVP (code 1452, vendor 10415).
+type TraceCollectionEntity net.IP
+
+// ToAVP makes Trace-Collection-Entity AVP.
+func (v TraceCollectionEntity) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1452, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(net.IP(v))
+	return a
+}
+
+// FromAVP reads Trace-Collection-Entity AVP.
+func (v *TraceCollectionEntity) FromAVP(a diameter.AVP) error {
+	var d net.IP
+	if e := checkAVP(a, 1452, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = TraceCollectionEntity(d)
+	return nil
+}
+
+// Kc is Kc AVP (code 1453, vendor 10415).
+type Kc []byte
+
+// ToAVP makes Kc AVP.
+func (v Kc) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1453, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Kc AVP.
+func (v *Kc) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1453, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = Kc(d)
+	return nil
+}
+
+// SRES is SRES AVP (code 1454, vendor 10415).
+type SRES []byte
+
+// ToAVP makes SRES AVP.
+func (v SRES) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1454, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads SRES AVP.
+func (v *SRES) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1454, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SRES(d)
+	return nil
+}
+
+// PDNType is PDN-Type AVP (code 1456, vendor 10415).
+type PDNType diameter.Enumerated
+
+const (
+	PDNTypeIPv4       PDNType = 0 // IPv4
+	PDNTypeIPv6       PDNType = 1 // IPv6
+	PDNTypeIPv4v6     PDNType = 2 // IPv4v6
+	PDNTypeIPv4ORIPv6 PDNType = 3 // IPv4_OR_IPv6
+)
+
+func (v PDNType) String() string {
+	switch v {
+	case 0:
+		return "IPv4"
+	case 1:
+		return "IPv6"
+	case 2:
+		return "IPv4v6"
+	case 3:
+		return "IPv4_OR_IPv6"
+	}
+	return fmt.Sprintf("PDNType(%d)", v)
+}
+
+// ToAVP makes PDN-Type AVP.
+func (v PDNType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1456, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads PDN-Type AVP.
+func (v *PDNType) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1456, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PDNType(d)
+	return nil
+}
+
+// RoamingRestrictedDueToUnsupportedFeature is Roaming-Restricted-Due-To-Unsupported-Feature AVP (code 1457, vendor 10415).
+type RoamingRestrictedDueToUnsupportedFeature diameter.Enumerated
+
+const (
+	RoamingRestrictedDueToUnsupportedFeatureRoamingRestrictedDueToUnsupportedFeature RoamingRestrictedDueToUnsupportedFeature = 0 // Roaming-Restricted-Due-To-Unsupported-Feature
+)
+
+func (v RoamingRestrictedDueToUnsupportedFeature) String() string {
+	switch v {
+	case 0:
+		return "Roaming-Restricted-Due-To-Unsupported-Feature"
+	}
+	return fmt.Sprintf("RoamingRestrictedDueToUnsupportedFeature(%d)", v)
+}
+
+// ToAVP makes Roaming-Restricted-Due-To-Unsupported-Feature AVP.
+func (v RoamingRestrictedDueToUnsupportedFeature) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1457, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Roaming-Restricted-Due-To-Unsupported-Feature AVP.
+func (v *RoamingRestrictedDueToUnsupportedFeature) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1457, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RoamingRestrictedDueToUnsupportedFeature(d)
+	return nil
+}
+
+// TraceData is Trace-Data AVP (code 1458, vendor 10415).
+type TraceData struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Trace-Data.
+func (v TraceData) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Trace-Data.
+func (v *TraceData) FromAVPs(avps []diameter.AVP) error {
+	*v = TraceData{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Trace-Data AVP.
+func (v TraceData) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1458, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Trace-Data AVP.
+func (v *TraceData) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1458, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// TraceReference is Trace-Reference AVP (code 1459, vendor 10415).
+type TraceReference []byte
+
+// ToAVP makes Trace-Reference AVP.
+func (v TraceReference) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1459, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Trace-Reference AVP.
+func (v *TraceReference) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1459, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = TraceReference(d)
+	return nil
+}
+
+// TraceDepth is Trace-Depth AVP (code 1462, vendor 10415).
+type TraceDepth diameter.Enumerated
+
+const (
+	TraceDepthMinimum                               TraceDepth = 0 // Minimum
+	TraceDepthMedium                                TraceDepth = 1 // Medium
+	TraceDepthMaximum                               TraceDepth = 2 // Maximum
+	TraceDepthMinimumWithoutVendorSpecificExtension TraceDepth = 3 // MinimumWithoutVendorSpecificExtension
+	TraceDepthMediumWithoutVendorSpecificExtension  TraceDepth = 4 // MediumWithoutVendorSpecificExtension
+	TraceDepthMaximumWithoutVendorSpecificExtension TraceDepth = 5 // MaximumWithoutVendorSpecificExtension
+)
+
+func (v TraceDepth) String() string {
+	switch v {
+	case 0:
+		return "Minimum"
+	case 1:
+		return "Medium"
+	case 2:
+		return "Maximum"
+	case 3:
+		return "MinimumWithoutVendorSpecificExtension"
+	case 4:
+		return "MediumWithoutVendorSpecificExtension"
+	case 5:
+		return "MaximumWithoutVendorSpecificExtension"
+	}
+	return fmt.Sprintf("TraceDepth(%d)", v)
+}
+
+// ToAVP makes Trace-Depth AVP.
+func (v TraceDepth) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1462, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Trace-Depth AVP.
+func (v *TraceDepth) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1462, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = TraceDepth(d)
+	return nil
+}
+
+// TraceNETypeList is Trace-NE-Type-List AVP (code 1463, vendor 10415).
+type TraceNETypeList []byte
+
+// ToAVP makes Trace-NE-Type-List AVP.
+func (v TraceNETypeList) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1463, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Trace-NE-Type-List AVP.
+func (v *TraceNETypeList) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1463, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = TraceNETypeList(d)
+	return nil
+}
+
+// TraceInterfaceList is Trace-Interface-List AVP (code 1464, vendor 10415).
+type TraceInterfaceList []byte
+
+// ToAVP makes Trace-Interface-List AVP.
+func (v TraceInterfaceList) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1464, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Trace-Interface-List AVP.
+func (v *TraceInterfaceList) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1464, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = TraceInterfaceList(d)
+	return nil
+}
+
+// TraceEventList is Trace-Event-List AVP (code 1465, vendor 10415).
+type TraceEventList []byte
+
+// ToAVP makes Trace-Event-List AVP.
+func (v TraceEventList) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1465, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Trace-Event-List AVP.
+func (v *TraceEventList) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1465, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = TraceEventList(d)
+	return nil
+}
+
+// OMCId is OMC-Id AVP (code 1466, vendor 10415).
+type OMCId []byte
+
+// ToAVP makes OMC-Id AVP.
+func (v OMCId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1466, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads OMC-Id AVP.
+func (v *OMCId) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1466, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = OMCId(d)
+	return nil
+}
+
+// GPRSSubscriptionData is GPRS-Subscription-Data AVP (code 1467, vendor 10415).
+type GPRSSubscriptionData struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of GPRS-Subscription-Data.
+func (v GPRSSubscriptionData) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of GPRS-Subscription-Data.
+func (v *GPRSSubscriptionData) FromAVPs(avps []diameter.AVP) error {
+	*v = GPRSSubscriptionData{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes GPRS-Subscription-Data AVP.
+func (v GPRSSubscriptionData) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1467, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads GPRS-Subscription-Data AVP.
+func (v *GPRSSubscriptionData) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1467, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// CompleteDataListIncludedIndicator is Complete-Data-List-Included-Indicator AVP (code 1468, vendor 10415).
+type CompleteDataListIncludedIndicator diameter.Enumerated
+
+const (
+	CompleteDataListIncludedIndicatorAllPDPCONTEXTSINCLUDED           CompleteDataListIncludedIndicator = 0 // All_PDP_CONTEXTS_INCLUDED
+	CompleteDataListIncludedIndicatorModifiedAddedPdpContextsIncluded CompleteDataListIncludedIndicator = 1 // MODIFIED_ADDED_PDP CONTEXTS_INCLUDED
+)
+
+func (v CompleteDataListIncludedIndicator) String() string {
+	switch v {
+	case 0:
+		return "All_PDP_CONTEXTS_INCLUDED"
+	case 1:
+		return "MODIFIED_ADDED_PDP CONTEXTS_INCLUDED"
+	}
+	return fmt.Sprintf("CompleteDataListIncludedIndicator(%d)", v)
+}
+
+// ToAVP makes Complete-Data-List-Included-Indicator AVP.
+func (v CompleteDataListIncludedIndicator) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1468, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Complete-Data-List-Included-Indicator AVP.
+func (v *CompleteDataListIncludedIndicator) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1468, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = CompleteDataListIncludedIndicator(d)
+	return nil
+}
+
+// PDPContext is PDP-Context AVP (code 1469, vendor 10415).
+type PDPContext struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of PDP-Context.
+func (v PDPContext) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of PDP-Context.
+func (v *PDPContext) FromAVPs(avps []diameter.AVP) error {
+	*v = PDPContext{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes PDP-Context AVP.
+func (v PDPContext) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1469, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads PDP-Context AVP.
+func (v *PDPContext) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1469, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// PDPType is PDP-Type AVP (code 1470, vendor 10415).
+type PDPType []byte
+
+// ToAVP makes PDP-Type AVP.
+func (v PDPType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1470, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads PDP-Type AVP.
+func (v *PDPType) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1470, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PDPType(d)
+	return nil
+}
+
+// AVP3GPP2MEID is 3GPP2-MEID AVP (code 1471, vendor 10415).
+type AVP3GPP2MEID []byte
+
+// ToAVP makes 3GPP2-MEID AVP.
+func (v AVP3GPP2MEID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1471, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads 3GPP2-MEID AVP.
+func (v *AVP3GPP2MEID) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1471, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AVP3GPP2MEID(d)
+	return nil
+}
+
+// SpecificAPNInfo is Specific-APN-Info AVP (code 1472, vendor 10415).
+type SpecificAPNInfo struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Specific-APN-Info.
+func (v SpecificAPNInfo) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Specific-APN-Info.
+func (v *SpecificAPNInfo) FromAVPs(avps []diameter.AVP) error {
+	*v = SpecificAPNInfo{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Specific-APN-Info AVP.
+func (v SpecificAPNInfo) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1472, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Specific-APN-Info AVP.
+func (v *SpecificAPNInfo) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1472, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// LCSInfo is LCS-Info AVP (code 1473, vendor 10415).
+type LCSInfo struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of LCS-Info.
+func (v LCSInfo) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of LCS-Info.
+func (v *LCSInfo) FromAVPs(avps []diameter.AVP) error {
+	*v = LCSInfo{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes LCS-Info AVP.
+func (v LCSInfo) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1473, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads LCS-Info AVP.
+func (v *LCSInfo) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1473, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// GMLCNumber is GMLC-Number AVP (code 1474, vendor 10415).
+type GMLCNumber []byte
+
+// ToAVP makes GMLC-Number AVP.
+func (v GMLCNumber) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1474, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads GMLC-Number AVP.
+func (v *GMLCNumber) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1474, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = GMLCNumber(d)
+	return nil
+}
+
+// LCSPrivacyException is LCS-PrivacyException AVP (code 1475, vendor 10415).
+type LCSPrivacyException struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of LCS-PrivacyException.
+func (v LCSPrivacyException) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of LCS-PrivacyException.
+func (v *LCSPrivacyException) FromAVPs(avps []diameter.AVP) error {
+	*v = LCSPrivacyException{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes LCS-PrivacyException AVP.
+func (v LCSPrivacyException) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1475, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads LCS-PrivacyException AVP.
+func (v *LCSPrivacyException) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1475, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// SSCode is SS-Code AVP (code 1476, vendor 10415).
+type SSCode []byte
+
+// ToAVP makes SS-Code AVP.
+func (v SSCode) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1476, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads SS-Code AVP.
+func (v *SSCode) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1476, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SSCode(d)
+	return nil
+}
+
+// SSStatus is SS-Status AVP (code 1477, vendor 10415).
+type SSStatus []byte
+
+// ToAVP makes SS-Status AVP.
+func (v SSStatus) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1477, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads SS-Status AVP.
+func (v *SSStatus) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1477, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SSStatus(d)
+	return nil
+}
+
+// NotificationToUEUser is Notification-To-UE-User AVP (code 1478, vendor 10415).
+type NotificationToUEUser diameter.Enumerated
+
+const (
+	NotificationToUEUserNotifyLocationAllowed                         NotificationToUEUser = 0 // NOTIFY_LOCATION_ALLOWED
+	NotificationToUEUserNotifyandverifyLocationAllowedIfNoResponse    NotificationToUEUser = 1 // NOTIFYANDVERIFY_LOCATION_ALLOWED_IF_NO_RESPONSE
+	NotificationToUEUserNotifyandverifyLocationNotAllowedIfNoResponse NotificationToUEUser = 2 // NOTIFYANDVERIFY_LOCATION_NOT_ALLOWED_IF_NO_RESPONSE
+	NotificationToUEUserLocationNotAllowed                            NotificationToUEUser = 3 // LOCATION_NOT_ALLOWED
+)
+
+func (v NotificationToUEUser) String() string {
+	switch v {
+	case 0:
+		return "NOTIFY_LOCATION_ALLOWED"
+	case 1:
+		return "NOTIFYANDVERIFY_LOCATION_ALLOWED_IF_NO_RESPONSE"
+	case 2:
+		return "NOTIFYANDVERIFY_LOCATION_NOT_ALLOWED_IF_NO_RESPONSE"
+	case 3:
+		return "LOCATION_NOT_ALLOWED"
+	}
+	return fmt.Sprintf("NotificationToUEUser(%d)", v)
+}
+
+// ToAVP makes Notification-To-UE-User AVP.
+func (v NotificationToUEUser) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1478, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Notification-To-UE-User AVP.
+func (v *NotificationToUEUser) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1478, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = NotificationToUEUser(d)
+	return nil
+}
+
+// ExternalClient is External-Client AVP (code 1479, vendor 10415).
+type ExternalClient struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of External-Client.
+func (v ExternalClient) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of External-Client.
+func (v *ExternalClient) FromAVPs(avps []diameter.AVP) error {
+	*v = ExternalClient{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes External-Client AVP.
+func (v ExternalClient) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1479, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads External-Client AVP.
+func (v *ExternalClient) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1479, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ClientIdentity is Client-Identity AVP (code 1480, vendor 10415).
+type ClientIdentity []byte
+
+// ToAVP makes Client-Identity AVP.
+func (v ClientIdentity) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1480, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Client-Identity AVP.
+func (v *ClientIdentity) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1480, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ClientIdentity(d)
+	return nil
+}
+
+// GMLCRestriction is GMLC-Restriction AVP (code 1481, vendor 10415).
+type GMLCRestriction diameter.Enumerated
+
+const (
+	GMLCRestrictionGmlcList    GMLCRestriction = 0 // GMLC_LIST
+	GMLCRestrictionHomeCountry GMLCRestriction = 1 // HOME_COUNTRY
+)
+
+func (v GMLCRestriction) String() string {
+	switch v {
+	case 0:
+		return "GMLC_LIST"
+	case 1:
+		return "HOME_COUNTRY"
+	}
+	return fmt.Sprintf("GMLCRestriction(%d)", v)
+}
+
+// ToAVP makes GMLC-Restriction AVP.
+func (v GMLCRestriction) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1481, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads GMLC-Restriction AVP.
+func (v *GMLCRestriction) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1481, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = GMLCRestriction(d)
+	return nil
+}
+
+// PLMNClient is PLMN-Client AVP (code 1482, vendor 10415).
+type PLMNClient diameter.Enumerated
+
+const (
+	PLMNClientBroadcastService          PLMNClient = 0 // BROADCAST_SERVICE
+	PLMNClientOAndMHplmn                PLMNClient = 1 // O_AND_M_HPLMN
+	PLMNClientOAndMVplmn                PLMNClient = 2 // O_AND_M_VPLMN
+	PLMNClientAnonymousLocation         PLMNClient = 3 // ANONYMOUS_LOCATION
+	PLMNClientTargetUeSubscribedService PLMNClient = 4 // TARGET_UE_SUBSCRIBED_SERVICE
+)
+
+func (v PLMNClient) String() string {
+	switch v {
+	case 0:
+		return "BROADCAST_SERVICE"
+	case 1:
+		return "O_AND_M_HPLMN"
+	case 2:
+		return "O_AND_M_VPLMN"
+	case 3:
+		return "ANONYMOUS_LOCATION"
+	case 4:
+		return "TARGET_UE_SUBSCRIBED_SERVICE"
+	}
+	return fmt.Sprintf("PLMNClient(%d)", v)
+}
+
+// ToAVP makes PLMN-Client AVP.
+func (v PLMNClient) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1482, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads PLMN-Client AVP.
+func (v *PLMNClient) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1482, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PLMNClient(d)
+	return nil
+}
+
+// ServiceType is Service-Type AVP (code 1483, vendor 10415).
+type ServiceType struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Service-Type.
+func (v ServiceType) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Service-Type.
+func (v *ServiceType) FromAVPs(avps []diameter.AVP) error {
+	*v = ServiceType{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Service-Type AVP.
+func (v ServiceType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1483, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Service-Type AVP.
+func (v *ServiceType) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1483, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ServiceTypeIdentity is ServiceTypeIdentity AVP (code 1484, vendor 10415).
+type ServiceTypeIdentity uint32
+
+// ToAVP makes ServiceTypeIdentity AVP.
+func (v ServiceTypeIdentity) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1484, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads ServiceTypeIdentity AVP.
+func (v *ServiceTypeIdentity) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1484, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ServiceTypeIdentity(d)
+	return nil
+}
+
+// MOLR is MO-LR AVP (code 1485, vendor 10415).
+type MOLR struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of MO-LR.
+func (v MOLR) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of MO-LR.
+func (v *MOLR) FromAVPs(avps []diameter.AVP) error {
+	*v = MOLR{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes MO-LR AVP.
+func (v MOLR) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1485, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads MO-LR AVP.
+func (v *MOLR) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1485, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// TeleserviceList is Teleservice-List AVP (code 1486, vendor 10415).
+type TeleserviceList struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Teleservice-List.
+func (v TeleserviceList) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Teleservice-List.
+func (v *TeleserviceList) FromAVPs(avps []diameter.AVP) error {
+	*v = TeleserviceList{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Teleservice-List AVP.
+func (v TeleserviceList) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1486, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Teleservice-List AVP.
+func (v *TeleserviceList) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1486, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// TSCode is TS-Code AVP (code 1487, vendor 10415).
+type TSCode []byte
+
+// ToAVP makes TS-Code AVP.
+func (v TSCode) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1487, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads TS-Code AVP.
+func (v *TSCode) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1487, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = TSCode(d)
+	return nil
+}
+
+// CallBarringInfo is Call-Barring-Info AVP (code 1488, vendor 10415).
+type CallBarringInfo struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Call-Barring-Info.
+func (v CallBarringInfo) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Call-Barring-Info.
+func (v *CallBarringInfo) FromAVPs(avps []diameter.AVP) error {
+	*v = CallBarringInfo{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Call-Barring-Info AVP.
+func (v CallBarringInfo) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1488, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Call-Barring-Info AVP.
+func (v *CallBarringInfo) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1488, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// SGSNNumber is SGSN-Number AVP (code 1489, vendor 10415).
+type SGSNNumber []byte
+
+// ToAVP makes SGSN-Number AVP.
+func (v SGSNNumber) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1489, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads SGSN-Number AVP.
+func (v *SGSNNumber) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1489, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SGSNNumber(d)
+	return nil
+}
+
+// IDRFlags is IDR-Flags AVP (code 1490, vendor 10415).
+type IDRFlags uint32
+
+// ToAVP makes IDR-Flags AVP.
+func (v IDRFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1490, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads IDR-Flags AVP.
+func (v *IDRFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1490, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = IDRFlags(d)
+	return nil
+}
+
+// ICSIndicator is ICS-Indicator AVP (code 1491, vendor 10415).
+type ICSIndicator diameter.Enumerated
+
+const (
+	ICSIndicatorFalse ICSIndicator = 0 // FALSE
+	ICSIndicatorTrue  ICSIndicator = 1 // TRUE
+)
+
+func (v ICSIndicator) String() string {
+	switch v {
+	case 0:
+		return "FALSE"
+	case 1:
+		return "TRUE"
+	}
+	return fmt.Sprintf("ICSIndicator(%d)", v)
+}
+
+// ToAVP makes ICS-Indicator AVP.
+func (v ICSIndicator) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1491, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads ICS-Indicator AVP.
+func (v *ICSIndicator) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1491, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ICSIndicator(d)
+	return nil
+}
+
+// IMSVoiceOverPSSessionsSupported is IMS-Voice-Over-PS-Sessions-Supported AVP (code 1492, vendor 10415).
+type IMSVoiceOverPSSessionsSupported diameter.Enumerated
+
+const (
+	IMSVoiceOverPSSessionsSupportedNotSupported IMSVoiceOverPSSessionsSupported = 0 // NOT_SUPPORTED
+	IMSVoiceOverPSSessionsSupportedSupported    IMSVoiceOverPSSessionsSupported = 1 // SUPPORTED
+)
+
+func (v IMSVoiceOverPSSessionsSupported) String() string {
+	switch v {
+	case 0:
+		return "NOT_SUPPORTED"
+	case 1:
+		return "SUPPORTED"
+	}
+	return fmt.Sprintf("IMSVoiceOverPSSessionsSupported(%d)", v)
+}
+
+// ToAVP makes IMS-Voice-Over-PS-Sessions-Supported AVP.
+func (v IMSVoiceOverPSSessionsSupported) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1492, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads IMS-Voice-Over-PS-Sessions-Supported AVP.
+func (v *IMSVoiceOverPSSessionsSupported) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1492, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = IMSVoiceOverPSSessionsSupported(d)
+	return nil
+}
+
+// HomogeneousSupportOfIMSVoiceOverPSSessions is Homogeneous-Support-of-IMS-Voice-Over-PS-Sessions AVP (code 1493, vendor 10415).
+type HomogeneousSupportOfIMSVoiceOverPSSessions diameter.Enumerated
+
+const (
+	HomogeneousSupportOfIMSVoiceOverPSSessionsNotSupported HomogeneousSupportOfIMSVoiceOverPSSessions = 0 // NOT_SUPPORTED
+	HomogeneousSupportOfIMSVoiceOverPSSessionsSupported    HomogeneousSupportOfIMSVoiceOverPSSessions = 1 // SUPPORTED
+)
+
+func (v HomogeneousSupportOfIMSVoiceOverPSSessions) String() string {
+	switch v {
+	case 0:
+		return "NOT_SUPPORTED"
+	case 1:
+		return "SUPPORTED"
+	}
+	return fmt.Sprintf("HomogeneousSupportOfIMSVoiceOverPSSessions(%d)", v)
+}
+
+// ToAVP makes Homogeneous-Support-of-IMS-Voice-Over-PS-Sessions AVP.
+func (v HomogeneousSupportOfIMSVoiceOverPSSessions) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1493, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Homogeneous-Support-of-IMS-Voice-Over-PS-Sessions AVP.
+func (v *HomogeneousSupportOfIMSVoiceOverPSSessions) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1493, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = HomogeneousSupportOfIMSVoiceOverPSSessions(d)
+	return nil
+}
+
+// LastUEActivityTime is Last-UE-Activity-Time AVP (code 1494, vendor 10415).
+type LastUEActivityTime time.Time
+
+// ToAVP makes Last-UE-Activity-Time AVP.
+func (v LastUEActivityTime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1494, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(time.Time(v))
+	return a
+}
+
+// FromAVP reads Last-UE-Activity-Time AVP.
+func (v *LastUEActivityTime) FromAVP(a diameter.AVP) error {
+	var d time.Time
+	if e := checkAVP(a, 1494, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = LastUEActivityTime(d)
+	return nil
+}
+
+// EPSUserState is EPS-User-State AVP (code 1495, vendor 10415).
+type EPSUserState struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of EPS-User-State.
+func (v EPSUserState) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of EPS-User-State.
+func (v *EPSUserState) FromAVPs(avps []diameter.AVP) error {
+	*v = EPSUserState{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes EPS-User-State AVP.
+func (v EPSUserState) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1495, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads EPS-User-State AVP.
+func (v *EPSUserState) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1495, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// EPSLocationInformation is EPS-Location-Information AVP (code 1496, vendor 10415).
+type EPSLocationInformation struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of EPS-Location-Information.
+func (v EPSLocationInformation) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of EPS-Location-Information.
+func (v *EPSLocationInformation) FromAVPs(avps []diameter.AVP) error {
+	*v = EPSLocationInformation{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes EPS-Location-Information AVP.
+func (v EPSLocationInformation) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1496, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads EPS-Location-Information AVP.
+func (v *EPSLocationInformation) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1496, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// MMEUserState is MME-User-State AVP (code 1497, vendor 10415).
+type MMEUserState struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of MME-User-State.
+func (v MMEUserState) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of MME-User-State.
+func (v *MMEUserState) FromAVPs(avps []diameter.AVP) error {
+	*v = MMEUserState{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes MME-User-State AVP.
+func (v MMEUserState) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1497, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads MME-User-State AVP.
+func (v *MMEUserState) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1497, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// SGSNUserState is SGSN-User-State AVP (code 1498, vendor 10415).
+type SGSNUserState struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of SGSN-User-State.
+func (v SGSNUserState) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of SGSN-User-State.
+func (v *SGSNUserState) FromAVPs(avps []diameter.AVP) error {
+	*v = SGSNUserState{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes SGSN-User-State AVP.
+func (v SGSNUserState) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1498, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads SGSN-User-State AVP.
+func (v *SGSNUserState) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1498, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// UserState is User-State AVP (code 1499, vendor 10415).
+type UserState diameter.Enumerated
+
+const (
+	UserStateDetached                       UserState = 0 // DETACHED
+	UserStateAttachedNotReachableForPaging  UserState = 1 // ATTACHED_NOT_REACHABLE_FOR_PAGING
+	UserStateAttachedReachableForPaging     UserState = 2 // ATTACHED_REACHABLE_FOR_PAGING
+	UserStateConnectedNotReachableForPaging UserState = 3 // CONNECTED_NOT_REACHABLE_FOR_PAGING
+	UserStateConnectedReachableForPaging    UserState = 4 // CONNECTED_REACHABLE_FOR_PAGING
+	UserStateReserved                       UserState = 5 // RESERVED
+)
+
+func (v UserState) String() string {
+	switch v {
+	case 0:
+		return "DETACHED"
+	case 1:
+		return "ATTACHED_NOT_REACHABLE_FOR_PAGING"
+	case 2:
+		return "ATTACHED_REACHABLE_FOR_PAGING"
+	case 3:
+		return "CONNECTED_NOT_REACHABLE_FOR_PAGING"
+	case 4:
+		return "CONNECTED_REACHABLE_FOR_PAGING"
+	case 5:
+		return "RESERVED"
+	}
+	return fmt.Sprintf("UserState(%d)", v)
+}
+
+// ToAVP makes User-State AVP.
+func (v UserState) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1499, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads User-State AVP.
+func (v *UserState) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1499, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = UserState(d)
+	return nil
+}
+
+// MMELocationInformation is MME-Location Information AVP (code 1600, vendor 10415).
+type MMELocationInformation struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of MME-Location Information.
+func (v MMELocationInformation) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of MME-Location Information.
+func (v *MMELocationInformation) FromAVPs(avps []diameter.AVP) error {
+	*v = MMELocationInformation{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes MME-Location Information AVP.
+func (v MMELocationInformation) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1600, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads MME-Location Information AVP.
+func (v *MMELocationInformation) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1600, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// SGSNLocationInformation is SGSN-Location-Information AVP (code 1601, vendor 10415).
+type SGSNLocationInformation struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of SGSN-Location-Information.
+func (v SGSNLocationInformation) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of SGSN-Location-Information.
+func (v *SGSNLocationInformation) FromAVPs(avps []diameter.AVP) error {
+	*v = SGSNLocationInformation{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes SGSN-Location-Information AVP.
+func (v SGSNLocationInformation) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1601, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads SGSN-Location-Information AVP.
+func (v *SGSNLocationInformation) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1601, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// EUTRANCellGlobalIdentity is E-UTRAN-Cell-Global-Identity AVP (code 1602, vendor 10415).
+type EUTRANCellGlobalIdentity []byte
+
+// ToAVP makes E-UTRAN-Cell-Global-Identity AVP.
+func (v EUTRANCellGlobalIdentity) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1602, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads E-UTRAN-Cell-Global-Identity AVP.
+func (v *EUTRANCellGlobalIdentity) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1602, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = EUTRANCellGlobalIdentity(d)
+	return nil
+}
+
+// TrackingAreaIdentity is Tracking-Area-Identity AVP (code 1603, vendor 10415).
+type TrackingAreaIdentity []byte
+
+// ToAVP makes Tracking-Area-Identity AVP.
+func (v TrackingAreaIdentity) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1603, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Tracking-Area-Identity AVP.
+func (v *TrackingAreaIdentity) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1603, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = TrackingAreaIdentity(d)
+	return nil
+}
+
+// CellGlobalIdentity is Cell-Global-Identity AVP (code 1604, vendor 10415).
+type CellGlobalIdentity []byte
+
+// ToAVP makes Cell-Global-Identity AVP.
+func (v CellGlobalIdentity) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1604, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Cell-Global-Identity AVP.
+func (v *CellGlobalIdentity) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1604, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = CellGlobalIdentity(d)
+	return nil
+}
+
+// RoutingAreaIdentity is Routing-Area-Identity AVP (code 1605, vendor 10415).
+type RoutingAreaIdentity []byte
+
+// ToAVP makes Routing-Area-Identity AVP.
+func (v RoutingAreaIdentity) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1605, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Routing-Area-Identity AVP.
+func (v *RoutingAreaIdentity) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1605, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RoutingAreaIdentity(d)
+	return nil
+}
+
+// LocationAreaIdentity is Location-Area-Identity AVP (code 1606, vendor 10415).
+type LocationAreaIdentity []byte
+
+// ToAVP makes Location-Area-Identity AVP.
+func (v LocationAreaIdentity) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1606, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Location-Area-Identity AVP.
+func (v *LocationAreaIdentity) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1606, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = LocationAreaIdentity(d)
+	return nil
+}
+
+// ServiceAreaIdentity is Service-Area-Identity AVP (code 1607, vendor 10415).
+type ServiceAreaIdentity []byte
+
+// ToAVP makes Service-Area-Identity AVP.
+func (v ServiceAreaIdentity) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1607, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Service-Area-Identity AVP.
+func (v *ServiceAreaIdentity) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1607, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ServiceAreaIdentity(d)
+	return nil
+}
+
+// GeographicalInformation is Geographical-Information AVP (code 1608, vendor 10415).
+type GeographicalInformation []byte
+
+// ToAVP makes Geographical-Information AVP.
+func (v GeographicalInformation) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1608, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Geographical-Information AVP.
+func (v *GeographicalInformation) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1608, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = GeographicalInformation(d)
+	return nil
+}
+
+// GeodeticInformation is Geodetic-Information AVP (code 1609, vendor 10415).
+type GeodeticInformation []byte
+
+// ToAVP makes Geodetic-Information AVP.
+func (v GeodeticInformation) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1609, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Geodetic-Information AVP.
+func (v *GeodeticInformation) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1609, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = GeodeticInformation(d)
+	return nil
+}
+
+// CurrentLocationRetrieved is Current-Location-Retrieved AVP (code 1610, vendor 10415).
+type CurrentLocationRetrieved diameter.Enumerated
+
+const (
+	CurrentLocationRetrievedActiveLocationRetrieval CurrentLocationRetrieved = 0 // ACTIVE-LOCATION-RETRIEVAL
+)
+
+func (v CurrentLocationRetrieved) String() string {
+	switch v {
+	case 0:
+		return "ACTIVE-LOCATION-RETRIEVAL"
+	}
+	return fmt.Sprintf("CurrentLocationRetrieved(%d)", v)
+}
+
+// ToAVP makes Current-Location-Retrieved AVP.
+func (v CurrentLocationRetrieved) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1610, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Current-Location-Retrieved AVP.
+func (v *CurrentLocationRetrieved) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1610, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = CurrentLocationRetrieved(d)
+	return nil
+}
+
+// AgeOfLocationInformation is Age-Of-Location-Information AVP (code 1611, vendor 10415).
+type AgeOfLocationInformation uint32
+
+// ToAVP makes Age-Of-Location-Information AVP.
+func (v AgeOfLocationInformation) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1611, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Age-Of-Location-Information AVP.
+func (v *AgeOfLocationInformation) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1611, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AgeOfLocationInformation(d)
+	return nil
+}
+
+// ActiveAPN is Active-APN AVP (code 1612, vendor 10415).
+type ActiveAPN struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Active-APN.
+func (v ActiveAPN) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Active-APN.
+func (v *ActiveAPN) FromAVPs(avps []diameter.AVP) error {
+	*v = ActiveAPN{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Active-APN AVP.
+func (v ActiveAPN) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1612, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Active-APN AVP.
+func (v *ActiveAPN) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1612, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ErrorDiagnostic is Error-Diagnostic AVP (code 1614, vendor 10415).
+type ErrorDiagnostic diameter.Enumerated
+
+const (
+	ErrorDiagnosticGprsDataSubscribed   ErrorDiagnostic = 0 // GPRS_DATA_SUBSCRIBED
+	ErrorDiagnosticNoGprsDataSubscribed ErrorDiagnostic = 1 // NO_GPRS_DATA_SUBSCRIBED
+	ErrorDiagnosticOdbAllApn            ErrorDiagnostic = 2 // ODB_ALL_APN
+	ErrorDiagnosticOdbHplmnApn          ErrorDiagnostic = 3 // ODB_HPLMN_APN
+	ErrorDiagnosticOdbVplmnApn          ErrorDiagnostic = 4 // ODB_VPLMN_APN
+)
+
+func (v ErrorDiagnostic) String() string {
+	switch v {
+	case 0:
+		return "GPRS_DATA_SUBSCRIBED"
+	case 1:
+		return "NO_GPRS_DATA_SUBSCRIBED"
+	case 2:
+		return "ODB_ALL_APN"
+	case 3:
+		return "ODB_HPLMN_APN"
+	case 4:
+		return "ODB_VPLMN_APN"
+	}
+	return fmt.Sprintf("ErrorDiagnostic(%d)", v)
+}
+
+// ToAVP makes Error-Diagnostic AVP.
+func (v ErrorDiagnostic) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1614, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Error-Diagnostic AVP.
+func (v *ErrorDiagnostic) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1614, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ErrorDiagnostic(d)
+	return nil
+}
+
+// ExtPDPAddress is Ext-PDP-Address AVP (code 1621, vendor 10415).
+type ExtPDPAddress net.IP
+
+// ToAVP makes Ext-PDP-Address AVP.
+func (v ExtPDPAddress) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1621, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(net.IP(v))
+	return a
+}
+
+// FromAVP reads Ext-PDP-Address AVP.
+func (v *ExtPDPAddress) FromAVP(a diameter.AVP) error {
+	var d net.IP
+	if e := checkAVP(a, 1621, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ExtPDPAddress(d)
+	return nil
+}
+
+// UESRVCCCapability is UE-SRVCC-Capability AVP (code 1615, vendor 10415).
+type UESRVCCCapability diameter.Enumerated
+
+const (
+	UESRVCCCapabilityUeSrvccNotSupported UESRVCCCapability = 0 // UE-SRVCC-NOT-SUPPORTED
+	UESRVCCCapabilityUeSrvccSupported    UESRVCCCapability = 1 // UE-SRVCC-SUPPORTED
+)
+
+func (v UESRVCCCapability) String() string {
+	switch v {
+	case 0:
+		return "UE-SRVCC-NOT-SUPPORTED"
+	case 1:
+		return "UE-SRVCC-SUPPORTED"
+	}
+	return fmt.Sprintf("UESRVCCCapability(%d)", v)
+}
+
+// ToAVP makes UE-SRVCC-Capability AVP.
+func (v UESRVCCCapability) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1615, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads UE-SRVCC-Capability AVP.
+func (v *UESRVCCCapability) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1615, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = UESRVCCCapability(d)
+	return nil
+}
+
+// MPSPriority is MPS-Priority AVP (code 1616, vendor 10415).
+type MPSPriority uint32
+
+// ToAVP makes MPS-Priority AVP.
+func (v MPSPriority) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1616, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads MPS-Priority AVP.
+func (v *MPSPriority) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1616, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MPSPriority(d)
+	return nil
+}
+
+// VPLMNLIPAAllowed is VPLMN-LIPA-Allowed AVP (code 1617, vendor 10415).
+type VPLMNLIPAAllowed diameter.Enumerated
+
+const (
+	VPLMNLIPAAllowedLipaNotallowed VPLMNLIPAAllowed = 0 // LIPA_NOTALLOWED
+	VPLMNLIPAAllowedLipaAllowed    VPLMNLIPAAllowed = 1 // LIPA_ALLOWED
+)
+
+func (v VPLMNLIPAAllowed) String() string {
+	switch v {
+	case 0:
+		return "LIPA_NOTALLOWED"
+	case 1:
+		return "LIPA_ALLOWED"
+	}
+	return fmt.Sprintf("VPLMNLIPAAllowed(%d)", v)
+}
+
+// ToAVP makes VPLMN-LIPA-Allowed AVP.
+func (v VPLMNLIPAAllowed) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1617, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads VPLMN-LIPA-Allowed AVP.
+func (v *VPLMNLIPAAllowed) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1617, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = VPLMNLIPAAllowed(d)
+	return nil
+}
+
+// LIPAPermission is LIPA-Permission AVP (code 1618, vendor 10415).
+type LIPAPermission diameter.Enumerated
+
+const (
+	LIPAPermissionLipaProhibited  LIPAPermission = 0 // LIPA_PROHIBITED
+	LIPAPermissionLipaOnly        LIPAPermission = 1 // LIPA_ONLY
+	LIPAPermissionLipaConditional LIPAPermission = 2 // LIPA_CONDITIONAL
+)
+
+func (v LIPAPermission) String() string {
+	switch v {
+	case 0:
+		return "LIPA_PROHIBITED"
+	case 1:
+		return "LIPA_ONLY"
+	case 2:
+		return "LIPA_CONDITIONAL"
+	}
+	return fmt.Sprintf("LIPAPermission(%d)", v)
+}
+
+// ToAVP makes LIPA-Permission AVP.
+func (v LIPAPermission) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1618, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads LIPA-Permission AVP.
+func (v *LIPAPermission) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1618, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = LIPAPermission(d)
+	return nil
+}
+
+// SubscribedPeriodicRAUTAUTimer is Subscribed-Periodic-RAU-TAU-Timer AVP (code 1619, vendor 10415).
+type SubscribedPeriodicRAUTAUTimer uint32
+
+// ToAVP makes Subscribed-Periodic-RAU-TAU-Timer AVP.
+func (v SubscribedPeriodicRAUTAUTimer) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1619, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Subscribed-Periodic-RAU-TAU-Timer AVP.
+func (v *SubscribedPeriodicRAUTAUTimer) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1619, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SubscribedPeriodicRAUTAUTimer(d)
+	return nil
+}
+
+// ExtPDPType is Ext-PDP-Type AVP (code 1620, vendor 10415).
+type ExtPDPType []byte
+
+// ToAVP makes Ext-PDP-Type AVP.
+func (v ExtPDPType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1620, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Ext-PDP-Type AVP.
+func (v *ExtPDPType) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1620, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ExtPDPType(d)
+	return nil
+}
+
+// SIPTOPermission is SIPTO-Permission AVP (code 1613, vendor 10415).
+type SIPTOPermission diameter.Enumerated
+
+const (
+	SIPTOPermissionSIPTOAboveRANALLOWED    SIPTOPermission = 0 // SIPTO_above_RAN_ALLOWED
+	SIPTOPermissionSIPTOAboveRANNOTALLOWED SIPTOPermission = 1 // SIPTO_above_RAN_NOTALLOWED
+)
+
+func (v SIPTOPermission) String() string {
+	switch v {
+	case 0:
+		return "SIPTO_above_RAN_ALLOWED"
+	case 1:
+		return "SIPTO_above_RAN_NOTALLOWED"
+	}
+	return fmt.Sprintf("SIPTOPermission(%d)", v)
+}
+
+// ToAVP makes SIPTO-Permission AVP.
+func (v SIPTOPermission) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1613, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads SIPTO-Permission AVP.
+func (v *SIPTOPermission) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1613, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SIPTOPermission(d)
+	return nil
+}
+
+// MDTConfiguration is MDT-Configuration AVP (code 1622, vendor 10415).
+type MDTConfiguration struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of MDT-Configuration.
+func (v MDTConfiguration) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of MDT-Configuration.
+func (v *MDTConfiguration) FromAVPs(avps []diameter.AVP) error {
+	*v = MDTConfiguration{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes MDT-Configuration AVP.
+func (v MDTConfiguration) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1622, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads MDT-Configuration AVP.
+func (v *MDTConfiguration) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1622, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// JobType is Job-Type AVP (code 1623, vendor 10415).
+type JobType diameter.Enumerated
+
+const (
+	JobTypeImmediateMDTOnly     JobType = 0 // Immediate MDT only
+	JobTypeLoggedMDTOnly        JobType = 1 // Logged MDT only
+	JobTypeTraceOnly            JobType = 2 // Trace only
+	JobTypeImmediateMDTAndTrace JobType = 3 // Immediate MDT and Trace
+	JobTypeRLFReportsOnly       JobType = 4 // RLF reports only
+	JobTypeRCEFReportsOnly      JobType = 5 // RCEF reports only
+	JobTypeLoggedMBSFNMDT       JobType = 6 // Logged MBSFN MDT
+)
+
+func (v JobType) String() string {
+	switch v {
+	case 0:
+		return "Immediate MDT only"
+	case 1:
+		return "Logged MDT only"
+	case 2:
+		return "Trace only"
+	case 3:
+		return "Immediate MDT and Trace"
+	case 4:
+		return "RLF reports only"
+	case 5:
+		return "RCEF reports only"
+	case 6:
+		return "Logged MBSFN MDT"
+	}
+	return fmt.Sprintf("JobType(%d)", v)
+}
+
+// ToAVP makes Job-Type AVP.
+func (v JobType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1623, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Job-Type AVP.
+func (v *JobType) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1623, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = JobType(d)
+	return nil
+}
+
+// AreaScope is Area-Scope AVP (code 1624, vendor 10415).
+type AreaScope struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Area-Scope.
+func (v AreaScope) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Area-Scope.
+func (v *AreaScope) FromAVPs(avps []diameter.AVP) error {
+	*v = AreaScope{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Area-Scope AVP.
+func (v AreaScope) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1624, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Area-Scope AVP.
+func (v *AreaScope) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1624, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ListOfMeasurements is List-Of-Measurements AVP (code 1625, vendor 10415).
+type ListOfMeasurements uint32
+
+// ToAVP makes List-Of-Measurements AVP.
+func (v ListOfMeasurements) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1625, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads List-Of-Measurements AVP.
+func (v *ListOfMeasurements) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1625, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ListOfMeasurements(d)
+	return nil
+}
+
+// ReportingTrigger is Reporting-Trigger AVP (code 1626, vendor 10415).
+type ReportingTrigger uint32
+
+// ToAVP makes Reporting-Trigger AVP.
+func (v ReportingTrigger) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1626, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Reporting-Trigger AVP.
+func (v *ReportingTrigger) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1626, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ReportingTrigger(d)
+	return nil
+}
+
+// ReportInterval is Report-Interval AVP (code 1627, vendor 10415).
+type ReportInterval diameter.Enumerated
+
+const (
+	ReportIntervalUMTS250Ms    ReportInterval = 0  // UMTS 250 ms
+	ReportIntervalUMTS500Ms    ReportInterval = 1  // UMTS 500 ms
+	ReportIntervalUMTS1000Ms   ReportInterval = 2  // UMTS 1000 ms
+	ReportIntervalUMTS2000Ms   ReportInterval = 3  // UMTS 2000 ms
+	ReportIntervalUMTS3000Ms   ReportInterval = 4  // UMTS 3000 ms
+	ReportIntervalUMTS4000Ms   ReportInterval = 5  // UMTS 4000 ms
+	ReportIntervalUMTS6000Ms   ReportInterval = 6  // UMTS 6000 ms
+	ReportIntervalUMTS8000Ms   ReportInterval = 7  // UMTS 8000 ms
+	ReportIntervalUMTS12000Ms  ReportInterval = 8  // UMTS 12000 ms
+	ReportIntervalUMTS16000Ms  ReportInterval = 9  // UMTS 16000 ms
+	ReportIntervalUMTS20000Ms  ReportInterval = 10 // UMTS 20000 ms
+	ReportIntervalUMTS24000Ms  ReportInterval = 11 // UMTS 24000 ms
+	ReportIntervalUMTS28000Ms  ReportInterval = 12 // UMTS 28000 ms
+	ReportIntervalUMTS32000Ms  ReportInterval = 13 // UMTS 32000 ms
+	ReportIntervalUMTS64000Ms  ReportInterval = 14 // UMTS 64000 ms
+	ReportIntervalLTE120Ms     ReportInterval = 15 // LTE 120 ms
+	ReportIntervalLTE240Ms     ReportInterval = 16 // LTE 240 ms
+	ReportIntervalLTE480Ms     ReportInterval = 17 // LTE 480 ms
+	ReportIntervalLTE640Ms     ReportInterval = 18 // LTE 640 ms
+	ReportIntervalLTE1024Ms    ReportInterval = 19 // LTE 1024 ms
+	ReportIntervalLTE2048Ms    ReportInterval = 20 // LTE 2048 ms
+	ReportIntervalLTE5120Ms    ReportInterval = 21 // LTE 5120 ms
+	ReportIntervalLTE10240Ms   ReportInterval = 22 // LTE 10240 ms
+	ReportIntervalLTE60000Ms   ReportInterval = 23 // LTE 60000 ms
+	ReportIntervalLTE360000Ms  ReportInterval = 24 // LTE 360000 ms
+	ReportIntervalLTE720000Ms  ReportInterval = 25 // LTE 720000 ms
+	ReportIntervalLTE1800000Ms ReportInterval = 26 // LTE 1800000 ms
+	ReportIntervalLTE3600000Ms ReportInterval = 27 // LTE 3600000 ms
+	ReportIntervalNR120Ms      ReportInterval = 28 // NR 120 ms
+	ReportIntervalNR240Ms      ReportInterval = 29 // NR 240 ms
+	ReportIntervalNR480Ms      ReportInterval = 30 // NR 480 ms
+	ReportIntervalNR640Ms      ReportInterval = 31 // NR 640 ms
+	ReportIntervalNR1024Ms     ReportInterval = 32 // NR 1024 ms
+	ReportIntervalNR2048Ms     ReportInterval = 33 // NR 2048 ms
+	ReportIntervalNR5120Ms     ReportInterval = 34 // NR 5120 ms
+	ReportIntervalNR10240Ms    ReportInterval = 35 // NR 10240 ms
+	ReportIntervalNR20480Ms    ReportInterval = 36 // NR 20480 ms
+	ReportIntervalNR40960Ms    ReportInterval = 37 // NR 40960 ms
+	ReportIntervalNR60000Ms    ReportInterval = 38 // NR 60000 ms
+	ReportIntervalNR360000Ms   ReportInterval = 39 // NR 360000 ms
+	ReportIntervalNR720000Ms   ReportInterval = 40 // NR 720000 ms
+	ReportIntervalNR1800000Ms  ReportInterval = 41 // NR 1800000 ms
+	ReportIntervalNR3600000Ms  ReportInterval = 42 // NR 3600000 ms
+)
+
+func (v ReportInterval) String() string {
+	switch v {
+	case 0:
+		return "UMTS 250 ms"
+	case 1:
+		return "UMTS 500 ms"
+	case 2:
+		return "UMTS 1000 ms"
+	case 3:
+		return "UMTS 2000 ms"
+	case 4:
+		return "UMTS 3000 ms"
+	case 5:
+		return "UMTS 4000 ms"
+	case 6:
+		return "UMTS 6000 ms"
+	case 7:
+		return "UMTS 8000 ms"
+	case 8:
+		return "UMTS 12000 ms"
+	case 9:
+		return "UMTS 16000 ms"
+	case 10:
+		return "UMTS 20000 ms"
+	case 11:
+		return "UMTS 24000 ms"
+	case 12:
+		return "UMTS 28000 ms"
+	case 13:
+		return "UMTS 32000 ms"
+	case 14:
+		return "UMTS 64000 ms"
+	case 15:
+		return "LTE 120 ms"
+	case 16:
+		return "LTE 240 ms"
+	case 17:
+		return "LTE 480 ms"
+	case 18:
+		return "LTE 640 ms"
+	case 19:
+		return "LTE 1024 ms"
+	case 20:
+		return "LTE 2048 ms"
+	case 21:
+		return "LTE 5120 ms"
+	case 22:
+		return "LTE 10240 ms"
+	case 23:
+		return "LTE 60000 ms"
+	case 24:
+		return "LTE 360000 ms"
+	case 25:
+		return "LTE 720000 ms"
+	case 26:
+		return "LTE 1800000 ms"
+	case 27:
+		return "LTE 3600000 ms"
+	case 28:
+		return "NR 120 ms"
+	case 29:
+		return "NR 240 ms"
+	case 30:
+		return "NR 480 ms"
+	case 31:
+		return "NR 640 ms"
+	case 32:
+		return "NR 1024 ms"
+	case 33:
+		return "NR 2048 ms"
+	case 34:
+		return "NR 5120 ms"
+	case 35:
+		return "NR 10240 ms"
+	case 36:
+		return "NR 20480 ms"
+	case 37:
+		return "NR 40960 ms"
+	case 38:
+		return "NR 60000 ms"
+	case 39:
+		return "NR 360000 ms"
+	case 40:
+		return "NR 720000 ms"
+	case 41:
+		return "NR 1800000 ms"
+	case 42:
+		return "NR 3600000 ms"
+	}
+	return fmt.Sprintf("ReportInterval(%d)", v)
+}
+
+// ToAVP makes Report-Interval AVP.
+func (v ReportInterval) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1627, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Report-Interval AVP.
+func (v *ReportInterval) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1627, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ReportInterval(d)
+	return nil
+}
+
+// ReportAmount is Report-Amount AVP (code 1628, vendor 10415).
+type ReportAmount diameter.Enumerated
+
+const (
+	ReportAmountAVP0     ReportAmount = 0 // 0
+	ReportAmountAVP2     ReportAmount = 1 // 2
+	ReportAmountAVP4     ReportAmount = 2 // 4
+	ReportAmountAVP8     ReportAmount = 3 // 8
+	ReportAmountAVP16    ReportAmount = 4 // 16
+	ReportAmountAVP32    ReportAmount = 5 // 32
+	ReportAmountAVP64    ReportAmount = 6 // 64
+	ReportAmountInfinity ReportAmount = 7 // infinity
+)
+
+func (v ReportAmount) String() string {
+	switch v {
+	case 0:
+		return "0"
+	case 1:
+		return "2"
+	case 2:
+		return "4"
+	case 3:
+		return "8"
+	case 4:
+		return "16"
+	case 5:
+		return "32"
+	case 6:
+		return "64"
+	case 7:
+		return "infinity"
+	}
+	return fmt.Sprintf("ReportAmount(%d)", v)
+}
+
+// ToAVP makes Report-Amount AVP.
+func (v ReportAmount) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1628, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Report-Amount AVP.
+func (v *ReportAmount) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1628, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ReportAmount(d)
+	return nil
+}
+
+// EventThresholdRSRP is Event-Threshold-RSRP AVP (code 1629, vendor 10415).
+type EventThresholdRSRP uint32
+
+// ToAVP makes Event-Threshold-RSRP AVP.
+func (v EventThresholdRSRP) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1629, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Event-Threshold-RSRP AVP.
+func (v *EventThresholdRSRP) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1629, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = EventThresholdRSRP(d)
+	return nil
+}
+
+// EventThresholdRSRQ is Event-Threshold-RSRQ AVP (code 1630, vendor 10415).
+type EventThresholdRSRQ uint32
+
+// ToAVP makes Event-Threshold-RSRQ AVP.
+func (v EventThresholdRSRQ) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1630, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Event-Threshold-RSRQ AVP.
+func (v *EventThresholdRSRQ) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1630, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = EventThresholdRSRQ(d)
+	return nil
+}
+
+// LoggingInterval is Logging-Interval AVP (code 1631, vendor 10415).
+type LoggingInterval diameter.Enumerated
+
+const (
+	LoggingIntervalAVP1280Ms  LoggingInterval = 0  // 1280 ms
+	LoggingIntervalAVP2560Ms  LoggingInterval = 1  // 2560 ms
+	LoggingIntervalAVP5120Ms  LoggingInterval = 2  // 5120 ms
+	LoggingIntervalAVP10240Ms LoggingInterval = 3  // 10240 ms
+	LoggingIntervalAVP20480Ms LoggingInterval = 4  // 20480 ms
+	LoggingIntervalAVP30720Ms LoggingInterval = 5  // 30720 ms
+	LoggingIntervalAVP40960Ms LoggingInterval = 6  // 40960 ms
+	LoggingIntervalAVP61440Ms LoggingInterval = 7  // 61440 ms
+	LoggingIntervalAVP320Ms   LoggingInterval = 8  // 320 ms
+	LoggingIntervalAVP640Ms   LoggingInterval = 9  // 640 ms
+	LoggingIntervalInfinity   LoggingInterval = 10 // infinity
+)
+
+func (v LoggingInterval) String() string {
+	switch v {
+	case 0:
+		return "1280 ms"
+	case 1:
+		return "2560 ms"
+	case 2:
+		return "5120 ms"
+	case 3:
+		return "10240 ms"
+	case 4:
+		return "20480 ms"
+	case 5:
+		return "30720 ms"
+	case 6:
+		return "40960 ms"
+	case 7:
+		return "61440 ms"
+	case 8:
+		return "320 ms"
+	case 9:
+		return "640 ms"
+	case 10:
+		return "infinity"
+	}
+	return fmt.Sprintf("LoggingInterval(%d)", v)
+}
+
+// ToAVP makes Logging-Interval AVP.
+func (v LoggingInterval) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1631, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Logging-Interval AVP.
+func (v *LoggingInterval) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1631, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = LoggingInterval(d)
+	return nil
+}
+
+// LoggingDuration is Logging-Duration AVP (code 1632, vendor 10415).
+type LoggingDuration diameter.Enumerated
+
+const (
+	LoggingDurationUMTSAndLTE600Sec  LoggingDuration = 0  // UMTS and LTE 600 sec
+	LoggingDurationUMTSAndLTE1200Sec LoggingDuration = 1  // UMTS and LTE 1200 sec
+	LoggingDurationUMTSAndLTE2400Sec LoggingDuration = 2  // UMTS and LTE 2400 sec
+	LoggingDurationUMTSAndLTE3600Sec LoggingDuration = 3  // UMTS and LTE 3600 sec
+	LoggingDurationUMTSAndLTE5400Sec LoggingDuration = 4  // UMTS and LTE 5400 sec
+	LoggingDurationUMTSAndLTE7200Sec LoggingDuration = 5  // UMTS and LTE 7200 sec
+	LoggingDurationNR600Sec          LoggingDuration = 6  // NR 600 sec
+	LoggingDurationNR1200Sec         LoggingDuration = 7  // NR 1200 sec
+	LoggingDurationNR2400Sec         LoggingDuration = 8  // NR 2400 sec
+	LoggingDurationNR3600Sec         LoggingDuration = 9  // NR 3600 sec
+	LoggingDurationNR5400Sec         LoggingDuration = 10 // NR 5400 sec
+	LoggingDurationNR7200Sec         LoggingDuration = 11 // NR 7200 sec
+)
+
+func (v LoggingDuration) String() string {
+	switch v {
+	case 0:
+		return "UMTS and LTE 600 sec"
+	case 1:
+		return "UMTS and LTE 1200 sec"
+	case 2:
+		return "UMTS and LTE 2400 sec"
+	case 3:
+		return "UMTS and LTE 3600 sec"
+	case 4:
+		return "UMTS and LTE 5400 sec"
+	case 5:
+		return "UMTS and LTE 7200 sec"
+	case 6:
+		return "NR 600 sec"
+	case 7:
+		return "NR 1200 sec"
+	case 8:
+		return "NR 2400 sec"
+	case 9:
+		return "NR 3600 sec"
+	case 10:
+		return "NR 5400 sec"
+	case 11:
+		return "NR 7200 sec"
+	}
+	return fmt.Sprintf("LoggingDuration(%d)", v)
+}
+
+// ToAVP makes Logging-Duration AVP.
+func (v LoggingDuration) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1632, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Logging-Duration AVP.
+func (v *LoggingDuration) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1632, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = LoggingDuration(d)
+	return nil
+}
+
+// RelayNodeIndicator is Relay-Node-Indicator AVP (code 1633, vendor 10415).
+type RelayNodeIndicator diameter.Enumerated
+
+const (
+	RelayNodeIndicatorNotRelayNode RelayNodeIndicator = 0 // NOT_RELAY_NODE
+	RelayNodeIndicatorRelayNode    RelayNodeIndicator = 1 // RELAY_NODE
+)
+
+func (v RelayNodeIndicator) String() string {
+	switch v {
+	case 0:
+		return "NOT_RELAY_NODE"
+	case 1:
+		return "RELAY_NODE"
+	}
+	return fmt.Sprintf("RelayNodeIndicator(%d)", v)
+}
+
+// ToAVP makes Relay-Node-Indicator AVP.
+func (v RelayNodeIndicator) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1633, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Relay-Node-Indicator AVP.
+func (v *RelayNodeIndicator) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1633, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RelayNodeIndicator(d)
+	return nil
+}
+
+// MDTUserConsent is MDT-User-Consent AVP (code 1634, vendor 10415).
+type MDTUserConsent diameter.Enumerated
+
+const (
+	MDTUserConsentConsentNotGiven MDTUserConsent = 0 // CONSENT_NOT_GIVEN
+	MDTUserConsentConsentGiven    MDTUserConsent = 1 // CONSENT_GIVEN
+)
+
+func (v MDTUserConsent) String() string {
+	switch v {
+	case 0:
+		return "CONSENT_NOT_GIVEN"
+	case 1:
+		return "CONSENT_GIVEN"
+	}
+	return fmt.Sprintf("MDTUserConsent(%d)", v)
+}
+
+// ToAVP makes MDT-User-Consent AVP.
+func (v MDTUserConsent) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1634, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads MDT-User-Consent AVP.
+func (v *MDTUserConsent) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1634, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MDTUserConsent(d)
+	return nil
+}
+
+// PURFlags is PUR-Flags AVP (code 1635, vendor 10415).
+type PURFlags uint32
+
+// ToAVP makes PUR-Flags AVP.
+func (v PURFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1635, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads PUR-Flags AVP.
+func (v *PURFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1635, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PURFlags(d)
+	return nil
+}
+
+// SubscribedVSRVCC is Subscribed-VSRVCC AVP (code 1636, vendor 10415).
+type SubscribedVSRVCC diameter.Enumerated
+
+const (
+	SubscribedVSRVCCVsrvccSubscribed SubscribedVSRVCC = 0 // VSRVCC_SUBSCRIBED
+)
+
+func (v SubscribedVSRVCC) String() string {
+	switch v {
+	case 0:
+		return "VSRVCC_SUBSCRIBED"
+	}
+	return fmt.Sprintf("SubscribedVSRVCC(%d)", v)
+}
+
+// ToAVP makes Subscribed-VSRVCC AVP.
+func (v SubscribedVSRVCC) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1636, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Subscribed-VSRVCC AVP.
+func (v *SubscribedVSRVCC) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1636, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SubscribedVSRVCC(d)
+	return nil
+}
+
+// EquivalentPLMNList is Equivalent-PLMN-List AVP (code 1637, vendor 10415).
+type EquivalentPLMNList struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Equivalent-PLMN-List.
+func (v EquivalentPLMNList) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Equivalent-PLMN-List.
+func (v *EquivalentPLMNList) FromAVPs(avps []diameter.AVP) error {
+	*v = EquivalentPLMNList{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Equivalent-PLMN-List AVP.
+func (v EquivalentPLMNList) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1637, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Equivalent-PLMN-List AVP.
+func (v *EquivalentPLMNList) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1637, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// CLRFlags is CLR-Flags AVP (code 1638, vendor 10415).
+type CLRFlags uint32
+
+// ToAVP makes CLR-Flags AVP.
+func (v CLRFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1638, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads CLR-Flags AVP.
+func (v *CLRFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1638, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = CLRFlags(d)
+	return nil
+}
+
+// UVRFlags is UVR-Flags AVP (code 1639, vendor 10415).
+type UVRFlags uint32
+
+// ToAVP makes UVR-Flags AVP.
+func (v UVRFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1639, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads UVR-Flags AVP.
+func (v *UVRFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1639, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = UVRFlags(d)
+	return nil
+}
+
+// UVAFlags is UVA-Flags AVP (code 1640, vendor 10415).
+type UVAFlags uint32
+
+// ToAVP makes UVA-Flags AVP.
+func (v UVAFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1640, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads UVA-Flags AVP.
+func (v *UVAFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1640, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = UVAFlags(d)
+	return nil
+}
+
+// VPLMNCSGSubscriptionData is VPLMN-CSG-Subscription-Data AVP (code 1641, vendor 10415).
+type VPLMNCSGSubscriptionData struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of VPLMN-CSG-Subscription-Data.
+func (v VPLMNCSGSubscriptionData) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of VPLMN-CSG-Subscription-Data.
+func (v *VPLMNCSGSubscriptionData) FromAVPs(avps []diameter.AVP) error {
+	*v = VPLMNCSGSubscriptionData{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes VPLMN-CSG-Subscription-Data AVP.
+func (v VPLMNCSGSubscriptionData) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1641, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads VPLMN-CSG-Subscription-Data AVP.
+func (v *VPLMNCSGSubscriptionData) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1641, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// TimeZone is Time-Zone AVP (code 1642, vendor 10415).
+type TimeZone string
+
+// ToAVP makes Time-Zone AVP.
+func (v TimeZone) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1642, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Time-Zone AVP.
+func (v *TimeZone) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 1642, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = TimeZone(d)
+	return nil
+}
+
+// AMSISDN is A-MSISDN AVP (code 1643, vendor 10415).
+type AMSISDN []byte
+
+// ToAVP makes A-MSISDN AVP.
+func (v AMSISDN) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1643, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads A-MSISDN AVP.
+func (v *AMSISDN) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1643, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AMSISDN(d)
+	return nil
+}
+
+// MMENumberForMTSMS is MME-Number-for-MT-SMS AVP (code 1645, vendor 10415).
+type MMENumberForMTSMS []byte
+
+// ToAVP makes MME-Number-for-MT-SMS AVP.
+func (v MMENumberForMTSMS) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1645, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads MME-Number-for-MT-SMS AVP.
+func (v *MMENumberForMTSMS) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1645, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MMENumberForMTSMS(d)
+	return nil
+}
+
+// SMSRegisterRequest is SMS-Register-Request AVP (code 1648, vendor 10415).
+type SMSRegisterRequest diameter.Enumerated
+
+const (
+	SMSRegisterRequestSmsRegistrationRequired     SMSRegisterRequest = 0 // SMS_REGISTRATION_REQUIRED
+	SMSRegisterRequestSmsRegistrationNotPreferred SMSRegisterRequest = 1 // SMS_REGISTRATION_NOT_PREFERRED
+	SMSRegisterRequestNoPreference                SMSRegisterRequest = 2 // NO_PREFERENCE
+)
+
+func (v SMSRegisterRequest) String() string {
+	switch v {
+	case 0:
+		return "SMS_REGISTRATION_REQUIRED"
+	case 1:
+		return "SMS_REGISTRATION_NOT_PREFERRED"
+	case 2:
+		return "NO_PREFERENCE"
+	}
+	return fmt.Sprintf("SMSRegisterRequest(%d)", v)
+}
+
+// ToAVP makes SMS-Register-Request AVP.
+func (v SMSRegisterRequest) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1648, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads SMS-Register-Request AVP.
+func (v *SMSRegisterRequest) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1648, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SMSRegisterRequest(d)
+	return nil
+}
+
+// LocalTimeZone is Local-Time-Zone AVP (code 1649, vendor 10415).
+type LocalTimeZone struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Local-Time-Zone.
+func (v LocalTimeZone) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Local-Time-Zone.
+func (v *LocalTimeZone) FromAVPs(avps []diameter.AVP) error {
+	*v = LocalTimeZone{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Local-Time-Zone AVP.
+func (v LocalTimeZone) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1649, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Local-Time-Zone AVP.
+func (v *LocalTimeZone) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1649, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// DaylightSavingTime is Daylight-Saving-Time AVP (code 1650, vendor 10415).
+type DaylightSavingTime diameter.Enumerated
+
+const (
+	DaylightSavingTimeNoAdjustment           DaylightSavingTime = 0 // NO_ADJUSTMENT
+	DaylightSavingTimePlusOneHourAdjustment  DaylightSavingTime = 1 // PLUS_ONE_HOUR_ADJUSTMENT
+	DaylightSavingTimePlusTwoHoursAdjustment DaylightSavingTime = 2 // PLUS_TWO_HOURS_ADJUSTMENT
+)
+
+func (v DaylightSavingTime) String() string {
+	switch v {
+	case 0:
+		return "NO_ADJUSTMENT"
+	case 1:
+		return "PLUS_ONE_HOUR_ADJUSTMENT"
+	case 2:
+		return "PLUS_TWO_HOURS_ADJUSTMENT"
+	}
+	return fmt.Sprintf("DaylightSavingTime(%d)", v)
+}
+
+// ToAVP makes Daylight-Saving-Time AVP.
+func (v DaylightSavingTime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1650, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Daylight-Saving-Time AVP.
+func (v *DaylightSavingTime) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1650, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = DaylightSavingTime(d)
+	return nil
+}
+
+// SubscriptionDataFlags is Subscription-Data-Flags AVP (code 1654, vendor 10415).
+type SubscriptionDataFlags uint32
+
+// ToAVP makes Subscription-Data-Flags AVP.
+func (v SubscriptionDataFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1654, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Subscription-Data-Flags AVP.
+func (v *SubscriptionDataFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1654, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SubscriptionDataFlags(d)
+	return nil
+}
+
+// MeasurementPeriodLTE is Measurement-Period-LTE AVP (code 1655, vendor 10415).
+type MeasurementPeriodLTE diameter.Enumerated
+
+const (
+	MeasurementPeriodLTEAVP1024Ms  MeasurementPeriodLTE = 0 // 1024 ms
+	MeasurementPeriodLTEAVP1280Ms  MeasurementPeriodLTE = 1 // 1280 ms
+	MeasurementPeriodLTEAVP2048Ms  MeasurementPeriodLTE = 2 // 2048 ms
+	MeasurementPeriodLTEAVP2560Ms  MeasurementPeriodLTE = 3 // 2560 ms
+	MeasurementPeriodLTEAVP5120Ms  MeasurementPeriodLTE = 4 // 5120 ms
+	MeasurementPeriodLTEAVP10240Ms MeasurementPeriodLTE = 5 // 10240 ms
+	MeasurementPeriodLTEAVP1Min    MeasurementPeriodLTE = 6 // 1 min
+)
+
+func (v MeasurementPeriodLTE) String() string {
+	switch v {
+	case 0:
+		return "1024 ms"
+	case 1:
+		return "1280 ms"
+	case 2:
+		return "2048 ms"
+	case 3:
+		return "2560 ms"
+	case 4:
+		return "5120 ms"
+	case 5:
+		return "10240 ms"
+	case 6:
+		return "1 min"
+	}
+	return fmt.Sprintf("MeasurementPeriodLTE(%d)", v)
+}
+
+// ToAVP makes Measurement-Period-LTE AVP.
+func (v MeasurementPeriodLTE) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1655, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Measurement-Period-LTE AVP.
+func (v *MeasurementPeriodLTE) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1655, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MeasurementPeriodLTE(d)
+	return nil
+}
+
+// MeasurementPeriodUMTS is Measurement-Period-UMTS AVP (code 1656, vendor 10415).
+type MeasurementPeriodUMTS diameter.Enumerated
+
+const (
+	MeasurementPeriodUMTSAVP250Ms   MeasurementPeriodUMTS = 0  // 250 ms
+	MeasurementPeriodUMTSAVP500Ms   MeasurementPeriodUMTS = 1  // 500 ms
+	MeasurementPeriodUMTSAVP1000Ms  MeasurementPeriodUMTS = 2  // 1000 ms
+	MeasurementPeriodUMTSAVP2000Ms  MeasurementPeriodUMTS = 3  // 2000 ms
+	MeasurementPeriodUMTSAVP3000Ms  MeasurementPeriodUMTS = 4  // 3000 ms
+	MeasurementPeriodUMTSAVP4000Ms  MeasurementPeriodUMTS = 5  // 4000 ms
+	MeasurementPeriodUMTSAVP6000Ms  MeasurementPeriodUMTS = 6  // 6000 ms
+	MeasurementPeriodUMTSAVP8000Ms  MeasurementPeriodUMTS = 7  // 8000 ms
+	MeasurementPeriodUMTSAVP12000Ms MeasurementPeriodUMTS = 8  // 12000 ms
+	MeasurementPeriodUMTSAVP16000Ms MeasurementPeriodUMTS = 9  // 16000 ms
+	MeasurementPeriodUMTSAVP20000Ms MeasurementPeriodUMTS = 10 // 20000 ms
+	MeasurementPeriodUMTSAVP24000Ms MeasurementPeriodUMTS = 11 // 24000 ms
+	MeasurementPeriodUMTSAVP28000Ms MeasurementPeriodUMTS = 12 // 28000 ms
+	MeasurementPeriodUMTSAVP32000Ms MeasurementPeriodUMTS = 13 // 32000 ms
+	MeasurementPeriodUMTSAVP64000Ms MeasurementPeriodUMTS = 14 // 64000 ms
+)
+
+func (v MeasurementPeriodUMTS) String() string {
+	switch v {
+	case 0:
+		return "250 ms"
+	case 1:
+		return "500 ms"
+	case 2:
+		return "1000 ms"
+	case 3:
+		return "2000 ms"
+	case 4:
+		return "3000 ms"
+	case 5:
+		return "4000 ms"
+	case 6:
+		return "6000 ms"
+	case 7:
+		return "8000 ms"
+	case 8:
+		return "12000 ms"
+	case 9:
+		return "16000 ms"
+	case 10:
+		return "20000 ms"
+	case 11:
+		return "24000 ms"
+	case 12:
+		return "28000 ms"
+	case 13:
+		return "32000 ms"
+	case 14:
+		return "64000 ms"
+	}
+	return fmt.Sprintf("MeasurementPeriodUMTS(%d)", v)
+}
+
+// ToAVP makes Measurement-Period-UMTS AVP.
+func (v MeasurementPeriodUMTS) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1656, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Measurement-Period-UMTS AVP.
+func (v *MeasurementPeriodUMTS) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1656, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MeasurementPeriodUMTS(d)
+	return nil
+}
+
+// CollectionPeriodRRMLTE is Collection-Period-RRM-LTE AVP (code 1657, vendor 10415).
+type CollectionPeriodRRMLTE diameter.Enumerated
+
+const (
+	CollectionPeriodRRMLTEAVP1024Ms  CollectionPeriodRRMLTE = 0 // 1024 ms
+	CollectionPeriodRRMLTEAVP1280Ms  CollectionPeriodRRMLTE = 1 // 1280 ms
+	CollectionPeriodRRMLTEAVP2048Ms  CollectionPeriodRRMLTE = 2 // 2048 ms
+	CollectionPeriodRRMLTEAVP2560Ms  CollectionPeriodRRMLTE = 3 // 2560 ms
+	CollectionPeriodRRMLTEAVP5120Ms  CollectionPeriodRRMLTE = 4 // 5120 ms
+	CollectionPeriodRRMLTEAVP10240Ms CollectionPeriodRRMLTE = 5 // 10240 ms
+	CollectionPeriodRRMLTEAVP1Min    CollectionPeriodRRMLTE = 6 // 1 min
+)
+
+func (v CollectionPeriodRRMLTE) String() string {
+	switch v {
+	case 0:
+		return "1024 ms"
+	case 1:
+		return "1280 ms"
+	case 2:
+		return "2048 ms"
+	case 3:
+		return "2560 ms"
+	case 4:
+		return "5120 ms"
+	case 5:
+		return "10240 ms"
+	case 6:
+		return "1 min"
+	}
+	return fmt.Sprintf("CollectionPeriodRRMLTE(%d)", v)
+}
+
+// ToAVP makes Collection-Period-RRM-LTE AVP.
+func (v CollectionPeriodRRMLTE) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1657, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Collection-Period-RRM-LTE AVP.
+func (v *CollectionPeriodRRMLTE) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1657, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = CollectionPeriodRRMLTE(d)
+	return nil
+}
+
+// CollectionPeriodRRMUMTS is Collection-Period-RRM-UMTS AVP (code 1658, vendor 10415).
+type CollectionPeriodRRMUMTS diameter.Enumerated
+
+const (
+	CollectionPeriodRRMUMTSAVP250Ms   CollectionPeriodRRMUMTS = 0  // 250 ms
+	CollectionPeriodRRMUMTSAVP500Ms   CollectionPeriodRRMUMTS = 1  // 500 ms
+	CollectionPeriodRRMUMTSAVP1000Ms  CollectionPeriodRRMUMTS = 2  // 1000 ms
+	CollectionPeriodRRMUMTSAVP2000Ms  CollectionPeriodRRMUMTS = 3  // 2000 ms
+	CollectionPeriodRRMUMTSAVP3000Ms  CollectionPeriodRRMUMTS = 4  // 3000 ms
+	CollectionPeriodRRMUMTSAVP4000Ms  CollectionPeriodRRMUMTS = 5  // 4000 ms
+	CollectionPeriodRRMUMTSAVP6000Ms  CollectionPeriodRRMUMTS = 6  // 6000 ms
+	CollectionPeriodRRMUMTSAVP8000Ms  CollectionPeriodRRMUMTS = 7  // 8000 ms
+	CollectionPeriodRRMUMTSAVP12000Ms CollectionPeriodRRMUMTS = 8  // 12000 ms
+	CollectionPeriodRRMUMTSAVP16000Ms CollectionPeriodRRMUMTS = 9  // 16000 ms
+	CollectionPeriodRRMUMTSAVP20000Ms CollectionPeriodRRMUMTS = 10 // 20000 ms
+	CollectionPeriodRRMUMTSAVP24000Ms CollectionPeriodRRMUMTS = 11 // 24000 ms
+	CollectionPeriodRRMUMTSAVP28000Ms CollectionPeriodRRMUMTS = 12 // 28000 ms
+	CollectionPeriodRRMUMTSAVP32000Ms CollectionPeriodRRMUMTS = 13 // 32000 ms
+	CollectionPeriodRRMUMTSAVP64000Ms CollectionPeriodRRMUMTS = 14 // 64000 ms
+)
+
+func (v CollectionPeriodRRMUMTS) String() string {
+	switch v {
+	case 0:
+		return "250 ms"
+	case 1:
+		return "500 ms"
+	case 2:
+		return "1000 ms"
+	case 3:
+		return "2000 ms"
+	case 4:
+		return "3000 ms"
+	case 5:
+		return "4000 ms"
+	case 6:
+		return "6000 ms"
+	case 7:
+		return "8000 ms"
+	case 8:
+		return "12000 ms"
+	case 9:
+		return "16000 ms"
+	case 10:
+		return "20000 ms"
+	case 11:
+		return "24000 ms"
+	case 12:
+		return "28000 ms"
+	case 13:
+		return "32000 ms"
+	case 14:
+		return "64000 ms"
+	}
+	return fmt.Sprintf("CollectionPeriodRRMUMTS(%d)", v)
+}
+
+// ToAVP makes Collection-Period-RRM-UMTS AVP.
+func (v CollectionPeriodRRMUMTS) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1658, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Collection-Period-RRM-UMTS AVP.
+func (v *CollectionPeriodRRMUMTS) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1658, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = CollectionPeriodRRMUMTS(d)
+	return nil
+}
+
+// PositioningMethod is Positioning-Method AVP (code 1659, vendor 10415).
+type PositioningMethod []byte
+
+// ToAVP makes Positioning-Method AVP.
+func (v PositioningMethod) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1659, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Positioning-Method AVP.
+func (v *PositioningMethod) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1659, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PositioningMethod(d)
+	return nil
+}
+
+// MeasurementQuantity is Measurement-Quantity AVP (code 1660, vendor 10415).
+type MeasurementQuantity []byte
+
+// ToAVP makes Measurement-Quantity AVP.
+func (v MeasurementQuantity) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1660, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Measurement-Quantity AVP.
+func (v *MeasurementQuantity) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1660, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MeasurementQuantity(d)
+	return nil
+}
+
+// EventThresholdEvent1F is Event-Threshold-Event-1F AVP (code 1661, vendor 10415).
+type EventThresholdEvent1F int32
+
+// ToAVP makes Event-Threshold-Event-1F AVP.
+func (v EventThresholdEvent1F) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1661, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(int32(v))
+	return a
+}
+
+// FromAVP reads Event-Threshold-Event-1F AVP.
+func (v *EventThresholdEvent1F) FromAVP(a diameter.AVP) error {
+	var d int32
+	if e := checkAVP(a, 1661, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = EventThresholdEvent1F(d)
+	return nil
+}
+
+// EventThresholdEvent1I is Event-Threshold-Event-1I AVP (code 1662, vendor 10415).
+type EventThresholdEvent1I int32
+
+// ToAVP makes Event-Threshold-Event-1I AVP.
+func (v EventThresholdEvent1I) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1662, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(int32(v))
+	return a
+}
+
+// FromAVP reads Event-Threshold-Event-1I AVP.
+func (v *EventThresholdEvent1I) FromAVP(a diameter.AVP) error {
+	var d int32
+	if e := checkAVP(a, 1662, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = EventThresholdEvent1I(d)
+	return nil
+}
+
+// RestorationPriority is Restoration-Priority AVP (code 1663, vendor 10415).
+type RestorationPriority uint32
+
+// ToAVP makes Restoration-Priority AVP.
+func (v RestorationPriority) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1663, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Restoration-Priority AVP.
+func (v *RestorationPriority) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1663, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RestorationPriority(d)
+	return nil
+}
+
+// SGsMMEIdentity is SGs-MME-Identity AVP (code 1664, vendor 10415).
+type SGsMMEIdentity string
+
+// ToAVP makes SGs-MME-Identity AVP.
+func (v SGsMMEIdentity) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1664, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads SGs-MME-Identity AVP.
+func (v *SGsMMEIdentity) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 1664, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SGsMMEIdentity(d)
+	return nil
+}
+
+// SIPTOLocalNetworkPermission is SIPTO-Local-Network-Permission AVP (code 1665, vendor 10415).
+type SIPTOLocalNetworkPermission uint32
+
+// ToAVP makes SIPTO-Local-Network-Permission AVP.
+func (v SIPTOLocalNetworkPermission) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1665, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads SIPTO-Local-Network-Permission AVP.
+func (v *SIPTOLocalNetworkPermission) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1665, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SIPTOLocalNetworkPermission(d)
+	return nil
+}
+
+// CoupledNodeDiameterID is Coupled-Node-Diameter-ID AVP (code 1666, vendor 10415).
+type CoupledNodeDiameterID diameter.Identity
+
+// ToAVP makes Coupled-Node-Diameter-ID AVP.
+func (v CoupledNodeDiameterID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1666, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Coupled-Node-Diameter-ID AVP.
+func (v *CoupledNodeDiameterID) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 1666, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = CoupledNodeDiameterID(d)
+	return nil
+}
+
+// WLANOffloadability is WLAN-offloadability AVP (code 1667, vendor 10415).
+type WLANOffloadability struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of WLAN-offloadability.
+func (v WLANOffloadability) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of WLAN-offloadability.
+func (v *WLANOffloadability) FromAVPs(avps []diameter.AVP) error {
+	*v = WLANOffloadability{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes WLAN-offloadability AVP.
+func (v WLANOffloadability) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1667, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads WLAN-offloadability AVP.
+func (v *WLANOffloadability) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1667, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// WLANOffloadabilityEUTRAN is WLAN-offloadability-EUTRAN AVP (code 1668, vendor 10415).
+type WLANOffloadabilityEUTRAN uint32
+
+// ToAVP makes WLAN-offloadability-EUTRAN AVP.
+func (v WLANOffloadabilityEUTRAN) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1668, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads WLAN-offloadability-EUTRAN AVP.
+func (v *WLANOffloadabilityEUTRAN) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1668, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = WLANOffloadabilityEUTRAN(d)
+	return nil
+}
+
+// WLANOffloadabilityUTRAN is WLAN-offloadability-UTRAN AVP (code 1669, vendor 10415).
+type WLANOffloadabilityUTRAN uint32
+
+// ToAVP makes WLAN-offloadability-UTRAN AVP.
+func (v WLANOffloadabilityUTRAN) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1669, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads WLAN-offloadability-UTRAN AVP.
+func (v *WLANOffloadabilityUTRAN) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1669, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = WLANOffloadabilityUTRAN(d)
+	return nil
+}
+
+// ResetID is Reset-ID AVP (code 1670, vendor 10415).
+type ResetID []byte
+
+// ToAVP makes Reset-ID AVP.
+func (v ResetID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1670, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Reset-ID AVP.
+func (v *ResetID) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1670, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ResetID(d)
+	return nil
+}
+
+// MDTAllowedPLMNId is MDT-Allowed-PLMN-Id AVP (code 1671, vendor 10415).
+type MDTAllowedPLMNId []byte
+
+// ToAVP makes MDT-Allowed-PLMN-Id AVP.
+func (v MDTAllowedPLMNId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1671, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads MDT-Allowed-PLMN-Id AVP.
+func (v *MDTAllowedPLMNId) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1671, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MDTAllowedPLMNId(d)
+	return nil
+}
+
+// AdjacentPLMNs is Adjacent-PLMNs AVP (code 1672, vendor 10415).
+type AdjacentPLMNs struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Adjacent-PLMNs.
+func (v AdjacentPLMNs) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Adjacent-PLMNs.
+func (v *AdjacentPLMNs) FromAVPs(avps []diameter.AVP) error {
+	*v = AdjacentPLMNs{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Adjacent-PLMNs AVP.
+func (v AdjacentPLMNs) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1672, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Adjacent-PLMNs AVP.
+func (v *AdjacentPLMNs) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1672, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// AdjacentAccessRestrictionData is Adjacent-Access-Restriction-Data AVP (code 1673, vendor 10415).
+type AdjacentAccessRestrictionData struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Adjacent-Access-Restriction-Data.
+func (v AdjacentAccessRestrictionData) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Adjacent-Access-Restriction-Data.
+func (v *AdjacentAccessRestrictionData) FromAVPs(avps []diameter.AVP) error {
+	*v = AdjacentAccessRestrictionData{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Adjacent-Access-Restriction-Data AVP.
+func (v AdjacentAccessRestrictionData) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1673, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Adjacent-Access-Restriction-Data AVP.
+func (v *AdjacentAccessRestrictionData) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1673, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// DLBufferingSuggestedPacketCount is DL-Buffering-Suggested-Packet-Count AVP (code 1674, vendor 10415).
+type DLBufferingSuggestedPacketCount int32
+
+// ToAVP makes DL-Buffering-Suggested-Packet-Count AVP.
+func (v DLBufferingSuggestedPacketCount) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1674, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(int32(v))
+	return a
+}
+
+// FromAVP reads DL-Buffering-Suggested-Packet-Count AVP.
+func (v *DLBufferingSuggestedPacketCount) FromAVP(a diameter.AVP) error {
+	var d int32
+	if e := checkAVP(a, 1674, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = DLBufferingSuggestedPacketCount(d)
+	return nil
+}
+
+// IMSIGroupId is IMSI-Group-Id AVP (code 1675, vendor 10415).
+type IMSIGroupId struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of IMSI-Group-Id.
+func (v IMSIGroupId) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of IMSI-Group-Id.
+func (v *IMSIGroupId) FromAVPs(avps []diameter.AVP) error {
+	*v = IMSIGroupId{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes IMSI-Group-Id AVP.
+func (v IMSIGroupId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1675, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads IMSI-Group-Id AVP.
+func (v *IMSIGroupId) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1675, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// GroupServiceId is Group-Service-Id AVP (code 1676, vendor 10415).
+type GroupServiceId uint32
+
+// ToAVP makes Group-Service-Id AVP.
+func (v GroupServiceId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1676, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Group-Service-Id AVP.
+func (v *GroupServiceId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1676, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = GroupServiceId(d)
+	return nil
+}
+
+// GroupPLMNId is Group-PLMN-Id AVP (code 1677, vendor 10415).
+type GroupPLMNId []byte
+
+// ToAVP makes Group-PLMN-Id AVP.
+func (v GroupPLMNId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1677, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Group-PLMN-Id AVP.
+func (v *GroupPLMNId) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1677, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = GroupPLMNId(d)
+	return nil
+}
+
+// LocalGroupId is Local-Group-Id AVP (code 1678, vendor 10415).
+type LocalGroupId []byte
+
+// ToAVP makes Local-Group-Id AVP.
+func (v LocalGroupId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1678, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Local-Group-Id AVP.
+func (v *LocalGroupId) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1678, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = LocalGroupId(d)
+	return nil
+}
+
+// AIRFlags is AIR-Flags AVP (code 1679, vendor 10415).
+type AIRFlags uint32
+
+// ToAVP makes AIR-Flags AVP.
+func (v AIRFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1679, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads AIR-Flags AVP.
+func (v *AIRFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1679, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AIRFlags(d)
+	return nil
+}
+
+// UEUsageType is UE-Usage-Type AVP (code 1680, vendor 10415).
+type UEUsageType uint32
+
+// ToAVP makes UE-Usage-Type AVP.
+func (v UEUsageType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1680, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads UE-Usage-Type AVP.
+func (v *UEUsageType) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1680, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = UEUsageType(d)
+	return nil
+}
+
+// NonIPPDNTypeIndicator is Non-IP-PDN-Type-Indicator AVP (code 1681, vendor 10415).
+type NonIPPDNTypeIndicator diameter.Enumerated
+
+const (
+	NonIPPDNTypeIndicatorFalse NonIPPDNTypeIndicator = 0 // FALSE
+	NonIPPDNTypeIndicatorTrue  NonIPPDNTypeIndicator = 1 // TRUE
+)
+
+func (v NonIPPDNTypeIndicator) String() string {
+	switch v {
+	case 0:
+		return "FALSE"
+	case 1:
+		return "TRUE"
+	}
+	return fmt.Sprintf("NonIPPDNTypeIndicator(%d)", v)
+}
+
+// ToAVP makes Non-IP-PDN-Type-Indicator AVP.
+func (v NonIPPDNTypeIndicator) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1681, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Non-IP-PDN-Type-Indicator AVP.
+func (v *NonIPPDNTypeIndicator) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1681, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = NonIPPDNTypeIndicator(d)
+	return nil
+}
+
+// NonIPDataDeliveryMechanism is Non-IP-Data-Delivery-Mechanism AVP (code 1682, vendor 10415).
+type NonIPDataDeliveryMechanism uint32
+
+// ToAVP makes Non-IP-Data-Delivery-Mechanism AVP.
+func (v NonIPDataDeliveryMechanism) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1682, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Non-IP-Data-Delivery-Mechanism AVP.
+func (v *NonIPDataDeliveryMechanism) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1682, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = NonIPDataDeliveryMechanism(d)
+	return nil
+}
+
+// AdditionalContextID is Additional-Context-ID AVP (code 1683, vendor 10415).
+type AdditionalContextID uint32
+
+// ToAVP makes Additional-Context-ID AVP.
+func (v AdditionalContextID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1683, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Additional-Context-ID AVP.
+func (v *AdditionalContextID) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1683, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AdditionalContextID(d)
+	return nil
+}
+
+// SCEFRealm is SCEF-Realm AVP (code 1684, vendor 10415).
+type SCEFRealm diameter.Identity
+
+// ToAVP makes SCEF-Realm AVP.
+func (v SCEFRealm) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1684, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads SCEF-Realm AVP.
+func (v *SCEFRealm) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 1684, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SCEFRealm(d)
+	return nil
+}
+
+// SubscriptionDataDeletion is Subscription-Data-Deletion AVP (code 1685, vendor 10415).
+type SubscriptionDataDeletion struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Subscription-Data-Deletion.
+func (v SubscriptionDataDeletion) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Subscription-Data-Deletion.
+func (v *SubscriptionDataDeletion) FromAVPs(avps []diameter.AVP) error {
+	*v = SubscriptionDataDeletion{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Subscription-Data-Deletion AVP.
+func (v SubscriptionDataDeletion) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1685, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Subscription-Data-Deletion AVP.
+func (v *SubscriptionDataDeletion) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1685, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// PreferredDataMode is Preferred-Data-Mode AVP (code 1686, vendor 10415).
+type PreferredDataMode uint32
+
+// ToAVP makes Preferred-Data-Mode AVP.
+func (v PreferredDataMode) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1686, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Preferred-Data-Mode AVP.
+func (v *PreferredDataMode) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1686, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PreferredDataMode(d)
+	return nil
+}
+
+// EmergencyInfo is Emergency-Info AVP (code 1687, vendor 10415).
+type EmergencyInfo struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Emergency-Info.
+func (v EmergencyInfo) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Emergency-Info.
+func (v *EmergencyInfo) FromAVPs(avps []diameter.AVP) error {
+	*v = EmergencyInfo{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Emergency-Info AVP.
+func (v EmergencyInfo) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1687, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Emergency-Info AVP.
+func (v *EmergencyInfo) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1687, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// V2XSubscriptionData is V2X-Subscription-Data AVP (code 1688, vendor 10415).
+type V2XSubscriptionData struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of V2X-Subscription-Data.
+func (v V2XSubscriptionData) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of V2X-Subscription-Data.
+func (v *V2XSubscriptionData) FromAVPs(avps []diameter.AVP) error {
+	*v = V2XSubscriptionData{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes V2X-Subscription-Data AVP.
+func (v V2XSubscriptionData) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1688, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads V2X-Subscription-Data AVP.
+func (v *V2XSubscriptionData) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1688, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// V2XPermission is V2X-Permission AVP (code 1689, vendor 10415).
+type V2XPermission uint32
+
+// ToAVP makes V2X-Permission AVP.
+func (v V2XPermission) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1689, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads V2X-Permission AVP.
+func (v *V2XPermission) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1689, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = V2XPermission(d)
+	return nil
+}
+
+// PDNConnectionContinuity is PDN-Connection-Continuity AVP (code 1690, vendor 10415).
+type PDNConnectionContinuity uint32
+
+// ToAVP makes PDN-Connection-Continuity AVP.
+func (v PDNConnectionContinuity) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1690, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads PDN-Connection-Continuity AVP.
+func (v *PDNConnectionContinuity) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1690, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PDNConnectionContinuity(d)
+	return nil
+}
+
+// EDRXCycleLength is eDRX-Cycle-Length AVP (code 1691, vendor 10415).
+type EDRXCycleLength struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of eDRX-Cycle-Length.
+func (v EDRXCycleLength) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of eDRX-Cycle-Length.
+func (v *EDRXCycleLength) FromAVPs(avps []diameter.AVP) error {
+	*v = EDRXCycleLength{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes eDRX-Cycle-Length AVP.
+func (v EDRXCycleLength) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1691, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads eDRX-Cycle-Length AVP.
+func (v *EDRXCycleLength) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1691, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// EDRXCycleLengthValue is eDRX-Cycle-Length-Value AVP (code 1692, vendor 10415).
+type EDRXCycleLengthValue []byte
+
+// ToAVP makes eDRX-Cycle-Length-Value AVP.
+func (v EDRXCycleLengthValue) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1692, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads eDRX-Cycle-Length-Value AVP.
+func (v *EDRXCycleLengthValue) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1692, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = EDRXCycleLengthValue(d)
+	return nil
+}
+
+// UEPC5AMBR is UE-PC5-AMBR AVP (code 1693, vendor 10415).
+type UEPC5AMBR uint32
+
+// ToAVP makes UE-PC5-AMBR AVP.
+func (v UEPC5AMBR) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1693, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads UE-PC5-AMBR AVP.
+func (v *UEPC5AMBR) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1693, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = UEPC5AMBR(d)
+	return nil
+}
+
+// MBSFNArea is MBSFN-Area AVP (code 1694, vendor 10415).
+type MBSFNArea struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of MBSFN-Area.
+func (v MBSFNArea) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of MBSFN-Area.
+func (v *MBSFNArea) FromAVPs(avps []diameter.AVP) error {
+	*v = MBSFNArea{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes MBSFN-Area AVP.
+func (v MBSFNArea) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1694, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads MBSFN-Area AVP.
+func (v *MBSFNArea) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1694, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// MBSFNAreaID is MBSFN-Area-ID AVP (code 1695, vendor 10415).
+type MBSFNAreaID uint32
+
+// ToAVP makes MBSFN-Area-ID AVP.
+func (v MBSFNAreaID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1695, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads MBSFN-Area-ID AVP.
+func (v *MBSFNAreaID) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1695, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MBSFNAreaID(d)
+	return nil
+}
+
+// CarrierFrequency is Carrier-Frequency AVP (code 1696, vendor 10415).
+type CarrierFrequency uint32
+
+// ToAVP makes Carrier-Frequency AVP.
+func (v CarrierFrequency) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1696, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Carrier-Frequency AVP.
+func (v *CarrierFrequency) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1696, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = CarrierFrequency(d)
+	return nil
+}
+
+// RDSIndicator is RDS-Indicator AVP (code 1697, vendor 10415).
+type RDSIndicator diameter.Enumerated
+
+const (
+	RDSIndicatorDisabled RDSIndicator = 0 // DISABLED
+	RDSIndicatorEnabled  RDSIndicator = 1 // ENABLED
+)
+
+func (v RDSIndicator) String() string {
+	switch v {
+	case 0:
+		return "DISABLED"
+	case 1:
+		return "ENABLED"
+	}
+	return fmt.Sprintf("RDSIndicator(%d)", v)
+}
+
+// ToAVP makes RDS-Indicator AVP.
+func (v RDSIndicator) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1697, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads RDS-Indicator AVP.
+func (v *RDSIndicator) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1697, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RDSIndicator(d)
+	return nil
+}
+
+// ServiceGapTime is Service-Gap-Time AVP (code 1698, vendor 10415).
+type ServiceGapTime uint32
+
+// ToAVP makes Service-Gap-Time AVP.
+func (v ServiceGapTime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1698, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Service-Gap-Time AVP.
+func (v *ServiceGapTime) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1698, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ServiceGapTime(d)
+	return nil
+}
+
+// AerialUESubscriptionInformation is Aerial-UE-Subscription-Information AVP (code 1699, vendor 10415).
+type AerialUESubscriptionInformation uint32
+
+// ToAVP makes Aerial-UE-Subscription-Information AVP.
+func (v AerialUESubscriptionInformation) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1699, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Aerial-UE-Subscription-Information AVP.
+func (v *AerialUESubscriptionInformation) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1699, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AerialUESubscriptionInformation(d)
+	return nil
+}
+
+// BroadcastLocationAssistanceDataTypes is Broadcast-Location-Assistance-Data-Types AVP (code 1700, vendor 10415).
+type BroadcastLocationAssistanceDataTypes uint64
+
+// ToAVP makes Broadcast-Location-Assistance-Data-Types AVP.
+func (v BroadcastLocationAssistanceDataTypes) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1700, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint64(v))
+	return a
+}
+
+// FromAVP reads Broadcast-Location-Assistance-Data-Types AVP.
+func (v *BroadcastLocationAssistanceDataTypes) FromAVP(a diameter.AVP) error {
+	var d uint64
+	if e := checkAVP(a, 1700, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = BroadcastLocationAssistanceDataTypes(d)
+	return nil
+}
+
+// PagingTimeWindow is Paging-Time-Window AVP (code 1701, vendor 10415).
+type PagingTimeWindow struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Paging-Time-Window.
+func (v PagingTimeWindow) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Paging-Time-Window.
+func (v *PagingTimeWindow) FromAVPs(avps []diameter.AVP) error {
+	*v = PagingTimeWindow{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Paging-Time-Window AVP.
+func (v PagingTimeWindow) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1701, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Paging-Time-Window AVP.
+func (v *PagingTimeWindow) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1701, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// OperationMode is Operation-Mode AVP (code 1702, vendor 10415).
+type OperationMode uint32
+
+// ToAVP makes Operation-Mode AVP.
+func (v OperationMode) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1702, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Operation-Mode AVP.
+func (v *OperationMode) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1702, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = OperationMode(d)
+	return nil
+}
+
+// PagingTimeWindowLength is Paging-Time-Window-Length AVP (code 1703, vendor 10415).
+type PagingTimeWindowLength []byte
+
+// ToAVP makes Paging-Time-Window-Length AVP.
+func (v PagingTimeWindowLength) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1703, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Paging-Time-Window-Length AVP.
+func (v *PagingTimeWindowLength) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1703, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PagingTimeWindowLength(d)
+	return nil
+}
+
+// CoreNetworkRestrictions is Core-Network-Restrictions AVP (code 1704, vendor 10415).
+type CoreNetworkRestrictions uint32
+
+// ToAVP makes Core-Network-Restrictions AVP.
+func (v CoreNetworkRestrictions) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1704, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Core-Network-Restrictions AVP.
+func (v *CoreNetworkRestrictions) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1704, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = CoreNetworkRestrictions(d)
+	return nil
+}
+
+// EDRXRelatedRAT is eDRX-Related-RAT AVP (code 1705, vendor 10415).
+type EDRXRelatedRAT struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of eDRX-Related-RAT.
+func (v EDRXRelatedRAT) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of eDRX-Related-RAT.
+func (v *EDRXRelatedRAT) FromAVPs(avps []diameter.AVP) error {
+	*v = EDRXRelatedRAT{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes eDRX-Related-RAT AVP.
+func (v EDRXRelatedRAT) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1705, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads eDRX-Related-RAT AVP.
+func (v *EDRXRelatedRAT) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1705, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// Interworking5GSIndicator is Interworking-5GS-Indicator AVP (code 1706, vendor 10415).
+type Interworking5GSIndicator diameter.Enumerated
+
+const (
+	Interworking5GSIndicatorNotSubscribed Interworking5GSIndicator = 0 // NOT-SUBSCRIBED
+	Interworking5GSIndicatorSubscribed    Interworking5GSIndicator = 1 // SUBSCRIBED
+)
+
+func (v Interworking5GSIndicator) String() string {
+	switch v {
+	case 0:
+		return "NOT-SUBSCRIBED"
+	case 1:
+		return "SUBSCRIBED"
+	}
+	return fmt.Sprintf("Interworking5GSIndicator(%d)", v)
+}
+
+// ToAVP makes Interworking-5GS-Indicator AVP.
+func (v Interworking5GSIndicator) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1706, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Interworking-5GS-Indicator AVP.
+func (v *Interworking5GSIndicator) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1706, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = Interworking5GSIndicator(d)
+	return nil
+}
+
+// EthernetPDNTypeIndicator is Ethernet-PDN-Type-Indicator AVP (code 1707, vendor 10415).
+type EthernetPDNTypeIndicator diameter.Enumerated
+
+const (
+	EthernetPDNTypeIndicatorFalse EthernetPDNTypeIndicator = 0 // FALSE
+	EthernetPDNTypeIndicatorTrue  EthernetPDNTypeIndicator = 1 // TRUE
+)
+
+func (v EthernetPDNTypeIndicator) String() string {
+	switch v {
+	case 0:
+		return "FALSE"
+	case 1:
+		return "TRUE"
+	}
+	return fmt.Sprintf("EthernetPDNTypeIndicator(%d)", v)
+}
+
+// ToAVP makes Ethernet-PDN-Type-Indicator AVP.
+func (v EthernetPDNTypeIndicator) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1707, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Ethernet-PDN-Type-Indicator AVP.
+func (v *EthernetPDNTypeIndicator) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1707, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = EthernetPDNTypeIndicator(d)
+	return nil
+}
+
+// SubscribedARPI is Subscribed-ARPI AVP (code 1708, vendor 10415).
+type SubscribedARPI uint32
+
+// ToAVP makes Subscribed-ARPI AVP.
+func (v SubscribedARPI) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1708, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Subscribed-ARPI AVP.
+func (v *SubscribedARPI) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1708, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SubscribedARPI(d)
+	return nil
+}
+
+// IABOperationPermission is IAB-Operation-Permission AVP (code 1709, vendor 10415).
+type IABOperationPermission diameter.Enumerated
+
+const (
+	IABOperationPermissionIabOperationAllowed    IABOperationPermission = 0 // IAB_OPERATION_ALLOWED
+	IABOperationPermissionIabOperationNotallowed IABOperationPermission = 1 // IAB_OPERATION_NOTALLOWED
+)
+
+func (v IABOperationPermission) String() string {
+	switch v {
+	case 0:
+		return "IAB_OPERATION_ALLOWED"
+	case 1:
+		return "IAB_OPERATION_NOTALLOWED"
+	}
+	return fmt.Sprintf("IABOperationPermission(%d)", v)
+}
+
+// ToAVP makes IAB-Operation-Permission AVP.
+func (v IABOperationPermission) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1709, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads IAB-Operation-Permission AVP.
+func (v *IABOperationPermission) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1709, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = IABOperationPermission(d)
+	return nil
+}
+
+// V2XSubscriptionDataNr is V2X-Subscription-Data-Nr AVP (code 1710, vendor 10415).
+type V2XSubscriptionDataNr struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of V2X-Subscription-Data-Nr.
+func (v V2XSubscriptionDataNr) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of V2X-Subscription-Data-Nr.
+func (v *V2XSubscriptionDataNr) FromAVPs(avps []diameter.AVP) error {
+	*v = V2XSubscriptionDataNr{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes V2X-Subscription-Data-Nr AVP.
+func (v V2XSubscriptionDataNr) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1710, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads V2X-Subscription-Data-Nr AVP.
+func (v *V2XSubscriptionDataNr) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1710, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// UEPC5QoS is UE-PC5-QoS AVP (code 1711, vendor 10415).
+type UEPC5QoS struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of UE-PC5-QoS.
+func (v UEPC5QoS) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of UE-PC5-QoS.
+func (v *UEPC5QoS) FromAVPs(avps []diameter.AVP) error {
+	*v = UEPC5QoS{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes UE-PC5-QoS AVP.
+func (v UEPC5QoS) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1711, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads UE-PC5-QoS AVP.
+func (v *UEPC5QoS) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1711, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// PC5QoSFlow is PC5-QoS-Flow AVP (code 1712, vendor 10415).
+type PC5QoSFlow struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of PC5-QoS-Flow.
+func (v PC5QoSFlow) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of PC5-QoS-Flow.
+func (v *PC5QoSFlow) FromAVPs(avps []diameter.AVP) error {
+	*v = PC5QoSFlow{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes PC5-QoS-Flow AVP.
+func (v PC5QoSFlow) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1712, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads PC5-QoS-Flow AVP.
+func (v *PC5QoSFlow) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1712, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// AVP5QI is 5QI AVP (code 1713, vendor 10415).
+type AVP5QI int32
+
+// ToAVP makes 5QI AVP.
+func (v AVP5QI) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1713, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(int32(v))
+	return a
+}
+
+// FromAVP reads 5QI AVP.
+func (v *AVP5QI) FromAVP(a diameter.AVP) error {
+	var d int32
+	if e := checkAVP(a, 1713, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AVP5QI(d)
+	return nil
+}
+
+// PC5FlowBitrates is PC5-Flow-Bitrates AVP (code 1714, vendor 10415).
+type PC5FlowBitrates struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of PC5-Flow-Bitrates.
+func (v PC5FlowBitrates) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of PC5-Flow-Bitrates.
+func (v *PC5FlowBitrates) FromAVPs(avps []diameter.AVP) error {
+	*v = PC5FlowBitrates{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes PC5-Flow-Bitrates AVP.
+func (v PC5FlowBitrates) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1714, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads PC5-Flow-Bitrates AVP.
+func (v *PC5FlowBitrates) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1714, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// GuaranteedFlowBitrates is Guaranteed-Flow-Bitrates AVP (code 1715, vendor 10415).
+type GuaranteedFlowBitrates int32
+
+// ToAVP makes Guaranteed-Flow-Bitrates AVP.
+func (v GuaranteedFlowBitrates) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1715, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(int32(v))
+	return a
+}
+
+// FromAVP reads Guaranteed-Flow-Bitrates AVP.
+func (v *GuaranteedFlowBitrates) FromAVP(a diameter.AVP) error {
+	var d int32
+	if e := checkAVP(a, 1715, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = GuaranteedFlowBitrates(d)
+	return nil
+}
+
+// MaximumFlowBitrates is Maximum-Flow-Bitrates AVP (code 1716, vendor 10415).
+type MaximumFlowBitrates int32
+
+// ToAVP makes Maximum-Flow-Bitrates AVP.
+func (v MaximumFlowBitrates) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1716, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(int32(v))
+	return a
+}
+
+// FromAVP reads Maximum-Flow-Bitrates AVP.
+func (v *MaximumFlowBitrates) FromAVP(a diameter.AVP) error {
+	var d int32
+	if e := checkAVP(a, 1716, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MaximumFlowBitrates(d)
+	return nil
+}
+
+// PC5Range is PC5-Range AVP (code 1717, vendor 10415).
+type PC5Range int32
+
+// ToAVP makes PC5-Range AVP.
+func (v PC5Range) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1717, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(int32(v))
+	return a
+}
+
+// FromAVP reads PC5-Range AVP.
+func (v *PC5Range) FromAVP(a diameter.AVP) error {
+	var d int32
+	if e := checkAVP(a, 1717, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PC5Range(d)
+	return nil
+}
+
+// PC5LinkAMBR is PC5-Link-AMBR AVP (code 1718, vendor 10415).
+type PC5LinkAMBR int32
+
+// ToAVP makes PC5-Link-AMBR AVP.
+func (v PC5LinkAMBR) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1718, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(int32(v))
+	return a
+}
+
+// FromAVP reads PC5-Link-AMBR AVP.
+func (v *PC5LinkAMBR) FromAVP(a diameter.AVP) error {
+	var d int32
+	if e := checkAVP(a, 1718, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PC5LinkAMBR(d)
+	return nil
+}
+
+// ThirdContextIdentifier is Third-Context-Identifier AVP (code 1719, vendor 10415).
+type ThirdContextIdentifier uint32
+
+// ToAVP makes Third-Context-Identifier AVP.
+func (v ThirdContextIdentifier) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1719, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Third-Context-Identifier AVP.
+func (v *ThirdContextIdentifier) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1719, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ThirdContextIdentifier(d)
+	return nil
+}
+
+// AVP3GPPChargingCharacteristics is 3GPP-Charging-Characteristics AVP (code 13, vendor 10415).
+type AVP3GPPChargingCharacteristics string
+
+// ToAVP makes 3GPP-Charging-Characteristics AVP.
+func (v AVP3GPPChargingCharacteristics) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 13, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads 3GPP-Charging-Characteristics AVP.
+func (v *AVP3GPPChargingCharacteristics) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 13, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AVP3GPPChargingCharacteristics(d)
+	return nil
+}
+
+// MaxRequestedBandwidthDL is Max-Requested-Bandwidth-DL AVP (code 515, vendor 10415).
+type MaxRequestedBandwidthDL uint32
+
+// ToAVP makes Max-Requested-Bandwidth-DL AVP.
+func (v MaxRequestedBandwidthDL) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 515, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Max-Requested-Bandwidth-DL AVP.
+func (v *MaxRequestedBandwidthDL) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 515, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MaxRequestedBandwidthDL(d)
+	return nil
+}
+
+// MaxRequestedBandwidthUL is Max-Requested-Bandwidth-UL AVP (code 516, vendor 10415).
+type MaxRequestedBandwidthUL uint32
+
+// ToAVP makes Max-Requested-Bandwidth-UL AVP.
+func (v MaxRequestedBandwidthUL) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 516, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Max-Requested-Bandwidth-UL AVP.
+func (v *MaxRequestedBandwidthUL) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 516, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MaxRequestedBandwidthUL(d)
+	return nil
+}
+
+// ExtendedMaxRequestedBWDL is Extended-Max-Requested-BW-DL AVP (code 554, vendor 10415).
+type ExtendedMaxRequestedBWDL uint32
+
+// ToAVP makes Extended-Max-Requested-BW-DL AVP.
+func (v ExtendedMaxRequestedBWDL) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 554, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Extended-Max-Requested-BW-DL AVP.
+func (v *ExtendedMaxRequestedBWDL) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 554, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ExtendedMaxRequestedBWDL(d)
+	return nil
+}
+
+// ExtendedMaxRequestedBWUL is Extended-Max-Requested-BW-UL AVP (code 555, vendor 10415).
+type ExtendedMaxRequestedBWUL uint32
+
+// ToAVP makes Extended-Max-Requested-BW-UL AVP.
+func (v ExtendedMaxRequestedBWUL) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 555, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Extended-Max-Requested-BW-UL AVP.
+func (v *ExtendedMaxRequestedBWUL) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 555, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ExtendedMaxRequestedBWUL(d)
+	return nil
+}
+
+// VisitedNetworkIdentifier is Visited-Network-Identifier AVP (code 600, vendor 10415).
+type VisitedNetworkIdentifier []byte
+
+// ToAVP makes Visited-Network-Identifier AVP.
+func (v VisitedNetworkIdentifier) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 600, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Visited-Network-Identifier AVP.
+func (v *VisitedNetworkIdentifier) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 600, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = VisitedNetworkIdentifier(d)
+	return nil
+}
+
+// ConfidentialityKey is Confidentiality-Key AVP (code 625, vendor 10415).
+type ConfidentialityKey []byte
+
+// ToAVP makes Confidentiality-Key AVP.
+func (v ConfidentialityKey) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 625, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Confidentiality-Key AVP.
+func (v *ConfidentialityKey) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 625, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ConfidentialityKey(d)
+	return nil
+}
+
+// IntegrityKey is Integrity-Key AVP (code 626, vendor 10415).
+type IntegrityKey []byte
+
+// ToAVP makes Integrity-Key AVP.
+func (v IntegrityKey) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 626, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Integrity-Key AVP.
+func (v *IntegrityKey) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 626, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = IntegrityKey(d)
+	return nil
+}
+
+// SupportedFeatures is Supported-Features AVP (code 628, vendor 10415).
+type SupportedFeatures struct {
+	VendorId      VendorId       // { Vendor-Id }
+	FeatureListID FeatureListID  // { Feature-List-ID }
+	FeatureList   FeatureList    // { Feature-List }
+	AVP           []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Supported-Features.
+func (v SupportedFeatures) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 4)
+	avps = append(avps, v.VendorId.ToAVP())
+	avps = append(avps, v.FeatureListID.ToAVP())
+	avps = append(avps, v.FeatureList.ToAVP())
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Supported-Features.
+func (v *SupportedFeatures) FromAVPs(avps []diameter.AVP) error {
+	*v = SupportedFeatures{}
+	var seen [3]bool
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 266: // Vendor-Id
+			e = decodeOne(a, &v.VendorId, &seen[0])
+		case 10415<<32 | 629: // Feature-List-ID
+			e = decodeOne(a, &v.FeatureListID, &seen[1])
+		case 10415<<32 | 630: // Feature-List
+			e = decodeOne(a, &v.FeatureList, &seen[2])
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(266, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(629, 10415, false)
+	}
+	if !seen[2] {
+		return missingAVP(630, 10415, false)
+	}
+	return nil
+}
+
+// ToAVP makes Supported-Features AVP.
+func (v SupportedFeatures) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 628, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Supported-Features AVP.
+func (v *SupportedFeatures) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 628, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// FeatureListID is Feature-List-ID AVP (code 629, vendor 10415).
+type FeatureListID uint32
+
+// ToAVP makes Feature-List-ID AVP.
+func (v FeatureListID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 629, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Feature-List-ID AVP.
+func (v *FeatureListID) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 629, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = FeatureListID(d)
+	return nil
+}
+
+// FeatureList is Feature-List AVP (code 630, vendor 10415).
+type FeatureList uint32
+
+// ToAVP makes Feature-List AVP.
+func (v FeatureList) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 630, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Feature-List AVP.
+func (v *FeatureList) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 630, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = FeatureList(d)
+	return nil
+}
+
+// MSISDN is MSISDN AVP (code 701, vendor 10415).
+type MSISDN []byte
+
+// ToAVP makes MSISDN AVP.
+func (v MSISDN) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 701, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads MSISDN AVP.
+func (v *MSISDN) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 701, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MSISDN(d)
+	return nil
+}
+
+// ServedPartyIPAddress is Served-Party-IP-Address AVP (code 848, vendor 10415).
+type ServedPartyIPAddress net.IP
+
+// ToAVP makes Served-Party-IP-Address AVP.
+func (v ServedPartyIPAddress) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 848, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(net.IP(v))
+	return a
+}
+
+// FromAVP reads Served-Party-IP-Address AVP.
+func (v *ServedPartyIPAddress) FromAVP(a diameter.AVP) error {
+	var d net.IP
+	if e := checkAVP(a, 848, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ServedPartyIPAddress(d)
+	return nil
+}
+
+// ChargedParty is Charged-Party AVP (code 857, vendor 10415).
+type ChargedParty string
+
+// ToAVP makes Charged-Party AVP.
+func (v ChargedParty) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 857, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Charged-Party AVP.
+func (v *ChargedParty) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 857, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ChargedParty(d)
+	return nil
+}
+
+// PDPAddress is PDP-Address AVP (code 1227, vendor 10415).
+type PDPAddress net.IP
+
+// ToAVP makes PDP-Address AVP.
+func (v PDPAddress) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1227, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(net.IP(v))
+	return a
+}
+
+// FromAVP reads PDP-Address AVP.
+func (v *PDPAddress) FromAVP(a diameter.AVP) error {
+	var d net.IP
+	if e := checkAVP(a, 1227, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PDPAddress(d)
+	return nil
+}
+
+// UserCSGInformation is User-CSG-Information AVP (code 2319, vendor 10415).
+type UserCSGInformation struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of User-CSG-Information.
+func (v UserCSGInformation) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of User-CSG-Information.
+func (v *UserCSGInformation) FromAVPs(avps []diameter.AVP) error {
+	*v = UserCSGInformation{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes User-CSG-Information AVP.
+func (v UserCSGInformation) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 2319, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads User-CSG-Information AVP.
+func (v *UserCSGInformation) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 2319, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// QoSClassIdentifier is QoS-Class-Identifier AVP (code 1028, vendor 10415).
+type QoSClassIdentifier diameter.Enumerated
+
+const (
+	QoSClassIdentifierQci1  QoSClassIdentifier = 1  // QCI_1
+	QoSClassIdentifierQci2  QoSClassIdentifier = 2  // QCI_2
+	QoSClassIdentifierQci3  QoSClassIdentifier = 3  // QCI_3
+	QoSClassIdentifierQci4  QoSClassIdentifier = 4  // QCI_4
+	QoSClassIdentifierQci5  QoSClassIdentifier = 5  // QCI_5
+	QoSClassIdentifierQci6  QoSClassIdentifier = 6  // QCI_6
+	QoSClassIdentifierQci7  QoSClassIdentifier = 7  // QCI_7
+	QoSClassIdentifierQci8  QoSClassIdentifier = 8  // QCI_8
+	QoSClassIdentifierQci9  QoSClassIdentifier = 9  // QCI_9
+	QoSClassIdentifierQci65 QoSClassIdentifier = 65 // QCI_65
+	QoSClassIdentifierQci66 QoSClassIdentifier = 66 // QCI_66
+	QoSClassIdentifierQci69 QoSClassIdentifier = 69 // QCI_69
+	QoSClassIdentifierQci70 QoSClassIdentifier = 70 // QCI_70
+	QoSClassIdentifierQci75 QoSClassIdentifier = 75 // QCI_75
+	QoSClassIdentifierQci79 QoSClassIdentifier = 79 // QCI_79
+	QoSClassIdentifierQci80 QoSClassIdentifier = 80 // QCI_80
+	QoSClassIdentifierQci82 QoSClassIdentifier = 82 // QCI_82
+	QoSClassIdentifierQci83 QoSClassIdentifier = 83 // QCI_83
+	QoSClassIdentifierQci84 QoSClassIdentifier = 84 // QCI_84
+	QoSClassIdentifierQci85 QoSClassIdentifier = 85 // QCI_85
+)
+
+func (v QoSClassIdentifier) String() string {
+	switch v {
+	case 1:
+		return "QCI_1"
+	case 2:
+		return "QCI_2"
+	case 3:
+		return "QCI_3"
+	case 4:
+		return "QCI_4"
+	case 5:
+		return "QCI_5"
+	case 6:
+		return "QCI_6"
+	case 7:
+		return "QCI_7"
+	case 8:
+		return "QCI_8"
+	case 9:
+		return "QCI_9"
+	case 65:
+		return "QCI_65"
+	case 66:
+		return "QCI_66"
+	case 69:
+		return "QCI_69"
+	case 70:
+		return "QCI_70"
+	case 75:
+		return "QCI_75"
+	case 79:
+		return "QCI_79"
+	case 80:
+		return "QCI_80"
+	case 82:
+		return "QCI_82"
+	case 83:
+		return "QCI_83"
+	case 84:
+		return "QCI_84"
+	case 85:
+		return "QCI_85"
+	}
+	return fmt.Sprintf("QoSClassIdentifier(%d)", v)
+}
+
+// ToAVP makes QoS-Class-Identifier AVP.
+func (v QoSClassIdentifier) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1028, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads QoS-Class-Identifier AVP.
+func (v *QoSClassIdentifier) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1028, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = QoSClassIdentifier(d)
+	return nil
+}
+
+// RATType is RAT-Type AVP (code 1032, vendor 10415).
+type RATType diameter.Enumerated
+
+const (
+	RATTypeWlan          RATType = 0    // WLAN
+	RATTypeVirtual       RATType = 1    // VIRTUAL
+	RATTypeUtran         RATType = 1000 // UTRAN
+	RATTypeGeran         RATType = 1001 // GERAN
+	RATTypeGan           RATType = 1002 // GAN
+	RATTypeHspaEvolution RATType = 1003 // HSPA_EVOLUTION
+	RATTypeEutran        RATType = 1004 // EUTRAN
+	RATTypeEUTRANNBIoT   RATType = 1005 // EUTRAN-NB-IoT
+	RATTypeNgRan         RATType = 1006 // NG-RAN
+	RATTypeCdma20001x    RATType = 2000 // CDMA2000_1X
+	RATTypeHrpd          RATType = 2001 // HRPD
+	RATTypeUmb           RATType = 2002 // UMB
+	RATTypeEhrpd         RATType = 2030 // EHRPD
+)
+
+func (v RATType) String() string {
+	switch v {
+	case 0:
+		return "WLAN"
+	case 1:
+		return "VIRTUAL"
+	case 1000:
+		return "UTRAN"
+	case 1001:
+		return "GERAN"
+	case 1002:
+		return "GAN"
+	case 1003:
+		return "HSPA_EVOLUTION"
+	case 1004:
+		return "EUTRAN"
+	case 1005:
+		return "EUTRAN-NB-IoT"
+	case 1006:
+		return "NG-RAN"
+	case 2000:
+		return "CDMA2000_1X"
+	case 2001:
+		return "HRPD"
+	case 2002:
+		return "UMB"
+	case 2030:
+		return "EHRPD"
+	}
+	return fmt.Sprintf("RATType(%d)", v)
+}
+
+// ToAVP makes RAT-Type AVP.
+func (v RATType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1032, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads RAT-Type AVP.
+func (v *RATType) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1032, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RATType(d)
+	return nil
+}
+
+// AllocationRetentionPriority is Allocation-Retention-Priority AVP (code 1034, vendor 10415).
+type AllocationRetentionPriority struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Allocation-Retention-Priority.
+func (v AllocationRetentionPriority) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Allocation-Retention-Priority.
+func (v *AllocationRetentionPriority) FromAVPs(avps []diameter.AVP) error {
+	*v = AllocationRetentionPriority{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Allocation-Retention-Priority AVP.
+func (v AllocationRetentionPriority) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1034, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Allocation-Retention-Priority AVP.
+func (v *AllocationRetentionPriority) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 1034, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// PriorityLevel is Priority-Level AVP (code 1046, vendor 10415).
+type PriorityLevel uint32
+
+// ToAVP makes Priority-Level AVP.
+func (v PriorityLevel) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1046, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Priority-Level AVP.
+func (v *PriorityLevel) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1046, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PriorityLevel(d)
+	return nil
+}
+
+// PreEmptionCapability is Pre-emption-Capability AVP (code 1047, vendor 10415).
+type PreEmptionCapability diameter.Enumerated
+
+const (
+	PreEmptionCapabilityPreEmptionCapabilityEnabled  PreEmptionCapability = 0 // PRE-EMPTION_CAPABILITY_ENABLED
+	PreEmptionCapabilityPreEmptionCapabilityDisabled PreEmptionCapability = 1 // PRE-EMPTION_CAPABILITY_DISABLED
+)
+
+func (v PreEmptionCapability) String() string {
+	switch v {
+	case 0:
+		return "PRE-EMPTION_CAPABILITY_ENABLED"
+	case 1:
+		return "PRE-EMPTION_CAPABILITY_DISABLED"
+	}
+	return fmt.Sprintf("PreEmptionCapability(%d)", v)
+}
+
+// ToAVP makes Pre-emption-Capability AVP.
+func (v PreEmptionCapability) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1047, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Pre-emption-Capability AVP.
+func (v *PreEmptionCapability) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1047, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PreEmptionCapability(d)
+	return nil
+}
+
+// PreEmptionVulnerability is Pre-emption-Vulnerability AVP (code 1048, vendor 10415).
+type PreEmptionVulnerability diameter.Enumerated
+
+const (
+	PreEmptionVulnerabilityPreEmptionVulnerabilityEnabledm PreEmptionVulnerability = 0 // PRE-EMPTION_VULNERABILITY_ENABLEDM
+	PreEmptionVulnerabilityPreEmptionVulnerabilityDisabled PreEmptionVulnerability = 1 // PRE-EMPTION_VULNERABILITY_DISABLED
+)
+
+func (v PreEmptionVulnerability) String() string {
+	switch v {
+	case 0:
+		return "PRE-EMPTION_VULNERABILITY_ENABLEDM"
+	case 1:
+		return "PRE-EMPTION_VULNERABILITY_DISABLED"
+	}
+	return fmt.Sprintf("PreEmptionVulnerability(%d)", v)
+}
+
+// ToAVP makes Pre-emption-Vulnerability AVP.
+func (v PreEmptionVulnerability) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1048, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Pre-emption-Vulnerability AVP.
+func (v *PreEmptionVulnerability) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 1048, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = PreEmptionVulnerability(d)
+	return nil
+}
+
+// EmergencyServices is Emergency-Services AVP (code 1538, vendor 10415).
+type EmergencyServices uint32
+
+// ToAVP makes Emergency-Services AVP.
+func (v EmergencyServices) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1538, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Emergency-Services AVP.
+func (v *EmergencyServices) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 1538, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = EmergencyServices(d)
+	return nil
+}
+
+// GMLCAddress is GMLC-Address AVP (code 2405, vendor 10415).
+type GMLCAddress net.IP
+
+// ToAVP makes GMLC-Address AVP.
+func (v GMLCAddress) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 2405, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(net.IP(v))
+	return a
+}
+
+// FromAVP reads GMLC-Address AVP.
+func (v *GMLCAddress) FromAVP(a diameter.AVP) error {
+	var d net.IP
+	if e := checkAVP(a, 2405, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = GMLCAddress(d)
+	return nil
+}
+
+// ExternalIdentifier is External-Identifier AVP (code 3111, vendor 10415).
+type ExternalIdentifier string
+
+// ToAVP makes External-Identifier AVP.
+func (v ExternalIdentifier) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3111, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads External-Identifier AVP.
+func (v *ExternalIdentifier) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 3111, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ExternalIdentifier(d)
+	return nil
+}
+
+// AESECommunicationPattern is AESE-Communication-Pattern AVP (code 3113, vendor 10415).
+type AESECommunicationPattern struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of AESE-Communication-Pattern.
+func (v AESECommunicationPattern) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of AESE-Communication-Pattern.
+func (v *AESECommunicationPattern) FromAVPs(avps []diameter.AVP) error {
+	*v = AESECommunicationPattern{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes AESE-Communication-Pattern AVP.
+func (v AESECommunicationPattern) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3113, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads AESE-Communication-Pattern AVP.
+func (v *AESECommunicationPattern) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3113, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// CommunicationPatternSet is Communication-Pattern-set AVP (code 3114, vendor 10415).
+type CommunicationPatternSet struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Communication-Pattern-set.
+func (v CommunicationPatternSet) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Communication-Pattern-set.
+func (v *CommunicationPatternSet) FromAVPs(avps []diameter.AVP) error {
+	*v = CommunicationPatternSet{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Communication-Pattern-set AVP.
+func (v CommunicationPatternSet) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3114, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Communication-Pattern-set AVP.
+func (v *CommunicationPatternSet) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3114, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// MonitoringEventConfiguration is Monitoring-Event-Configuration AVP (code 3122, vendor 10415).
+type MonitoringEventConfiguration struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Monitoring-Event-Configuration.
+func (v MonitoringEventConfiguration) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Monitoring-Event-Configuration.
+func (v *MonitoringEventConfiguration) FromAVPs(avps []diameter.AVP) error {
+	*v = MonitoringEventConfiguration{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Monitoring-Event-Configuration AVP.
+func (v MonitoringEventConfiguration) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3122, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Monitoring-Event-Configuration AVP.
+func (v *MonitoringEventConfiguration) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3122, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// MonitoringEventReport is Monitoring-Event-Report AVP (code 3123, vendor 10415).
+type MonitoringEventReport struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Monitoring-Event-Report.
+func (v MonitoringEventReport) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Monitoring-Event-Report.
+func (v *MonitoringEventReport) FromAVPs(avps []diameter.AVP) error {
+	*v = MonitoringEventReport{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Monitoring-Event-Report AVP.
+func (v MonitoringEventReport) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3123, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Monitoring-Event-Report AVP.
+func (v *MonitoringEventReport) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3123, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// SCEFReferenceID is SCEF-Reference-ID AVP (code 3124, vendor 10415).
+type SCEFReferenceID uint32
+
+// ToAVP makes SCEF-Reference-ID AVP.
+func (v SCEFReferenceID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3124, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads SCEF-Reference-ID AVP.
+func (v *SCEFReferenceID) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3124, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SCEFReferenceID(d)
+	return nil
+}
+
+// SCEFID is SCEF-ID AVP (code 3125, vendor 10415).
+type SCEFID diameter.Identity
+
+// ToAVP makes SCEF-ID AVP.
+func (v SCEFID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3125, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads SCEF-ID AVP.
+func (v *SCEFID) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 3125, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SCEFID(d)
+	return nil
+}
+
+// SCEFReferenceIDForDeletion is SCEF-Reference-ID-for-Deletion AVP (code 3126, vendor 10415).
+type SCEFReferenceIDForDeletion uint32
+
+// ToAVP makes SCEF-Reference-ID-for-Deletion AVP.
+func (v SCEFReferenceIDForDeletion) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3126, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads SCEF-Reference-ID-for-Deletion AVP.
+func (v *SCEFReferenceIDForDeletion) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3126, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SCEFReferenceIDForDeletion(d)
+	return nil
+}
+
+// MonitoringType is Monitoring-Type AVP (code 3127, vendor 10415).
+type MonitoringType uint32
+
+// ToAVP makes Monitoring-Type AVP.
+func (v MonitoringType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3127, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Monitoring-Type AVP.
+func (v *MonitoringType) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3127, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MonitoringType(d)
+	return nil
+}
+
+// MaximumNumberOfReports is Maximum-Number-of-Reports AVP (code 3128, vendor 10415).
+type MaximumNumberOfReports uint32
+
+// ToAVP makes Maximum-Number-of-Reports AVP.
+func (v MaximumNumberOfReports) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3128, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Maximum-Number-of-Reports AVP.
+func (v *MaximumNumberOfReports) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3128, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MaximumNumberOfReports(d)
+	return nil
+}
+
+// UEReachabilityConfiguration is UE-Reachability-Configuration AVP (code 3129, vendor 10415).
+type UEReachabilityConfiguration struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of UE-Reachability-Configuration.
+func (v UEReachabilityConfiguration) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of UE-Reachability-Configuration.
+func (v *UEReachabilityConfiguration) FromAVPs(avps []diameter.AVP) error {
+	*v = UEReachabilityConfiguration{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes UE-Reachability-Configuration AVP.
+func (v UEReachabilityConfiguration) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3129, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads UE-Reachability-Configuration AVP.
+func (v *UEReachabilityConfiguration) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3129, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// MonitoringDuration is Monitoring-Duration AVP (code 3130, vendor 10415).
+type MonitoringDuration time.Time
+
+// ToAVP makes Monitoring-Duration AVP.
+func (v MonitoringDuration) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3130, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(time.Time(v))
+	return a
+}
+
+// FromAVP reads Monitoring-Duration AVP.
+func (v *MonitoringDuration) FromAVP(a diameter.AVP) error {
+	var d time.Time
+	if e := checkAVP(a, 3130, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MonitoringDuration(d)
+	return nil
+}
+
+// ReachabilityType is Reachability-Type AVP (code 3132, vendor 10415).
+type ReachabilityType uint32
+
+// ToAVP makes Reachability-Type AVP.
+func (v ReachabilityType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3132, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Reachability-Type AVP.
+func (v *ReachabilityType) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3132, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ReachabilityType(d)
+	return nil
+}
+
+// MaximumResponseTime is Maximum-Response-Time AVP (code 3134, vendor 10415).
+type MaximumResponseTime uint32
+
+// ToAVP makes Maximum-Response-Time AVP.
+func (v MaximumResponseTime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3134, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Maximum-Response-Time AVP.
+func (v *MaximumResponseTime) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3134, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MaximumResponseTime(d)
+	return nil
+}
+
+// LocationInformationConfiguration is Location-Information-Configuration AVP (code 3135, vendor 10415).
+type LocationInformationConfiguration struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Location-Information-Configuration.
+func (v LocationInformationConfiguration) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Location-Information-Configuration.
+func (v *LocationInformationConfiguration) FromAVPs(avps []diameter.AVP) error {
+	*v = LocationInformationConfiguration{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Location-Information-Configuration AVP.
+func (v LocationInformationConfiguration) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3135, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Location-Information-Configuration AVP.
+func (v *LocationInformationConfiguration) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3135, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ReachabilityInformation is Reachability-Information AVP (code 3140, vendor 10415).
+type ReachabilityInformation uint32
+
+// ToAVP makes Reachability-Information AVP.
+func (v ReachabilityInformation) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3140, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Reachability-Information AVP.
+func (v *ReachabilityInformation) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3140, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ReachabilityInformation(d)
+	return nil
+}
+
+// MonitoringEventConfigStatus is Monitoring-Event-Config-Status AVP (code 3142, vendor 10415).
+type MonitoringEventConfigStatus struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Monitoring-Event-Config-Status.
+func (v MonitoringEventConfigStatus) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Monitoring-Event-Config-Status.
+func (v *MonitoringEventConfigStatus) FromAVPs(avps []diameter.AVP) error {
+	*v = MonitoringEventConfigStatus{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Monitoring-Event-Config-Status AVP.
+func (v MonitoringEventConfigStatus) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3142, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Monitoring-Event-Config-Status AVP.
+func (v *MonitoringEventConfigStatus) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3142, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// SupportedServices is Supported-Services AVP (code 3143, vendor 10415).
+type SupportedServices struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Supported-Services.
+func (v SupportedServices) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Supported-Services.
+func (v *SupportedServices) FromAVPs(avps []diameter.AVP) error {
+	*v = SupportedServices{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Supported-Services AVP.
+func (v SupportedServices) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3143, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Supported-Services AVP.
+func (v *SupportedServices) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3143, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// SupportedMonitoringEvents is Supported-Monitoring-Events AVP (code 3144, vendor 10415).
+type SupportedMonitoringEvents uint64
+
+// ToAVP makes Supported-Monitoring-Events AVP.
+func (v SupportedMonitoringEvents) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3144, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint64(v))
+	return a
+}
+
+// FromAVP reads Supported-Monitoring-Events AVP.
+func (v *SupportedMonitoringEvents) FromAVP(a diameter.AVP) error {
+	var d uint64
+	if e := checkAVP(a, 3144, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SupportedMonitoringEvents(d)
+	return nil
+}
+
+// ReferenceIDValidityTime is Reference-ID-Validity-Time AVP (code 3148, vendor 10415).
+type ReferenceIDValidityTime time.Time
+
+// ToAVP makes Reference-ID-Validity-Time AVP.
+func (v ReferenceIDValidityTime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3148, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(time.Time(v))
+	return a
+}
+
+// FromAVP reads Reference-ID-Validity-Time AVP.
+func (v *ReferenceIDValidityTime) FromAVP(a diameter.AVP) error {
+	var d time.Time
+	if e := checkAVP(a, 3148, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ReferenceIDValidityTime(d)
+	return nil
+}
+
+// LossOfConnectivityReason is Loss-Of-Connectivity-Reason AVP (code 3162, vendor 10415).
+type LossOfConnectivityReason uint32
+
+// ToAVP makes Loss-Of-Connectivity-Reason AVP.
+func (v LossOfConnectivityReason) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3162, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Loss-Of-Connectivity-Reason AVP.
+func (v *LossOfConnectivityReason) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3162, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = LossOfConnectivityReason(d)
+	return nil
+}
+
+// MTCProviderInfo is MTC-Provider-Info AVP (code 3178, vendor 10415).
+type MTCProviderInfo struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of MTC-Provider-Info.
+func (v MTCProviderInfo) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of MTC-Provider-Info.
+func (v *MTCProviderInfo) FromAVPs(avps []diameter.AVP) error {
+	*v = MTCProviderInfo{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes MTC-Provider-Info AVP.
+func (v MTCProviderInfo) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3178, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads MTC-Provider-Info AVP.
+func (v *MTCProviderInfo) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3178, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// PDNConnectivityStatusConfiguration is PDN-Connectivity-Status-Configuration AVP (code 3180, vendor 10415).
+type PDNConnectivityStatusConfiguration struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of PDN-Connectivity-Status-Configuration.
+func (v PDNConnectivityStatusConfiguration) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of PDN-Connectivity-Status-Configuration.
+func (v *PDNConnectivityStatusConfiguration) FromAVPs(avps []diameter.AVP) error {
+	*v = PDNConnectivityStatusConfiguration{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes PDN-Connectivity-Status-Configuration AVP.
+func (v PDNConnectivityStatusConfiguration) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3180, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads PDN-Connectivity-Status-Configuration AVP.
+func (v *PDNConnectivityStatusConfiguration) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3180, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// PDNConnectivityStatusReport is PDN-Connectivity-Status-Report AVP (code 3181, vendor 10415).
+type PDNConnectivityStatusReport struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of PDN-Connectivity-Status-Report.
+func (v PDNConnectivityStatusReport) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of PDN-Connectivity-Status-Report.
+func (v *PDNConnectivityStatusReport) FromAVPs(avps []diameter.AVP) error {
+	*v = PDNConnectivityStatusReport{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes PDN-Connectivity-Status-Report AVP.
+func (v PDNConnectivityStatusReport) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3181, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads PDN-Connectivity-Status-Report AVP.
+func (v *PDNConnectivityStatusReport) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3181, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// TrafficProfile is Traffic-Profile AVP (code 3183, vendor 10415).
+type TrafficProfile uint32
+
+// ToAVP makes Traffic-Profile AVP.
+func (v TrafficProfile) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3183, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Traffic-Profile AVP.
+func (v *TrafficProfile) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3183, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = TrafficProfile(d)
+	return nil
+}
+
+// BatteryIndicator is Battery-Indicator AVP (code 3185, vendor 10415).
+type BatteryIndicator uint32
+
+// ToAVP makes Battery-Indicator AVP.
+func (v BatteryIndicator) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3185, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Battery-Indicator AVP.
+func (v *BatteryIndicator) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3185, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = BatteryIndicator(d)
+	return nil
+}
+
+// SCEFReferenceIDExt is SCEF-Reference-ID-Ext AVP (code 3186, vendor 10415).
+type SCEFReferenceIDExt uint64
+
+// ToAVP makes SCEF-Reference-ID-Ext AVP.
+func (v SCEFReferenceIDExt) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3186, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint64(v))
+	return a
+}
+
+// FromAVP reads SCEF-Reference-ID-Ext AVP.
+func (v *SCEFReferenceIDExt) FromAVP(a diameter.AVP) error {
+	var d uint64
+	if e := checkAVP(a, 3186, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SCEFReferenceIDExt(d)
+	return nil
+}
+
+// SCEFReferenceIDForDeletionExt is SCEF-Reference-ID-for-Deletion-Ext AVP (code 3187, vendor 10415).
+type SCEFReferenceIDForDeletionExt uint64
+
+// ToAVP makes SCEF-Reference-ID-for-Deletion-Ext AVP.
+func (v SCEFReferenceIDForDeletionExt) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3187, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint64(v))
+	return a
+}
+
+// FromAVP reads SCEF-Reference-ID-for-Deletion-Ext AVP.
+func (v *SCEFReferenceIDForDeletionExt) FromAVP(a diameter.AVP) error {
+	var d uint64
+	if e := checkAVP(a, 3187, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SCEFReferenceIDForDeletionExt(d)
+	return nil
+}
+
+// MaximumUEAvailabilityTime is Maximum-UE-Availability-Time AVP (code 3329, vendor 10415).
+type MaximumUEAvailabilityTime time.Time
+
+// ToAVP makes Maximum-UE-Availability-Time AVP.
+func (v MaximumUEAvailabilityTime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3329, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(time.Time(v))
+	return a
+}
+
+// FromAVP reads Maximum-UE-Availability-Time AVP.
+func (v *MaximumUEAvailabilityTime) FromAVP(a diameter.AVP) error {
+	var d time.Time
+	if e := checkAVP(a, 3329, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MaximumUEAvailabilityTime(d)
+	return nil
+}
+
+// ProSeSubscriptionData is ProSe-Subscription-Data AVP (code 3701, vendor 10415).
+type ProSeSubscriptionData struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of ProSe-Subscription-Data.
+func (v ProSeSubscriptionData) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of ProSe-Subscription-Data.
+func (v *ProSeSubscriptionData) FromAVPs(avps []diameter.AVP) error {
+	*v = ProSeSubscriptionData{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes ProSe-Subscription-Data AVP.
+func (v ProSeSubscriptionData) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3701, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads ProSe-Subscription-Data AVP.
+func (v *ProSeSubscriptionData) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3701, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ENodeBID is eNodeB-ID AVP (code 4008, vendor 10415).
+type ENodeBID []byte
+
+// ToAVP makes eNodeB-ID AVP.
+func (v ENodeBID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 4008, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads eNodeB-ID AVP.
+func (v *ENodeBID) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 4008, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ENodeBID(d)
+	return nil
+}
+
+// ExtendedENodeBID is Extended-eNodeB-ID AVP (code 4013, vendor 10415).
+type ExtendedENodeBID []byte
+
+// ToAVP makes Extended-eNodeB-ID AVP.
+func (v ExtendedENodeBID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 4013, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Extended-eNodeB-ID AVP.
+func (v *ExtendedENodeBID) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 4013, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ExtendedENodeBID(d)
+	return nil
+}
+
+// IdleStatusIndication is Idle-Status-Indication AVP (code 4322, vendor 10415).
+type IdleStatusIndication struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Idle-Status-Indication.
+func (v IdleStatusIndication) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Idle-Status-Indication.
+func (v *IdleStatusIndication) FromAVPs(avps []diameter.AVP) error {
+	*v = IdleStatusIndication{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Idle-Status-Indication AVP.
+func (v IdleStatusIndication) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 4322, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Idle-Status-Indication AVP.
+func (v *IdleStatusIndication) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 4322, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ActiveTime is Active-Time AVP (code 4324, vendor 10415).
+type ActiveTime uint32
+
+// ToAVP makes Active-Time AVP.
+func (v ActiveTime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 4324, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Active-Time AVP.
+func (v *ActiveTime) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 4324, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ActiveTime(d)
+	return nil
+}
+
+// AcctInterimInterval is Acct-Interim-Interval AVP (code 85, vendor 0).
+type AcctInterimInterval uint32
+
+// ToAVP makes Acct-Interim-Interval AVP.
+func (v AcctInterimInterval) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 85, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Acct-Interim-Interval AVP.
+func (v *AcctInterimInterval) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 85, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AcctInterimInterval(d)
+	return nil
+}
+
+// AccountingRealtimeRequired is Accounting-Realtime-Required AVP (code 483, vendor 0).
+type AccountingRealtimeRequired diameter.Enumerated
+
+const (
+	AccountingRealtimeRequiredDeliverAndGrant AccountingRealtimeRequired = 1 // DELIVER_AND_GRANT
+	AccountingRealtimeRequiredGrantAndStore   AccountingRealtimeRequired = 2 // GRANT_AND_STORE
+	AccountingRealtimeRequiredGrantAndLose    AccountingRealtimeRequired = 3 // GRANT_AND_LOSE
+)
+
+func (v AccountingRealtimeRequired) String() string {
+	switch v {
+	case 1:
+		return "DELIVER_AND_GRANT"
+	case 2:
+		return "GRANT_AND_STORE"
+	case 3:
+		return "GRANT_AND_LOSE"
+	}
+	return fmt.Sprintf("AccountingRealtimeRequired(%d)", v)
+}
+
+// ToAVP makes Accounting-Realtime-Required AVP.
+func (v AccountingRealtimeRequired) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 483, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Accounting-Realtime-Required AVP.
+func (v *AccountingRealtimeRequired) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 483, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AccountingRealtimeRequired(d)
+	return nil
+}
+
+// AcctMultiSessionId is Acct-Multi-Session-Id AVP (code 50, vendor 0).
+type AcctMultiSessionId string
+
+// ToAVP makes Acct-Multi-Session-Id AVP.
+func (v AcctMultiSessionId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 50, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Acct-Multi-Session-Id AVP.
+func (v *AcctMultiSessionId) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 50, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AcctMultiSessionId(d)
+	return nil
+}
+
+// AccountingRecordNumber is Accounting-Record-Number AVP (code 485, vendor 0).
+type AccountingRecordNumber uint32
+
+// ToAVP makes Accounting-Record-Number AVP.
+func (v AccountingRecordNumber) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 485, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Accounting-Record-Number AVP.
+func (v *AccountingRecordNumber) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 485, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AccountingRecordNumber(d)
+	return nil
+}
+
+// AccountingRecordType is Accounting-Record-Type AVP (code 480, vendor 0).
+type AccountingRecordType diameter.Enumerated
+
+const (
+	AccountingRecordTypeEventRecord   AccountingRecordType = 1 // EVENT_RECORD
+	AccountingRecordTypeStartRecord   AccountingRecordType = 2 // START_RECORD
+	AccountingRecordTypeInterimRecord AccountingRecordType = 3 // INTERIM_RECORD
+	AccountingRecordTypeStopRecord    AccountingRecordType = 4 // STOP_RECORD
+)
+
+func (v AccountingRecordType) String() string {
+	switch v {
+	case 1:
+		return "EVENT_RECORD"
+	case 2:
+		return "START_RECORD"
+	case 3:
+		return "INTERIM_RECORD"
+	case 4:
+		return "STOP_RECORD"
+	}
+	return fmt.Sprintf("AccountingRecordType(%d)", v)
+}
+
+// ToAVP makes Accounting-Record-Type AVP.
+func (v AccountingRecordType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 480, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Accounting-Record-Type AVP.
+func (v *AccountingRecordType) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 480, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AccountingRecordType(d)
+	return nil
+}
+
+// AccountingSessionId is Accounting-Session-Id AVP (code 44, vendor 0).
+type AccountingSessionId []byte
+
+// ToAVP makes Accounting-Session-Id AVP.
+func (v AccountingSessionId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 44, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Accounting-Session-Id AVP.
+func (v *AccountingSessionId) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 44, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AccountingSessionId(d)
+	return nil
+}
+
+// AccountingSubSessionId is Accounting-Sub-Session-Id AVP (code 287, vendor 0).
+type AccountingSubSessionId uint64
+
+// ToAVP makes Accounting-Sub-Session-Id AVP.
+func (v AccountingSubSessionId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 287, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint64(v))
+	return a
+}
+
+// FromAVP reads Accounting-Sub-Session-Id AVP.
+func (v *AccountingSubSessionId) FromAVP(a diameter.AVP) error {
+	var d uint64
+	if e := checkAVP(a, 287, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AccountingSubSessionId(d)
+	return nil
+}
+
+// AcctApplicationId is Acct-Application-Id AVP (code 259, vendor 0).
+type AcctApplicationId uint32
+
+// ToAVP makes Acct-Application-Id AVP.
+func (v AcctApplicationId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 259, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Acct-Application-Id AVP.
+func (v *AcctApplicationId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 259, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AcctApplicationId(d)
+	return nil
+}
+
+// AuthApplicationId is Auth-Application-Id AVP (code 258, vendor 0).
+type AuthApplicationId uint32
+
+// ToAVP makes Auth-Application-Id AVP.
+func (v AuthApplicationId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 258, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Auth-Application-Id AVP.
+func (v *AuthApplicationId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 258, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AuthApplicationId(d)
+	return nil
+}
+
+// AuthRequestType is Auth-Request-Type AVP (code 274, vendor 0).
+type AuthRequestType diameter.Enumerated
+
+const (
+	AuthRequestTypeAuthorizeOnly         AuthRequestType = 0 // AUTHORIZE_ONLY
+	AuthRequestTypeAuthorizeAuthenticate AuthRequestType = 1 // AUTHORIZE_AUTHENTICATE
+)
+
+func (v AuthRequestType) String() string {
+	switch v {
+	case 0:
+		return "AUTHORIZE_ONLY"
+	case 1:
+		return "AUTHORIZE_AUTHENTICATE"
+	}
+	return fmt.Sprintf("AuthRequestType(%d)", v)
+}
+
+// ToAVP makes Auth-Request-Type AVP.
+func (v AuthRequestType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 274, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Auth-Request-Type AVP.
+func (v *AuthRequestType) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 274, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AuthRequestType(d)
+	return nil
+}
+
+// AuthorizationLifetime is Authorization-Lifetime AVP (code 291, vendor 0).
+type AuthorizationLifetime uint32
+
+// ToAVP makes Authorization-Lifetime AVP.
+func (v AuthorizationLifetime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 291, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Authorization-Lifetime AVP.
+func (v *AuthorizationLifetime) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 291, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AuthorizationLifetime(d)
+	return nil
+}
+
+// AuthGracePeriod is Auth-Grace-Period AVP (code 276, vendor 0).
+type AuthGracePeriod uint32
+
+// ToAVP makes Auth-Grace-Period AVP.
+func (v AuthGracePeriod) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 276, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Auth-Grace-Period AVP.
+func (v *AuthGracePeriod) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 276, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AuthGracePeriod(d)
+	return nil
+}
+
+// AuthSessionState is Auth-Session-State AVP (code 277, vendor 0).
+type AuthSessionState diameter.Enumerated
+
+const (
+	AuthSessionStateStateMaintained   AuthSessionState = 0 // STATE_MAINTAINED
+	AuthSessionStateNoStateMaintained AuthSessionState = 1 // NO_STATE_MAINTAINED
+)
+
+func (v AuthSessionState) String() string {
+	switch v {
+	case 0:
+		return "STATE_MAINTAINED"
+	case 1:
+		return "NO_STATE_MAINTAINED"
+	}
+	return fmt.Sprintf("AuthSessionState(%d)", v)
+}
+
+// ToAVP makes Auth-Session-State AVP.
+func (v AuthSessionState) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 277, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Auth-Session-State AVP.
+func (v *AuthSessionState) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 277, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AuthSessionState(d)
+	return nil
+}
+
+// ReAuthRequestType is Re-Auth-Request-Type AVP (code 285, vendor 0).
+type ReAuthRequestType diameter.Enumerated
+
+const (
+	ReAuthRequestTypeAuthorizeOnly         ReAuthRequestType = 0 // AUTHORIZE_ONLY
+	ReAuthRequestTypeAuthorizeAuthenticate ReAuthRequestType = 1 // AUTHORIZE_AUTHENTICATE
+)
+
+func (v ReAuthRequestType) String() string {
+	switch v {
+	case 0:
+		return "AUTHORIZE_ONLY"
+	case 1:
+		return "AUTHORIZE_AUTHENTICATE"
+	}
+	return fmt.Sprintf("ReAuthRequestType(%d)", v)
+}
+
+// ToAVP makes Re-Auth-Request-Type AVP.
+func (v ReAuthRequestType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 285, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Re-Auth-Request-Type AVP.
+func (v *ReAuthRequestType) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 285, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ReAuthRequestType(d)
+	return nil
+}
+
+// Class is Class AVP (code 25, vendor 0).
+type Class []byte
+
+// ToAVP makes Class AVP.
+func (v Class) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 25, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Class AVP.
+func (v *Class) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 25, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = Class(d)
+	return nil
+}
+
+// DestinationHost is Destination-Host AVP (code 293, vendor 0).
+type DestinationHost diameter.Identity
+
+// ToAVP makes Destination-Host AVP.
+func (v DestinationHost) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 293, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Destination-Host AVP.
+func (v *DestinationHost) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 293, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = DestinationHost(d)
+	return nil
+}
+
+// DestinationRealm is Destination-Realm AVP (code 283, vendor 0).
+type DestinationRealm diameter.Identity
+
+// ToAVP makes Destination-Realm AVP.
+func (v DestinationRealm) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 283, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Destination-Realm AVP.
+func (v *DestinationRealm) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 283, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = DestinationRealm(d)
+	return nil
+}
+
+// DisconnectCause is Disconnect-Cause AVP (code 273, vendor 0).
+type DisconnectCause diameter.Enumerated
+
+const (
+	DisconnectCauseRebooting            DisconnectCause = 0 // REBOOTING
+	DisconnectCauseBusy                 DisconnectCause = 1 // BUSY
+	DisconnectCauseDoNotWantToTalkToYou DisconnectCause = 2 // DO_NOT_WANT_TO_TALK_TO_YOU
+)
+
+func (v DisconnectCause) String() string {
+	switch v {
+	case 0:
+		return "REBOOTING"
+	case 1:
+		return "BUSY"
+	case 2:
+		return "DO_NOT_WANT_TO_TALK_TO_YOU"
+	}
+	return fmt.Sprintf("DisconnectCause(%d)", v)
+}
+
+// ToAVP makes Disconnect-Cause AVP.
+func (v DisconnectCause) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 273, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Disconnect-Cause AVP.
+func (v *DisconnectCause) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 273, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = DisconnectCause(d)
+	return nil
+}
+
+// E2ESequence is E2E-Sequence AVP (code 300, vendor 0).
+type E2ESequence struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of E2E-Sequence.
+func (v E2ESequence) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of E2E-Sequence.
+func (v *E2ESequence) FromAVPs(avps []diameter.AVP) error {
+	*v = E2ESequence{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes E2E-Sequence AVP.
+func (v E2ESequence) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 300, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads E2E-Sequence AVP.
+func (v *E2ESequence) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 300, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ErrorMessage is Error-Message AVP (code 281, vendor 0).
+type ErrorMessage string
+
+// ToAVP makes Error-Message AVP.
+func (v ErrorMessage) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 281, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Error-Message AVP.
+func (v *ErrorMessage) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 281, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ErrorMessage(d)
+	return nil
+}
+
+// ErrorReportingHost is Error-Reporting-Host AVP (code 294, vendor 0).
+type ErrorReportingHost diameter.Identity
+
+// ToAVP makes Error-Reporting-Host AVP.
+func (v ErrorReportingHost) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 294, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Error-Reporting-Host AVP.
+func (v *ErrorReportingHost) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 294, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ErrorReportingHost(d)
+	return nil
+}
+
+// EventTimestamp is Event-Timestamp AVP (code 55, vendor 0).
+type EventTimestamp time.Time
+
+// ToAVP makes Event-Timestamp AVP.
+func (v EventTimestamp) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 55, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(time.Time(v))
+	return a
+}
+
+// FromAVP reads Event-Timestamp AVP.
+func (v *EventTimestamp) FromAVP(a diameter.AVP) error {
+	var d time.Time
+	if e := checkAVP(a, 55, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = EventTimestamp(d)
+	return nil
+}
+
+// ExperimentalResult is Experimental-Result AVP (code 297, vendor 0).
+type ExperimentalResult struct {
+	VendorId               VendorId               // { Vendor-Id }
+	ExperimentalResultCode ExperimentalResultCode // { Experimental-Result-Code }
+}
+
+// ToAVPs returns AVPs of Experimental-Result.
+func (v ExperimentalResult) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 2)
+	avps = append(avps, v.VendorId.ToAVP())
+	avps = append(avps, v.ExperimentalResultCode.ToAVP())
+	return avps
+}
+
+// FromAVPs reads AVPs of Experimental-Result.
+func (v *ExperimentalResult) FromAVPs(avps []diameter.AVP) error {
+	*v = ExperimentalResult{}
+	var seen [2]bool
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 266: // Vendor-Id
+			e = decodeOne(a, &v.VendorId, &seen[0])
+		case 298: // Experimental-Result-Code
+			e = decodeOne(a, &v.ExperimentalResultCode, &seen[1])
+		default:
+			e = diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(266, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(298, 0, true)
+	}
+	return nil
+}
+
+// ToAVP makes Experimental-Result AVP.
+func (v ExperimentalResult) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 297, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Experimental-Result AVP.
+func (v *ExperimentalResult) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 297, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ExperimentalResultCode is Experimental-Result-Code AVP (code 298, vendor 0).
+type ExperimentalResultCode uint32
+
+// ToAVP makes Experimental-Result-Code AVP.
+func (v ExperimentalResultCode) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 298, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Experimental-Result-Code AVP.
+func (v *ExperimentalResultCode) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 298, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ExperimentalResultCode(d)
+	return nil
+}
+
+// FailedAVP is Failed-AVP AVP (code 279, vendor 0).
+type FailedAVP struct {
+	AVP []diameter.AVP // *{ AVP }
+}
+
+// ToAVPs returns AVPs of Failed-AVP.
+func (v FailedAVP) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Failed-AVP.
+func (v *FailedAVP) FromAVPs(avps []diameter.AVP) error {
+	*v = FailedAVP{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if len(v.AVP) < 1 {
+		return diameter.InvalidAVP{Code: diameter.MissingAvp}
+	}
+	return nil
+}
+
+// ToAVP makes Failed-AVP AVP.
+func (v FailedAVP) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 279, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Failed-AVP AVP.
+func (v *FailedAVP) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 279, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// FirmwareRevision is Firmware-Revision AVP (code 267, vendor 0).
+type FirmwareRevision uint32
+
+// ToAVP makes Firmware-Revision AVP.
+func (v FirmwareRevision) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 267, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Firmware-Revision AVP.
+func (v *FirmwareRevision) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 267, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = FirmwareRevision(d)
+	return nil
+}
+
+// HostIPAddress is Host-IP-Address AVP (code 257, vendor 0).
+type HostIPAddress net.IP
+
+// ToAVP makes Host-IP-Address AVP.
+func (v HostIPAddress) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 257, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(net.IP(v))
+	return a
+}
+
+// FromAVP reads Host-IP-Address AVP.
+func (v *HostIPAddress) FromAVP(a diameter.AVP) error {
+	var d net.IP
+	if e := checkAVP(a, 257, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = HostIPAddress(d)
+	return nil
+}
+
+// InbandSecurityId is Inband-Security-Id AVP (code 299, vendor 0).
+type InbandSecurityId uint32
+
+// ToAVP makes Inband-Security-Id AVP.
+func (v InbandSecurityId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 299, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Inband-Security-Id AVP.
+func (v *InbandSecurityId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 299, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = InbandSecurityId(d)
+	return nil
+}
+
+// MultiRoundTimeOut is Multi-Round-Time-Out AVP (code 272, vendor 0).
+type MultiRoundTimeOut uint32
+
+// ToAVP makes Multi-Round-Time-Out AVP.
+func (v MultiRoundTimeOut) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 272, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Multi-Round-Time-Out AVP.
+func (v *MultiRoundTimeOut) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 272, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MultiRoundTimeOut(d)
+	return nil
+}
+
+// OriginHost is Origin-Host AVP (code 264, vendor 0).
+type OriginHost diameter.Identity
+
+// ToAVP makes Origin-Host AVP.
+func (v OriginHost) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 264, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Origin-Host AVP.
+func (v *OriginHost) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 264, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = OriginHost(d)
+	return nil
+}
+
+// OriginRealm is Origin-Realm AVP (code 296, vendor 0).
+type OriginRealm diameter.Identity
+
+// ToAVP makes Origin-Realm AVP.
+func (v OriginRealm) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 296, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Origin-Realm AVP.
+func (v *OriginRealm) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 296, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = OriginRealm(d)
+	return nil
+}
+
+// OriginStateId is Origin-State-Id AVP (code 278, vendor 0).
+type OriginStateId uint32
+
+// ToAVP makes Origin-State-Id AVP.
+func (v OriginStateId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 278, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Origin-State-Id AVP.
+func (v *OriginStateId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 278, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = OriginStateId(d)
+	return nil
+}
+
+// ProductName is Product-Name AVP (code 269, vendor 0).
+type ProductName string
+
+// ToAVP makes Product-Name AVP.
+func (v ProductName) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 269, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Product-Name AVP.
+func (v *ProductName) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 269, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ProductName(d)
+	return nil
+}
+
+// ProxyHost is Proxy-Host AVP (code 280, vendor 0).
+type ProxyHost diameter.Identity
+
+// ToAVP makes Proxy-Host AVP.
+func (v ProxyHost) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 280, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Proxy-Host AVP.
+func (v *ProxyHost) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 280, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ProxyHost(d)
+	return nil
+}
+
+// ProxyInfo is Proxy-Info AVP (code 284, vendor 0).
+type ProxyInfo struct {
+	ProxyHost  ProxyHost      // { Proxy-Host }
+	ProxyState ProxyState     // { Proxy-State }
+	AVP        []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Proxy-Info.
+func (v ProxyInfo) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 3)
+	avps = append(avps, v.ProxyHost.ToAVP())
+	avps = append(avps, v.ProxyState.ToAVP())
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Proxy-Info.
+func (v *ProxyInfo) FromAVPs(avps []diameter.AVP) error {
+	*v = ProxyInfo{}
+	var seen [2]bool
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 280: // Proxy-Host
+			e = decodeOne(a, &v.ProxyHost, &seen[0])
+		case 33: // Proxy-State
+			e = decodeOne(a, &v.ProxyState, &seen[1])
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(280, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(33, 0, true)
+	}
+	return nil
+}
+
+// ToAVP makes Proxy-Info AVP.
+func (v ProxyInfo) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 284, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Proxy-Info AVP.
+func (v *ProxyInfo) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 284, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ProxyState is Proxy-State AVP (code 33, vendor 0).
+type ProxyState []byte
+
+// ToAVP makes Proxy-State AVP.
+func (v ProxyState) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 33, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Proxy-State AVP.
+func (v *ProxyState) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 33, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ProxyState(d)
+	return nil
+}
+
+// RedirectHost is Redirect-Host AVP (code 292, vendor 0).
+type RedirectHost diameter.URI
+
+// ToAVP makes Redirect-Host AVP.
+func (v RedirectHost) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 292, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.URI(v))
+	return a
+}
+
+// FromAVP reads Redirect-Host AVP.
+func (v *RedirectHost) FromAVP(a diameter.AVP) error {
+	var d diameter.URI
+	if e := checkAVP(a, 292, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RedirectHost(d)
+	return nil
+}
+
+// RedirectHostUsage is Redirect-Host-Usage AVP (code 261, vendor 0).
+type RedirectHostUsage diameter.Enumerated
+
+const (
+	RedirectHostUsageDontCache           RedirectHostUsage = 0 // DONT_CACHE
+	RedirectHostUsageAllSession          RedirectHostUsage = 1 // ALL_SESSION
+	RedirectHostUsageAllRealm            RedirectHostUsage = 2 // ALL_REALM
+	RedirectHostUsageRealmAndApplication RedirectHostUsage = 3 // REALM_AND_APPLICATION
+	RedirectHostUsageAllApplication      RedirectHostUsage = 4 // ALL_APPLICATION
+	RedirectHostUsageAllHost             RedirectHostUsage = 5 // ALL_HOST
+	RedirectHostUsageAllUser             RedirectHostUsage = 6 // ALL_USER
+)
+
+func (v RedirectHostUsage) String() string {
+	switch v {
+	case 0:
+		return "DONT_CACHE"
+	case 1:
+		return "ALL_SESSION"
+	case 2:
+		return "ALL_REALM"
+	case 3:
+		return "REALM_AND_APPLICATION"
+	case 4:
+		return "ALL_APPLICATION"
+	case 5:
+		return "ALL_HOST"
+	case 6:
+		return "ALL_USER"
+	}
+	return fmt.Sprintf("RedirectHostUsage(%d)", v)
+}
+
+// ToAVP makes Redirect-Host-Usage AVP.
+func (v RedirectHostUsage) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 261, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Redirect-Host-Usage AVP.
+func (v *RedirectHostUsage) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 261, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RedirectHostUsage(d)
+	return nil
+}
+
+// RedirectMaxCacheTime is Redirect-Max-Cache-Time AVP (code 262, vendor 0).
+type RedirectMaxCacheTime uint32
+
+// ToAVP makes Redirect-Max-Cache-Time AVP.
+func (v RedirectMaxCacheTime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 262, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Redirect-Max-Cache-Time AVP.
+func (v *RedirectMaxCacheTime) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 262, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RedirectMaxCacheTime(d)
+	return nil
+}
+
+// ResultCode is Result-Code AVP (code 268, vendor 0).
+type ResultCode uint32
+
+// ToAVP makes Result-Code AVP.
+func (v ResultCode) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 268, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Result-Code AVP.
+func (v *ResultCode) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 268, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ResultCode(d)
+	return nil
+}
+
+// RouteRecord is Route-Record AVP (code 282, vendor 0).
+type RouteRecord diameter.Identity
+
+// ToAVP makes Route-Record AVP.
+func (v RouteRecord) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 282, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Route-Record AVP.
+func (v *RouteRecord) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 282, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RouteRecord(d)
+	return nil
+}
+
+// SessionId is Session-Id AVP (code 263, vendor 0).
+type SessionId string
+
+// ToAVP makes Session-Id AVP.
+func (v SessionId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 263, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Session-Id AVP.
+func (v *SessionId) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 263, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SessionId(d)
+	return nil
+}
+
+// SessionTimeout is Session-Timeout AVP (code 27, vendor 0).
+type SessionTimeout uint32
+
+// ToAVP makes Session-Timeout AVP.
+func (v SessionTimeout) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 27, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Session-Timeout AVP.
+func (v *SessionTimeout) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 27, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SessionTimeout(d)
+	return nil
+}
+
+// SessionBinding is Session-Binding AVP (code 270, vendor 0).
+type SessionBinding uint32
+
+// ToAVP makes Session-Binding AVP.
+func (v SessionBinding) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 270, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Session-Binding AVP.
+func (v *SessionBinding) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 270, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SessionBinding(d)
+	return nil
+}
+
+// SessionServerFailover is Session-Server-Failover AVP (code 271, vendor 0).
+type SessionServerFailover diameter.Enumerated
+
+const (
+	SessionServerFailoverRefuseService        SessionServerFailover = 0 // REFUSE_SERVICE
+	SessionServerFailoverTryAgain             SessionServerFailover = 1 // TRY_AGAIN
+	SessionServerFailoverAllowService         SessionServerFailover = 2 // ALLOW_SERVICE
+	SessionServerFailoverTryAgainAllowService SessionServerFailover = 3 // TRY_AGAIN_ALLOW_SERVICE
+)
+
+func (v SessionServerFailover) String() string {
+	switch v {
+	case 0:
+		return "REFUSE_SERVICE"
+	case 1:
+		return "TRY_AGAIN"
+	case 2:
+		return "ALLOW_SERVICE"
+	case 3:
+		return "TRY_AGAIN_ALLOW_SERVICE"
+	}
+	return fmt.Sprintf("SessionServerFailover(%d)", v)
+}
+
+// ToAVP makes Session-Server-Failover AVP.
+func (v SessionServerFailover) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 271, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Session-Server-Failover AVP.
+func (v *SessionServerFailover) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 271, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SessionServerFailover(d)
+	return nil
+}
+
+// SupportedVendorId is Supported-Vendor-Id AVP (code 265, vendor 0).
+type SupportedVendorId uint32
+
+// ToAVP makes Supported-Vendor-Id AVP.
+func (v SupportedVendorId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 265, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Supported-Vendor-Id AVP.
+func (v *SupportedVendorId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 265, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SupportedVendorId(d)
+	return nil
+}
+
+// TerminationCause is Termination-Cause AVP (code 295, vendor 0).
+type TerminationCause diameter.Enumerated
+
+const (
+	TerminationCauseReserved                     TerminationCause = 0  // Reserved
+	TerminationCauseDiameterLogout               TerminationCause = 1  // DIAMETER_LOGOUT
+	TerminationCauseDiameterServiceNotProvided   TerminationCause = 2  // DIAMETER_SERVICE_NOT_PROVIDED
+	TerminationCauseDiameterBadAnswer            TerminationCause = 3  // DIAMETER_BAD_ANSWER
+	TerminationCauseDiameterAdministrative       TerminationCause = 4  // DIAMETER_ADMINISTRATIVE
+	TerminationCauseDiameterLinkBroken           TerminationCause = 5  // DIAMETER_LINK_BROKEN
+	TerminationCauseDiameterAuthExpired          TerminationCause = 6  // DIAMETER_AUTH_EXPIRED
+	TerminationCauseDiameterUserMoved            TerminationCause = 7  // DIAMETER_USER_MOVED
+	TerminationCauseDiameterSessionTimeout       TerminationCause = 8  // DIAMETER_SESSION_TIMEOUT
+	TerminationCauseUserRequest                  TerminationCause = 11 // User Request
+	TerminationCauseLostCarrier                  TerminationCause = 12 // Lost Carrier
+	TerminationCauseLostService                  TerminationCause = 13 // Lost Service
+	TerminationCauseIdleTimeout                  TerminationCause = 14 // Idle Timeout
+	TerminationCauseSessionTimeout               TerminationCause = 15 // Session Timeout
+	TerminationCauseAdminReset                   TerminationCause = 16 // Admin Reset
+	TerminationCauseAdminReboot                  TerminationCause = 17 // Admin Reboot
+	TerminationCausePortError                    TerminationCause = 18 // Port Error
+	TerminationCauseNASError                     TerminationCause = 19 // NAS Error
+	TerminationCauseNASRequest                   TerminationCause = 20 // NAS Request
+	TerminationCauseNASReboot                    TerminationCause = 21 // NAS Reboot
+	TerminationCausePortUnneeded                 TerminationCause = 22 // Port Unneeded
+	TerminationCausePortPreempted                TerminationCause = 23 // Port Preempted
+	TerminationCausePortSuspended                TerminationCause = 24 // Port Suspended
+	TerminationCauseServiceUnavailable           TerminationCause = 25 // Service Unavailable
+	TerminationCauseCallback                     TerminationCause = 26 // Callback
+	TerminationCauseUserError                    TerminationCause = 27 // User Error
+	TerminationCauseHostRequest                  TerminationCause = 28 // Host Request
+	TerminationCauseSupplicantRestart            TerminationCause = 29 // Supplicant Restart
+	TerminationCauseReauthenticationFailure      TerminationCause = 30 // Reauthentication Failure
+	TerminationCausePortReinitialized            TerminationCause = 31 // Port Reinitialized
+	TerminationCausePortAdministrativelyDisabled TerminationCause = 32 // Port Administratively Disabled
+)
+
+func (v TerminationCause) String() string {
+	switch v {
+	case 0:
+		return "Reserved"
+	case 1:
+		return "DIAMETER_LOGOUT"
+	case 2:
+		return "DIAMETER_SERVICE_NOT_PROVIDED"
+	case 3:
+		return "DIAMETER_BAD_ANSWER"
+	case 4:
+		return "DIAMETER_ADMINISTRATIVE"
+	case 5:
+		return "DIAMETER_LINK_BROKEN"
+	case 6:
+		return "DIAMETER_AUTH_EXPIRED"
+	case 7:
+		return "DIAMETER_USER_MOVED"
+	case 8:
+		return "DIAMETER_SESSION_TIMEOUT"
+	case 11:
+		return "User Request"
+	case 12:
+		return "Lost Carrier"
+	case 13:
+		return "Lost Service"
+	case 14:
+		return "Idle Timeout"
+	case 15:
+		return "Session Timeout"
+	case 16:
+		return "Admin Reset"
+	case 17:
+		return "Admin Reboot"
+	case 18:
+		return "Port Error"
+	case 19:
+		return "NAS Error"
+	case 20:
+		return "NAS Request"
+	case 21:
+		return "NAS Reboot"
+	case 22:
+		return "Port Unneeded"
+	case 23:
+		return "Port Preempted"
+	case 24:
+		return "Port Suspended"
+	case 25:
+		return "Service Unavailable"
+	case 26:
+		return "Callback"
+	case 27:
+		return "User Error"
+	case 28:
+		return "Host Request"
+	case 29:
+		return "Supplicant Restart"
+	case 30:
+		return "Reauthentication Failure"
+	case 31:
+		return "Port Reinitialized"
+	case 32:
+		return "Port Administratively Disabled"
+	}
+	return fmt.Sprintf("TerminationCause(%d)", v)
+}
+
+// ToAVP makes Termination-Cause AVP.
+func (v TerminationCause) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 295, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Termination-Cause AVP.
+func (v *TerminationCause) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 295, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = TerminationCause(d)
+	return nil
+}
+
+// UserName is User-Name AVP (code 1, vendor 0).
+type UserName string
+
+// ToAVP makes User-Name AVP.
+func (v UserName) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads User-Name AVP.
+func (v *UserName) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 1, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = UserName(d)
+	return nil
+}
+
+// VendorId is Vendor-Id AVP (code 266, vendor 0).
+type VendorId uint32
+
+// ToAVP makes Vendor-Id AVP.
+func (v VendorId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 266, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Vendor-Id AVP.
+func (v *VendorId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 266, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = VendorId(d)
+	return nil
+}
+
+// VendorSpecificApplicationId is Vendor-Specific-Application-Id AVP (code 260, vendor 0).
+type VendorSpecificApplicationId struct {
+	VendorId          VendorId           // { Vendor-Id }
+	AuthApplicationId *AuthApplicationId // [ Auth-Application-Id ]
+	AcctApplicationId *AcctApplicationId // [ Acct-Application-Id ]
+}
+
+// ToAVPs returns AVPs of Vendor-Specific-Application-Id.
+func (v VendorSpecificApplicationId) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 3)
+	avps = append(avps, v.VendorId.ToAVP())
+	if v.AuthApplicationId != nil {
+		avps = append(avps, v.AuthApplicationId.ToAVP())
+	}
+	if v.AcctApplicationId != nil {
+		avps = append(avps, v.AcctApplicationId.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Vendor-Specific-Application-Id.
+func (v *VendorSpecificApplicationId) FromAVPs(avps []diameter.AVP) error {
+	*v = VendorSpecificApplicationId{}
+	var seen [1]bool
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 266: // Vendor-Id
+			e = decodeOne(a, &v.VendorId, &seen[0])
+		case 258: // Auth-Application-Id
+			e = decodeOptional(a, &v.AuthApplicationId)
+		case 259: // Acct-Application-Id
+			e = decodeOptional(a, &v.AcctApplicationId)
+		default:
+			e = diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(266, 0, true)
+	}
+	return nil
+}
+
+// ToAVP makes Vendor-Specific-Application-Id AVP.
+func (v VendorSpecificApplicationId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 260, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Vendor-Specific-Application-Id AVP.
+func (v *VendorSpecificApplicationId) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 260, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ServiceSelection is Service-Selection AVP (code 493, vendor 0).
+type ServiceSelection string
+
+// ToAVP makes Service-Selection AVP.
+func (v ServiceSelection) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 493, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Service-Selection AVP.
+func (v *ServiceSelection) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 493, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ServiceSelection(d)
+	return nil
+}
+
+// MIP6AgentInfo is MIP6-Agent-Info AVP (code 486, vendor 0).
+type MIP6AgentInfo struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of MIP6-Agent-Info.
+func (v MIP6AgentInfo) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of MIP6-Agent-Info.
+func (v *MIP6AgentInfo) FromAVPs(avps []diameter.AVP) error {
+	*v = MIP6AgentInfo{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes MIP6-Agent-Info AVP.
+func (v MIP6AgentInfo) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 486, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads MIP6-Agent-Info AVP.
+func (v *MIP6AgentInfo) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 486, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// MIPHomeAgentAddress is MIP-Home-Agent-Address AVP (code 334, vendor 0).
+type MIPHomeAgentAddress net.IP
+
+// ToAVP makes MIP-Home-Agent-Address AVP.
+func (v MIPHomeAgentAddress) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 334, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(net.IP(v))
+	return a
+}
+
+// FromAVP reads MIP-Home-Agent-Address AVP.
+func (v *MIPHomeAgentAddress) FromAVP(a diameter.AVP) error {
+	var d net.IP
+	if e := checkAVP(a, 334, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MIPHomeAgentAddress(d)
+	return nil
+}
+
+// MIPHomeAgentHost is MIP-Home-Agent-Host AVP (code 348, vendor 0).
+type MIPHomeAgentHost diameter.Identity
+
+// ToAVP makes MIP-Home-Agent-Host AVP.
+func (v MIPHomeAgentHost) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 348, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads MIP-Home-Agent-Host AVP.
+func (v *MIPHomeAgentHost) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 348, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MIPHomeAgentHost(d)
+	return nil
+}
+
+// OCSupportedFeatures is OC-Supported-Features AVP (code 621, vendor 0).
+type OCSupportedFeatures struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of OC-Supported-Features.
+func (v OCSupportedFeatures) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of OC-Supported-Features.
+func (v *OCSupportedFeatures) FromAVPs(avps []diameter.AVP) error {
+	*v = OCSupportedFeatures{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes OC-Supported-Features AVP.
+func (v OCSupportedFeatures) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 621, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads OC-Supported-Features AVP.
+func (v *OCSupportedFeatures) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 621, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// OCOLR is OC-OLR AVP (code 623, vendor 0).
+type OCOLR struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of OC-OLR.
+func (v OCOLR) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of OC-OLR.
+func (v *OCOLR) FromAVPs(avps []diameter.AVP) error {
+	*v = OCOLR{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes OC-OLR AVP.
+func (v OCOLR) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 623, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads OC-OLR AVP.
+func (v *OCOLR) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 623, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// SourceID is SourceID AVP (code 649, vendor 0).
+type SourceID diameter.Identity
+
+// ToAVP makes SourceID AVP.
+func (v SourceID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 649, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads SourceID AVP.
+func (v *SourceID) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 649, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SourceID(d)
+	return nil
+}
+
+// Load is Load AVP (code 650, vendor 0).
+type Load struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Load.
+func (v Load) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Load.
+func (v *Load) FromAVPs(avps []diameter.AVP) error {
+	*v = Load{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes Load AVP.
+func (v Load) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 650, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Load AVP.
+func (v *Load) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 650, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// LoadType is Load-Type AVP (code 651, vendor 0).
+type LoadType diameter.Enumerated
+
+const (
+	LoadTypeHost LoadType = 0 // HOST
+	LoadTypePeer LoadType = 1 // PEER
+)
+
+func (v LoadType) String() string {
+	switch v {
+	case 0:
+		return "HOST"
+	case 1:
+		return "PEER"
+	}
+	return fmt.Sprintf("LoadType(%d)", v)
+}
+
+// ToAVP makes Load-Type AVP.
+func (v LoadType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 651, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Load-Type AVP.
+func (v *LoadType) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 651, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = LoadType(d)
+	return nil
+}
+
+// LoadValue is Load-Value AVP (code 652, vendor 0).
+type LoadValue uint64
+
+// ToAVP makes Load-Value AVP.
+func (v LoadValue) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 652, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(uint64(v))
+	return a
+}
+
+// FromAVP reads Load-Value AVP.
+func (v *LoadValue) FromAVP(a diameter.AVP) error {
+	var d uint64
+	if e := checkAVP(a, 652, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = LoadValue(d)
+	return nil
+}
+
+// DRMP is DRMP AVP (code 301, vendor 0).
+type DRMP diameter.Enumerated
+
+const (
+	DRMPPriority0  DRMP = 0  // PRIORITY_0
+	DRMPPriority1  DRMP = 1  // PRIORITY_1
+	DRMPPriority2  DRMP = 2  // PRIORITY_2
+	DRMPPriority3  DRMP = 3  // PRIORITY_3
+	DRMPPriority4  DRMP = 4  // PRIORITY_4
+	DRMPPriority5  DRMP = 5  // PRIORITY_5
+	DRMPPriority6  DRMP = 6  // PRIORITY_6
+	DRMPPriority7  DRMP = 7  // PRIORITY_7
+	DRMPPriority8  DRMP = 8  // PRIORITY_8
+	DRMPPriority9  DRMP = 9  // PRIORITY_9
+	DRMPPriority10 DRMP = 10 // PRIORITY_10
+	DRMPPriority11 DRMP = 11 // PRIORITY_11
+	DRMPPriority12 DRMP = 12 // PRIORITY_12
+	DRMPPriority13 DRMP = 13 // PRIORITY_13
+	DRMPPriority14 DRMP = 14 // PRIORITY_14
+	DRMPPriority15 DRMP = 15 // PRIORITY_15
+)
+
+func (v DRMP) String() string {
+	switch v {
+	case 0:
+		return "PRIORITY_0"
+	case 1:
+		return "PRIORITY_1"
+	case 2:
+		return "PRIORITY_2"
+	case 3:
+		return "PRIORITY_3"
+	case 4:
+		return "PRIORITY_4"
+	case 5:
+		return "PRIORITY_5"
+	case 6:
+		return "PRIORITY_6"
+	case 7:
+		return "PRIORITY_7"
+	case 8:
+		return "PRIORITY_8"
+	case 9:
+		return "PRIORITY_9"
+	case 10:
+		return "PRIORITY_10"
+	case 11:
+		return "PRIORITY_11"
+	case 12:
+		return "PRIORITY_12"
+	case 13:
+		return "PRIORITY_13"
+	case 14:
+		return "PRIORITY_14"
+	case 15:
+		return "PRIORITY_15"
+	}
+	return fmt.Sprintf("DRMP(%d)", v)
+}
+
+// ToAVP makes DRMP AVP.
+func (v DRMP) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 301, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads DRMP AVP.
+func (v *DRMP) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 301, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = DRMP(d)
+	return nil
+}
+
+func checkAVP(a diameter.AVP, code, vid uint32, m bool) error {
+	if a.Code != code || a.VendorID != vid {
+		return fmt.Errorf("AVP code %d (vendor %d) is not %d (vendor %d)",
+			a.Code, a.VendorID, code, vid)
+	}
+	if m && !a.Mandatory {
+		return diameter.InvalidAVP{Code: diameter.InvalidAvpBits, AVP: a}
+	}
+	return nil
+}
+
+func decodeAVP(a diameter.AVP, d any) error {
+	err := a.Decode(d)
+	if err == io.EOF {
+		err = diameter.InvalidAVP{Code: diameter.InvalidAvpLength, AVP: a}
+	} else if err != nil {
+		err = diameter.InvalidAVP{Code: diameter.InvalidAvpValue, AVP: a, E: err}
+	}
+	return err
+}
+
+// avpPtr is pointer of generated AVP type.
+type avpPtr[T any] interface {
+	*T
+	FromAVP(diameter.AVP) error
+}
+
+func decodeOne[T any, P avpPtr[T]](a diameter.AVP, v *T, seen *bool) error {
+	if *seen {
+		return diameter.InvalidAVP{Code: diameter.AvpOccursTooManyTimes, AVP: a}
+	}
+	*seen = true
+	return P(v).FromAVP(a)
+}
+
+func decodeOptional[T any, P avpPtr[T]](a diameter.AVP, v **T) error {
+	if *v != nil {
+		return diameter.InvalidAVP{Code: diameter.AvpOccursTooManyTimes, AVP: a}
+	}
+	*v = new(T)
+	return P(*v).FromAVP(a)
+}
+
+func decodeMulti[T any, P avpPtr[T]](a diameter.AVP, v *[]T, max int) error {
+	if max >= 0 && len(*v) >= max {
+		return diameter.InvalidAVP{Code: diameter.AvpOccursTooManyTimes, AVP: a}
+	}
+	var d T
+	if e := P(&d).FromAVP(a); e != nil {
+		return e
+	}
+	*v = append(*v, d)
+	return nil
+}
+
+func missingAVP(code, vid uint32, m bool) error {
+	return diameter.InvalidAVP{Code: diameter.MissingAvp,
+		AVP: diameter.AVP{Code: code, VendorID: vid, Mandatory: m}}
+}
+
+func checkMessage(m diameter.Message, req bool, code, appID uint32) error {
+	if m.FlgR != req || m.Code != code || m.AppID != appID {
+		return diameter.InvalidMessage{
+			Code:   diameter.CommandUnspported,
+			ErrMsg: fmt.Sprintf("message (R=%t, code=%d, app=%d) is not expected", m.FlgR, m.Code, m.AppID)}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package s6a
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/fkgi/diameter"
+)
+
+func testULR() UpdateLocationRequest {
+	imei := IMEI("123456789012345")
+	return UpdateLocationRequest{
+		SessionId:           "mme.local;1;1",
+		AuthSessionState:    AuthSessionStateNoStateMaintained,
+		OriginHost:          "mme.local",
+		OriginRealm:         "local",
+		DestinationRealm:    "remote",
+		UserName:            "001010123456789",
+		RATType:             RATTypeEutran,
+		ULRFlags:            0x22,
+		VisitedPLMNId:       []byte{0x00, 0xf1, 0x10},
+		TerminalInformation: &TerminalInformation{IMEI: &imei},
+		SupportedFeatures: []SupportedFeatures{
+			{VendorId: 10415, FeatureListID: 1, FeatureList: 0x3},
+			{VendorId: 10415, FeatureListID: 2, FeatureList: 0x1}}}
+}
+
+func TestUpdateLocationRequest(t *testing.T) {
+	ulr := testULR()
+	var r UpdateLocationRequest
+	if e := r.FromMessage(ulr.ToMessage()); e != nil {
+		t.Fatal(e)
+	}
+	if !reflect.DeepEqual(r, ulr) {
+		t.Errorf("decoded ULR is %+v, not %+v", r, ulr)
+	}
+}
+
+func TestUpdateLocationRequestInvalid(t *testing.T) {
+	ulr := testULR()
+	avps := ulr.ToAVPs()
+	var r UpdateLocationRequest
+
+	// missing User-Name
+	var missing []diameter.AVP
+	for _, a := range avps {
+		if a.Code != 1 {
+			missing = append(missing, a)
+		}
+	}
+	var ie diameter.InvalidAVP
+	if e := r.FromAVPs(missing); !errors.As(e, &ie) || ie.Code != diameter.MissingAvp || ie.AVP.Code != 1 {
+		t.Errorf("error of missing AVP is %v", e)
+	}
+
+	// duplicated Origin-Host
+	if e := r.FromAVPs(append(avps, ulr.OriginHost.ToAVP())); !errors.As(e, &ie) || ie.Code != diameter.AvpOccursTooManyTimes {
+		t.Errorf("error of duplicated AVP is %v", e)
+	}
+
+	// Session-Id is not the first AVP
+	avps[0], avps[1] = avps[1], avps[0]
+	if e := r.FromAVPs(avps); !errors.As(e, &ie) || ie.Code != diameter.AvpNotAllowed {
+		t.Errorf("error of misplaced fixed AVP is %v", e)
+	}
+}
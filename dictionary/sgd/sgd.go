@@ -0,0 +1,3045 @@
+// Code generated by generator from sgd.xml. DO NOT EDIT.
+
+package sgd
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/fkgi/diameter"
+)
+
+// SGdApplicationID is Application-ID of SGd.
+const SGdApplicationID uint32 = 16777313
+
+// MOForwardShortMessageCode is Command-Code of MO-Forward-Short-Message.
+const MOForwardShortMessageCode uint32 = 8388645
+
+// MOForwardShortMessageRequest is MO-Forward-Short-Message-Request message of SGd.
+type MOForwardShortMessageRequest struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DestinationRealm            DestinationRealm             // { Destination-Realm }
+	SCAddress                   SCAddress                    // { SC-Address }
+	UserIdentifier              UserIdentifier               // { User-Identifier }
+	SMRPUI                      SMRPUI                       // { SM-RP-UI }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	DestinationHost             *DestinationHost             // [ Destination-Host ]
+	OFRFlags                    *OFRFlags                    // [ OFR-Flags ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	SMSMICorrelationID          *SMSMICorrelationID          // [ SMSMI-Correlation-ID ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of MO-Forward-Short-Message-Request.
+func (v MOForwardShortMessageRequest) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 17)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	avps = append(avps, v.DestinationRealm.ToAVP())
+	avps = append(avps, v.SCAddress.ToAVP())
+	avps = append(avps, v.UserIdentifier.ToAVP())
+	avps = append(avps, v.SMRPUI.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	if v.DestinationHost != nil {
+		avps = append(avps, v.DestinationHost.ToAVP())
+	}
+	if v.OFRFlags != nil {
+		avps = append(avps, v.OFRFlags.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.SMSMICorrelationID != nil {
+		avps = append(avps, v.SMSMICorrelationID.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of MO-Forward-Short-Message-Request.
+func (v *MOForwardShortMessageRequest) FromAVPs(avps []diameter.AVP) error {
+	*v = MOForwardShortMessageRequest{}
+	var seen [8]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 283: // Destination-Realm
+			e = decodeOne(a, &v.DestinationRealm, &seen[4])
+		case 10415<<32 | 3300: // SC-Address
+			e = decodeOne(a, &v.SCAddress, &seen[5])
+		case 10415<<32 | 3102: // User-Identifier
+			e = decodeOne(a, &v.UserIdentifier, &seen[6])
+		case 10415<<32 | 3301: // SM-RP-UI
+			e = decodeOne(a, &v.SMRPUI, &seen[7])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 293: // Destination-Host
+			e = decodeOptional(a, &v.DestinationHost)
+		case 10415<<32 | 3328: // OFR-Flags
+			e = decodeOptional(a, &v.OFRFlags)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 3324: // SMSMI-Correlation-ID
+			e = decodeOptional(a, &v.SMSMICorrelationID)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	if !seen[4] {
+		return missingAVP(283, 0, true)
+	}
+	if !seen[5] {
+		return missingAVP(3300, 10415, true)
+	}
+	if !seen[6] {
+		return missingAVP(3102, 10415, true)
+	}
+	if !seen[7] {
+		return missingAVP(3301, 10415, true)
+	}
+	return nil
+}
+
+// ToMessage makes MO-Forward-Short-Message-Request message.
+func (v MOForwardShortMessageRequest) ToMessage() diameter.Message {
+	return diameter.NewRequest(MOForwardShortMessageCode, SGdApplicationID).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads MO-Forward-Short-Message-Request message.
+func (v *MOForwardShortMessageRequest) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, true, MOForwardShortMessageCode, SGdApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// MOForwardShortMessageAnswer is MO-Forward-Short-Message-Answer message of SGd.
+type MOForwardShortMessageAnswer struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	ResultCode                  *ResultCode                  // [ Result-Code ]
+	ExperimentalResult          *ExperimentalResult          // [ Experimental-Result ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	SMDeliveryFailureCause      *SMDeliveryFailureCause      // [ SM-Delivery-Failure-Cause ]
+	SMRPUI                      *SMRPUI                      // [ SM-RP-UI ]
+	ExternalIdentifier          *ExternalIdentifier          // [ External-Identifier ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	FailedAVP                   *FailedAVP                   // [ Failed-AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of MO-Forward-Short-Message-Answer.
+func (v MOForwardShortMessageAnswer) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 16)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	if v.ResultCode != nil {
+		avps = append(avps, v.ResultCode.ToAVP())
+	}
+	if v.ExperimentalResult != nil {
+		avps = append(avps, v.ExperimentalResult.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.SMDeliveryFailureCause != nil {
+		avps = append(avps, v.SMDeliveryFailureCause.ToAVP())
+	}
+	if v.SMRPUI != nil {
+		avps = append(avps, v.SMRPUI.ToAVP())
+	}
+	if v.ExternalIdentifier != nil {
+		avps = append(avps, v.ExternalIdentifier.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	if v.FailedAVP != nil {
+		avps = append(avps, v.FailedAVP.ToAVP())
+	}
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of MO-Forward-Short-Message-Answer.
+func (v *MOForwardShortMessageAnswer) FromAVPs(avps []diameter.AVP) error {
+	*v = MOForwardShortMessageAnswer{}
+	var seen [4]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 268: // Result-Code
+			e = decodeOptional(a, &v.ResultCode)
+		case 297: // Experimental-Result
+			e = decodeOptional(a, &v.ExperimentalResult)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 3303: // SM-Delivery-Failure-Cause
+			e = decodeOptional(a, &v.SMDeliveryFailureCause)
+		case 10415<<32 | 3301: // SM-RP-UI
+			e = decodeOptional(a, &v.SMRPUI)
+		case 10415<<32 | 3111: // External-Identifier
+			e = decodeOptional(a, &v.ExternalIdentifier)
+		case 279: // Failed-AVP
+			e = decodeOptional(a, &v.FailedAVP)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	return nil
+}
+
+// ToMessage makes MO-Forward-Short-Message-Answer message for the request.
+func (v MOForwardShortMessageAnswer) ToMessage(req diameter.Message) diameter.Message {
+	return diameter.NewAnswer(req).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads MO-Forward-Short-Message-Answer message.
+func (v *MOForwardShortMessageAnswer) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, false, MOForwardShortMessageCode, SGdApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// MTForwardShortMessageCode is Command-Code of MT-Forward-Short-Message.
+const MTForwardShortMessageCode uint32 = 8388646
+
+// MTForwardShortMessageRequest is MT-Forward-Short-Message-Request message of SGd.
+type MTForwardShortMessageRequest struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DestinationHost             DestinationHost              // { Destination-Host }
+	DestinationRealm            DestinationRealm             // { Destination-Realm }
+	UserName                    UserName                     // { User-Name }
+	SCAddress                   SCAddress                    // { SC-Address }
+	SMRPUI                      SMRPUI                       // { SM-RP-UI }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	SMSMICorrelationID          *SMSMICorrelationID          // [ SMSMI-Correlation-ID ]
+	MMENumberForMTSMS           *MMENumberForMTSMS           // [ MME-Number-for-MT-SMS ]
+	SGSNNumber                  *SGSNNumber                  // [ SGSN-Number ]
+	TFRFlags                    *TFRFlags                    // [ TFR-Flags ]
+	SMDeliveryTimer             *SMDeliveryTimer             // [ SM-Delivery-Timer ]
+	SMDeliveryStartTime         *SMDeliveryStartTime         // [ SM-Delivery-Start-Time ]
+	MaximumRetransmissionTime   *MaximumRetransmissionTime   // [ Maximum-Retransmission-Time ]
+	SMSGMSCAddress              *SMSGMSCAddress              // [ SMS-GMSC-Address ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of MT-Forward-Short-Message-Request.
+func (v MTForwardShortMessageRequest) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 23)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	avps = append(avps, v.DestinationHost.ToAVP())
+	avps = append(avps, v.DestinationRealm.ToAVP())
+	avps = append(avps, v.UserName.ToAVP())
+	avps = append(avps, v.SCAddress.ToAVP())
+	avps = append(avps, v.SMRPUI.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.SMSMICorrelationID != nil {
+		avps = append(avps, v.SMSMICorrelationID.ToAVP())
+	}
+	if v.MMENumberForMTSMS != nil {
+		avps = append(avps, v.MMENumberForMTSMS.ToAVP())
+	}
+	if v.SGSNNumber != nil {
+		avps = append(avps, v.SGSNNumber.ToAVP())
+	}
+	if v.TFRFlags != nil {
+		avps = append(avps, v.TFRFlags.ToAVP())
+	}
+	if v.SMDeliveryTimer != nil {
+		avps = append(avps, v.SMDeliveryTimer.ToAVP())
+	}
+	if v.SMDeliveryStartTime != nil {
+		avps = append(avps, v.SMDeliveryStartTime.ToAVP())
+	}
+	if v.MaximumRetransmissionTime != nil {
+		avps = append(avps, v.MaximumRetransmissionTime.ToAVP())
+	}
+	if v.SMSGMSCAddress != nil {
+		avps = append(avps, v.SMSGMSCAddress.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of MT-Forward-Short-Message-Request.
+func (v *MTForwardShortMessageRequest) FromAVPs(avps []diameter.AVP) error {
+	*v = MTForwardShortMessageRequest{}
+	var seen [9]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 293: // Destination-Host
+			e = decodeOne(a, &v.DestinationHost, &seen[4])
+		case 283: // Destination-Realm
+			e = decodeOne(a, &v.DestinationRealm, &seen[5])
+		case 1: // User-Name
+			e = decodeOne(a, &v.UserName, &seen[6])
+		case 10415<<32 | 3300: // SC-Address
+			e = decodeOne(a, &v.SCAddress, &seen[7])
+		case 10415<<32 | 3301: // SM-RP-UI
+			e = decodeOne(a, &v.SMRPUI, &seen[8])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 3324: // SMSMI-Correlation-ID
+			e = decodeOptional(a, &v.SMSMICorrelationID)
+		case 10415<<32 | 1645: // MME-Number-for-MT-SMS
+			e = decodeOptional(a, &v.MMENumberForMTSMS)
+		case 10415<<32 | 1489: // SGSN-Number
+			e = decodeOptional(a, &v.SGSNNumber)
+		case 10415<<32 | 3302: // TFR-Flags
+			e = decodeOptional(a, &v.TFRFlags)
+		case 10415<<32 | 3306: // SM-Delivery-Timer
+			e = decodeOptional(a, &v.SMDeliveryTimer)
+		case 10415<<32 | 3307: // SM-Delivery-Start-Time
+			e = decodeOptional(a, &v.SMDeliveryStartTime)
+		case 10415<<32 | 3330: // Maximum-Retransmission-Time
+			e = decodeOptional(a, &v.MaximumRetransmissionTime)
+		case 10415<<32 | 3332: // SMS-GMSC-Address
+			e = decodeOptional(a, &v.SMSGMSCAddress)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	if !seen[4] {
+		return missingAVP(293, 0, true)
+	}
+	if !seen[5] {
+		return missingAVP(283, 0, true)
+	}
+	if !seen[6] {
+		return missingAVP(1, 0, true)
+	}
+	if !seen[7] {
+		return missingAVP(3300, 10415, true)
+	}
+	if !seen[8] {
+		return missingAVP(3301, 10415, true)
+	}
+	return nil
+}
+
+// ToMessage makes MT-Forward-Short-Message-Request message.
+func (v MTForwardShortMessageRequest) ToMessage() diameter.Message {
+	return diameter.NewRequest(MTForwardShortMessageCode, SGdApplicationID).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads MT-Forward-Short-Message-Request message.
+func (v *MTForwardShortMessageRequest) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, true, MTForwardShortMessageCode, SGdApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// MTForwardShortMessageAnswer is MT-Forward-Short-Message-Answer message of SGd.
+type MTForwardShortMessageAnswer struct {
+	SessionId                   SessionId                    // < Session-Id >
+	AuthSessionState            AuthSessionState             // { Auth-Session-State }
+	OriginHost                  OriginHost                   // { Origin-Host }
+	OriginRealm                 OriginRealm                  // { Origin-Realm }
+	DRMP                        *DRMP                        // [ DRMP ]
+	VendorSpecificApplicationId *VendorSpecificApplicationId // [ Vendor-Specific-Application-Id ]
+	ResultCode                  *ResultCode                  // [ Result-Code ]
+	ExperimentalResult          *ExperimentalResult          // [ Experimental-Result ]
+	SupportedFeatures           []SupportedFeatures          // *[ Supported-Features ]
+	AbsentUserDiagnosticSM      *AbsentUserDiagnosticSM      // [ Absent-User-Diagnostic-SM ]
+	SMDeliveryFailureCause      *SMDeliveryFailureCause      // [ SM-Delivery-Failure-Cause ]
+	SMRPUI                      *SMRPUI                      // [ SM-RP-UI ]
+	RequestedRetransmissionTime *RequestedRetransmissionTime // [ Requested-Retransmission-Time ]
+	UserIdentifier              *UserIdentifier              // [ User-Identifier ]
+	AVP                         []diameter.AVP               // *[ AVP ]
+	FailedAVP                   *FailedAVP                   // [ Failed-AVP ]
+	ProxyInfo                   []ProxyInfo                  // *[ Proxy-Info ]
+	RouteRecord                 []RouteRecord                // *[ Route-Record ]
+}
+
+// ToAVPs returns AVPs of MT-Forward-Short-Message-Answer.
+func (v MTForwardShortMessageAnswer) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 18)
+	avps = append(avps, v.SessionId.ToAVP())
+	avps = append(avps, v.AuthSessionState.ToAVP())
+	avps = append(avps, v.OriginHost.ToAVP())
+	avps = append(avps, v.OriginRealm.ToAVP())
+	if v.DRMP != nil {
+		avps = append(avps, v.DRMP.ToAVP())
+	}
+	if v.VendorSpecificApplicationId != nil {
+		avps = append(avps, v.VendorSpecificApplicationId.ToAVP())
+	}
+	if v.ResultCode != nil {
+		avps = append(avps, v.ResultCode.ToAVP())
+	}
+	if v.ExperimentalResult != nil {
+		avps = append(avps, v.ExperimentalResult.ToAVP())
+	}
+	for _, a := range v.SupportedFeatures {
+		avps = append(avps, a.ToAVP())
+	}
+	if v.AbsentUserDiagnosticSM != nil {
+		avps = append(avps, v.AbsentUserDiagnosticSM.ToAVP())
+	}
+	if v.SMDeliveryFailureCause != nil {
+		avps = append(avps, v.SMDeliveryFailureCause.ToAVP())
+	}
+	if v.SMRPUI != nil {
+		avps = append(avps, v.SMRPUI.ToAVP())
+	}
+	if v.RequestedRetransmissionTime != nil {
+		avps = append(avps, v.RequestedRetransmissionTime.ToAVP())
+	}
+	if v.UserIdentifier != nil {
+		avps = append(avps, v.UserIdentifier.ToAVP())
+	}
+	avps = append(avps, v.AVP...)
+	if v.FailedAVP != nil {
+		avps = append(avps, v.FailedAVP.ToAVP())
+	}
+	for _, a := range v.ProxyInfo {
+		avps = append(avps, a.ToAVP())
+	}
+	for _, a := range v.RouteRecord {
+		avps = append(avps, a.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of MT-Forward-Short-Message-Answer.
+func (v *MTForwardShortMessageAnswer) FromAVPs(avps []diameter.AVP) error {
+	*v = MTForwardShortMessageAnswer{}
+	var seen [4]bool
+	for i, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 263: // Session-Id
+			if i != 0 {
+				return diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+			}
+			e = decodeOne(a, &v.SessionId, &seen[0])
+		case 277: // Auth-Session-State
+			e = decodeOne(a, &v.AuthSessionState, &seen[1])
+		case 264: // Origin-Host
+			e = decodeOne(a, &v.OriginHost, &seen[2])
+		case 296: // Origin-Realm
+			e = decodeOne(a, &v.OriginRealm, &seen[3])
+		case 301: // DRMP
+			e = decodeOptional(a, &v.DRMP)
+		case 260: // Vendor-Specific-Application-Id
+			e = decodeOptional(a, &v.VendorSpecificApplicationId)
+		case 268: // Result-Code
+			e = decodeOptional(a, &v.ResultCode)
+		case 297: // Experimental-Result
+			e = decodeOptional(a, &v.ExperimentalResult)
+		case 10415<<32 | 628: // Supported-Features
+			e = decodeMulti(a, &v.SupportedFeatures, -1)
+		case 10415<<32 | 3322: // Absent-User-Diagnostic-SM
+			e = decodeOptional(a, &v.AbsentUserDiagnosticSM)
+		case 10415<<32 | 3303: // SM-Delivery-Failure-Cause
+			e = decodeOptional(a, &v.SMDeliveryFailureCause)
+		case 10415<<32 | 3301: // SM-RP-UI
+			e = decodeOptional(a, &v.SMRPUI)
+		case 10415<<32 | 3331: // Requested-Retransmission-Time
+			e = decodeOptional(a, &v.RequestedRetransmissionTime)
+		case 10415<<32 | 3102: // User-Identifier
+			e = decodeOptional(a, &v.UserIdentifier)
+		case 279: // Failed-AVP
+			e = decodeOptional(a, &v.FailedAVP)
+		case 284: // Proxy-Info
+			e = decodeMulti(a, &v.ProxyInfo, -1)
+		case 282: // Route-Record
+			e = decodeMulti(a, &v.RouteRecord, -1)
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(263, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(277, 0, true)
+	}
+	if !seen[2] {
+		return missingAVP(264, 0, true)
+	}
+	if !seen[3] {
+		return missingAVP(296, 0, true)
+	}
+	return nil
+}
+
+// ToMessage makes MT-Forward-Short-Message-Answer message for the request.
+func (v MTForwardShortMessageAnswer) ToMessage(req diameter.Message) diameter.Message {
+	return diameter.NewAnswer(req).Add(v.ToAVPs()...).Message()
+}
+
+// FromMessage reads MT-Forward-Short-Message-Answer message.
+func (v *MTForwardShortMessageAnswer) FromMessage(m diameter.Message) error {
+	if e := checkMessage(m, false, MTForwardShortMessageCode, SGdApplicationID); e != nil {
+		return e
+	}
+	avps, e := m.GetAVP()
+	if e != nil {
+		return e
+	}
+	return v.FromAVPs(avps)
+}
+
+// SCAddress is SC-Address AVP (code 3300, vendor 10415).
+type SCAddress []byte
+
+// ToAVP makes SC-Address AVP.
+func (v SCAddress) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3300, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads SC-Address AVP.
+func (v *SCAddress) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 3300, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SCAddress(d)
+	return nil
+}
+
+// SMRPUI is SM-RP-UI AVP (code 3301, vendor 10415).
+type SMRPUI []byte
+
+// ToAVP makes SM-RP-UI AVP.
+func (v SMRPUI) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3301, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads SM-RP-UI AVP.
+func (v *SMRPUI) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 3301, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SMRPUI(d)
+	return nil
+}
+
+// TFRFlags is TFR-Flags AVP (code 3302, vendor 10415).
+type TFRFlags uint32
+
+// ToAVP makes TFR-Flags AVP.
+func (v TFRFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3302, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads TFR-Flags AVP.
+func (v *TFRFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3302, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = TFRFlags(d)
+	return nil
+}
+
+// SMDeliveryFailureCause is SM-Delivery-Failure-Cause AVP (code 3303, vendor 10415).
+type SMDeliveryFailureCause struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of SM-Delivery-Failure-Cause.
+func (v SMDeliveryFailureCause) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of SM-Delivery-Failure-Cause.
+func (v *SMDeliveryFailureCause) FromAVPs(avps []diameter.AVP) error {
+	*v = SMDeliveryFailureCause{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes SM-Delivery-Failure-Cause AVP.
+func (v SMDeliveryFailureCause) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3303, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads SM-Delivery-Failure-Cause AVP.
+func (v *SMDeliveryFailureCause) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3303, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// SMEnumeratedDeliveryFailureCause is SM-Enumerated-Delivery-Failure-Cause AVP (code 3304, vendor 10415).
+type SMEnumeratedDeliveryFailureCause diameter.Enumerated
+
+const (
+	SMEnumeratedDeliveryFailureCauseMemoryCapacityExceeded SMEnumeratedDeliveryFailureCause = 0 // MEMORY_CAPACITY_EXCEEDED
+	SMEnumeratedDeliveryFailureCauseEquipmentProtocolError SMEnumeratedDeliveryFailureCause = 1 // EQUIPMENT_PROTOCOL_ERROR
+	SMEnumeratedDeliveryFailureCauseEquipmentNotSmEquipped SMEnumeratedDeliveryFailureCause = 2 // EQUIPMENT_NOT_SM-EQUIPPED
+	SMEnumeratedDeliveryFailureCauseUnknownServiceCentre   SMEnumeratedDeliveryFailureCause = 3 // UNKNOWN_SERVICE_CENTRE
+	SMEnumeratedDeliveryFailureCauseScCongestion           SMEnumeratedDeliveryFailureCause = 4 // SC-CONGESTION
+	SMEnumeratedDeliveryFailureCauseInvalidSmeAddress      SMEnumeratedDeliveryFailureCause = 5 // INVALID_SME-ADDRESS
+	SMEnumeratedDeliveryFailureCauseUserNotScUser          SMEnumeratedDeliveryFailureCause = 6 // USER_NOT_SC-USER
+)
+
+func (v SMEnumeratedDeliveryFailureCause) String() string {
+	switch v {
+	case 0:
+		return "MEMORY_CAPACITY_EXCEEDED"
+	case 1:
+		return "EQUIPMENT_PROTOCOL_ERROR"
+	case 2:
+		return "EQUIPMENT_NOT_SM-EQUIPPED"
+	case 3:
+		return "UNKNOWN_SERVICE_CENTRE"
+	case 4:
+		return "SC-CONGESTION"
+	case 5:
+		return "INVALID_SME-ADDRESS"
+	case 6:
+		return "USER_NOT_SC-USER"
+	}
+	return fmt.Sprintf("SMEnumeratedDeliveryFailureCause(%d)", v)
+}
+
+// ToAVP makes SM-Enumerated-Delivery-Failure-Cause AVP.
+func (v SMEnumeratedDeliveryFailureCause) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3304, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads SM-Enumerated-Delivery-Failure-Cause AVP.
+func (v *SMEnumeratedDeliveryFailureCause) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 3304, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SMEnumeratedDeliveryFailureCause(d)
+	return nil
+}
+
+// SMDiagnosticInfo is SM-Diagnostic-Info AVP (code 3305, vendor 10415).
+type SMDiagnosticInfo []byte
+
+// ToAVP makes SM-Diagnostic-Info AVP.
+func (v SMDiagnosticInfo) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3305, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads SM-Diagnostic-Info AVP.
+func (v *SMDiagnosticInfo) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 3305, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SMDiagnosticInfo(d)
+	return nil
+}
+
+// SMDeliveryTimer is SM-Delivery-Timer AVP (code 3306, vendor 10415).
+type SMDeliveryTimer uint32
+
+// ToAVP makes SM-Delivery-Timer AVP.
+func (v SMDeliveryTimer) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3306, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads SM-Delivery-Timer AVP.
+func (v *SMDeliveryTimer) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3306, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SMDeliveryTimer(d)
+	return nil
+}
+
+// SMDeliveryStartTime is SM-Delivery-Start-Time AVP (code 3307, vendor 10415).
+type SMDeliveryStartTime time.Time
+
+// ToAVP makes SM-Delivery-Start-Time AVP.
+func (v SMDeliveryStartTime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3307, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(time.Time(v))
+	return a
+}
+
+// FromAVP reads SM-Delivery-Start-Time AVP.
+func (v *SMDeliveryStartTime) FromAVP(a diameter.AVP) error {
+	var d time.Time
+	if e := checkAVP(a, 3307, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SMDeliveryStartTime(d)
+	return nil
+}
+
+// SMSMICorrelationID is SMSMI-Correlation-ID AVP (code 3324, vendor 10415).
+type SMSMICorrelationID struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of SMSMI-Correlation-ID.
+func (v SMSMICorrelationID) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of SMSMI-Correlation-ID.
+func (v *SMSMICorrelationID) FromAVPs(avps []diameter.AVP) error {
+	*v = SMSMICorrelationID{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes SMSMI-Correlation-ID AVP.
+func (v SMSMICorrelationID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3324, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads SMSMI-Correlation-ID AVP.
+func (v *SMSMICorrelationID) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3324, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// HSSID is HSS-ID AVP (code 3325, vendor 10415).
+type HSSID []byte
+
+// ToAVP makes HSS-ID AVP.
+func (v HSSID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3325, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads HSS-ID AVP.
+func (v *HSSID) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 3325, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = HSSID(d)
+	return nil
+}
+
+// OriginatingSIPURI is Originating-SIP-URI AVP (code 3326, vendor 10415).
+type OriginatingSIPURI string
+
+// ToAVP makes Originating-SIP-URI AVP.
+func (v OriginatingSIPURI) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3326, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Originating-SIP-URI AVP.
+func (v *OriginatingSIPURI) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 3326, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = OriginatingSIPURI(d)
+	return nil
+}
+
+// DestinationSIPURI is Destination-SIP-URI AVP (code 3327, vendor 10415).
+type DestinationSIPURI string
+
+// ToAVP makes Destination-SIP-URI AVP.
+func (v DestinationSIPURI) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3327, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Destination-SIP-URI AVP.
+func (v *DestinationSIPURI) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 3327, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = DestinationSIPURI(d)
+	return nil
+}
+
+// OFRFlags is OFR-Flags AVP (code 3328, vendor 10415).
+type OFRFlags uint32
+
+// ToAVP makes OFR-Flags AVP.
+func (v OFRFlags) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3328, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads OFR-Flags AVP.
+func (v *OFRFlags) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3328, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = OFRFlags(d)
+	return nil
+}
+
+// MaximumRetransmissionTime is Maximum-Retransmission-Time AVP (code 3330, vendor 10415).
+type MaximumRetransmissionTime time.Time
+
+// ToAVP makes Maximum-Retransmission-Time AVP.
+func (v MaximumRetransmissionTime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3330, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(time.Time(v))
+	return a
+}
+
+// FromAVP reads Maximum-Retransmission-Time AVP.
+func (v *MaximumRetransmissionTime) FromAVP(a diameter.AVP) error {
+	var d time.Time
+	if e := checkAVP(a, 3330, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MaximumRetransmissionTime(d)
+	return nil
+}
+
+// RequestedRetransmissionTime is Requested-Retransmission-Time AVP (code 3331, vendor 10415).
+type RequestedRetransmissionTime time.Time
+
+// ToAVP makes Requested-Retransmission-Time AVP.
+func (v RequestedRetransmissionTime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3331, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(time.Time(v))
+	return a
+}
+
+// FromAVP reads Requested-Retransmission-Time AVP.
+func (v *RequestedRetransmissionTime) FromAVP(a diameter.AVP) error {
+	var d time.Time
+	if e := checkAVP(a, 3331, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RequestedRetransmissionTime(d)
+	return nil
+}
+
+// SMSGMSCAddress is SMS-GMSC-Address AVP (code 3332, vendor 10415).
+type SMSGMSCAddress []byte
+
+// ToAVP makes SMS-GMSC-Address AVP.
+func (v SMSGMSCAddress) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3332, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads SMS-GMSC-Address AVP.
+func (v *SMSGMSCAddress) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 3332, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SMSGMSCAddress(d)
+	return nil
+}
+
+// AbsentUserDiagnosticSM is Absent-User-Diagnostic-SM AVP (code 3322, vendor 10415).
+type AbsentUserDiagnosticSM uint32
+
+// ToAVP makes Absent-User-Diagnostic-SM AVP.
+func (v AbsentUserDiagnosticSM) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3322, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Absent-User-Diagnostic-SM AVP.
+func (v *AbsentUserDiagnosticSM) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 3322, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AbsentUserDiagnosticSM(d)
+	return nil
+}
+
+// MSISDN is MSISDN AVP (code 701, vendor 10415).
+type MSISDN []byte
+
+// ToAVP makes MSISDN AVP.
+func (v MSISDN) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 701, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads MSISDN AVP.
+func (v *MSISDN) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 701, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MSISDN(d)
+	return nil
+}
+
+// UserIdentifier is User-Identifier AVP (code 3102, vendor 10415).
+type UserIdentifier struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of User-Identifier.
+func (v UserIdentifier) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of User-Identifier.
+func (v *UserIdentifier) FromAVPs(avps []diameter.AVP) error {
+	*v = UserIdentifier{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes User-Identifier AVP.
+func (v UserIdentifier) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3102, VendorID: 10415, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads User-Identifier AVP.
+func (v *UserIdentifier) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 3102, 10415, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ExternalIdentifier is External-Identifier AVP (code 3111, vendor 10415).
+type ExternalIdentifier string
+
+// ToAVP makes External-Identifier AVP.
+func (v ExternalIdentifier) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 3111, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads External-Identifier AVP.
+func (v *ExternalIdentifier) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 3111, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ExternalIdentifier(d)
+	return nil
+}
+
+// SGSNNumber is SGSN-Number AVP (code 1489, vendor 10415).
+type SGSNNumber []byte
+
+// ToAVP makes SGSN-Number AVP.
+func (v SGSNNumber) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1489, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads SGSN-Number AVP.
+func (v *SGSNNumber) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1489, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SGSNNumber(d)
+	return nil
+}
+
+// MMENumberForMTSMS is MME-Number-for-MT-SMS AVP (code 1645, vendor 10415).
+type MMENumberForMTSMS []byte
+
+// ToAVP makes MME-Number-for-MT-SMS AVP.
+func (v MMENumberForMTSMS) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1645, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads MME-Number-for-MT-SMS AVP.
+func (v *MMENumberForMTSMS) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 1645, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MMENumberForMTSMS(d)
+	return nil
+}
+
+// SupportedFeatures is Supported-Features AVP (code 628, vendor 10415).
+type SupportedFeatures struct {
+	VendorId      VendorId       // { Vendor-Id }
+	FeatureListID FeatureListID  // { Feature-List-ID }
+	FeatureList   FeatureList    // { Feature-List }
+	AVP           []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Supported-Features.
+func (v SupportedFeatures) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 4)
+	avps = append(avps, v.VendorId.ToAVP())
+	avps = append(avps, v.FeatureListID.ToAVP())
+	avps = append(avps, v.FeatureList.ToAVP())
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Supported-Features.
+func (v *SupportedFeatures) FromAVPs(avps []diameter.AVP) error {
+	*v = SupportedFeatures{}
+	var seen [3]bool
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 266: // Vendor-Id
+			e = decodeOne(a, &v.VendorId, &seen[0])
+		case 10415<<32 | 629: // Feature-List-ID
+			e = decodeOne(a, &v.FeatureListID, &seen[1])
+		case 10415<<32 | 630: // Feature-List
+			e = decodeOne(a, &v.FeatureList, &seen[2])
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(266, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(629, 10415, false)
+	}
+	if !seen[2] {
+		return missingAVP(630, 10415, false)
+	}
+	return nil
+}
+
+// ToAVP makes Supported-Features AVP.
+func (v SupportedFeatures) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 628, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Supported-Features AVP.
+func (v *SupportedFeatures) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 628, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// FeatureListID is Feature-List-ID AVP (code 629, vendor 10415).
+type FeatureListID uint32
+
+// ToAVP makes Feature-List-ID AVP.
+func (v FeatureListID) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 629, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Feature-List-ID AVP.
+func (v *FeatureListID) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 629, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = FeatureListID(d)
+	return nil
+}
+
+// FeatureList is Feature-List AVP (code 630, vendor 10415).
+type FeatureList uint32
+
+// ToAVP makes Feature-List AVP.
+func (v FeatureList) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 630, VendorID: 10415, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Feature-List AVP.
+func (v *FeatureList) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 630, 10415, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = FeatureList(d)
+	return nil
+}
+
+// AcctInterimInterval is Acct-Interim-Interval AVP (code 85, vendor 0).
+type AcctInterimInterval uint32
+
+// ToAVP makes Acct-Interim-Interval AVP.
+func (v AcctInterimInterval) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 85, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Acct-Interim-Interval AVP.
+func (v *AcctInterimInterval) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 85, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AcctInterimInterval(d)
+	return nil
+}
+
+// AccountingRealtimeRequired is Accounting-Realtime-Required AVP (code 483, vendor 0).
+type AccountingRealtimeRequired diameter.Enumerated
+
+const (
+	AccountingRealtimeRequiredDeliverAndGrant AccountingRealtimeRequired = 1 // DELIVER_AND_GRANT
+	AccountingRealtimeRequiredGrantAndStore   AccountingRealtimeRequired = 2 // GRANT_AND_STORE
+	AccountingRealtimeRequiredGrantAndLose    AccountingRealtimeRequired = 3 // GRANT_AND_LOSE
+)
+
+func (v AccountingRealtimeRequired) String() string {
+	switch v {
+	case 1:
+		return "DELIVER_AND_GRANT"
+	case 2:
+		return "GRANT_AND_STORE"
+	case 3:
+		return "GRANT_AND_LOSE"
+	}
+	return fmt.Sprintf("AccountingRealtimeRequired(%d)", v)
+}
+
+// ToAVP makes Accounting-Realtime-Required AVP.
+func (v AccountingRealtimeRequired) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 483, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Accounting-Realtime-Required AVP.
+func (v *AccountingRealtimeRequired) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 483, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AccountingRealtimeRequired(d)
+	return nil
+}
+
+// AcctMultiSessionId is Acct-Multi-Session-Id AVP (code 50, vendor 0).
+type AcctMultiSessionId string
+
+// ToAVP makes Acct-Multi-Session-Id AVP.
+func (v AcctMultiSessionId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 50, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Acct-Multi-Session-Id AVP.
+func (v *AcctMultiSessionId) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 50, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AcctMultiSessionId(d)
+	return nil
+}
+
+// AccountingRecordNumber is Accounting-Record-Number AVP (code 485, vendor 0).
+type AccountingRecordNumber uint32
+
+// ToAVP makes Accounting-Record-Number AVP.
+func (v AccountingRecordNumber) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 485, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Accounting-Record-Number AVP.
+func (v *AccountingRecordNumber) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 485, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AccountingRecordNumber(d)
+	return nil
+}
+
+// AccountingRecordType is Accounting-Record-Type AVP (code 480, vendor 0).
+type AccountingRecordType diameter.Enumerated
+
+const (
+	AccountingRecordTypeEventRecord   AccountingRecordType = 1 // EVENT_RECORD
+	AccountingRecordTypeStartRecord   AccountingRecordType = 2 // START_RECORD
+	AccountingRecordTypeInterimRecord AccountingRecordType = 3 // INTERIM_RECORD
+	AccountingRecordTypeStopRecord    AccountingRecordType = 4 // STOP_RECORD
+)
+
+func (v AccountingRecordType) String() string {
+	switch v {
+	case 1:
+		return "EVENT_RECORD"
+	case 2:
+		return "START_RECORD"
+	case 3:
+		return "INTERIM_RECORD"
+	case 4:
+		return "STOP_RECORD"
+	}
+	return fmt.Sprintf("AccountingRecordType(%d)", v)
+}
+
+// ToAVP makes Accounting-Record-Type AVP.
+func (v AccountingRecordType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 480, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Accounting-Record-Type AVP.
+func (v *AccountingRecordType) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 480, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AccountingRecordType(d)
+	return nil
+}
+
+// AccountingSessionId is Accounting-Session-Id AVP (code 44, vendor 0).
+type AccountingSessionId []byte
+
+// ToAVP makes Accounting-Session-Id AVP.
+func (v AccountingSessionId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 44, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Accounting-Session-Id AVP.
+func (v *AccountingSessionId) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 44, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AccountingSessionId(d)
+	return nil
+}
+
+// AccountingSubSessionId is Accounting-Sub-Session-Id AVP (code 287, vendor 0).
+type AccountingSubSessionId uint64
+
+// ToAVP makes Accounting-Sub-Session-Id AVP.
+func (v AccountingSubSessionId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 287, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint64(v))
+	return a
+}
+
+// FromAVP reads Accounting-Sub-Session-Id AVP.
+func (v *AccountingSubSessionId) FromAVP(a diameter.AVP) error {
+	var d uint64
+	if e := checkAVP(a, 287, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AccountingSubSessionId(d)
+	return nil
+}
+
+// AcctApplicationId is Acct-Application-Id AVP (code 259, vendor 0).
+type AcctApplicationId uint32
+
+// ToAVP makes Acct-Application-Id AVP.
+func (v AcctApplicationId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 259, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Acct-Application-Id AVP.
+func (v *AcctApplicationId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 259, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AcctApplicationId(d)
+	return nil
+}
+
+// AuthApplicationId is Auth-Application-Id AVP (code 258, vendor 0).
+type AuthApplicationId uint32
+
+// ToAVP makes Auth-Application-Id AVP.
+func (v AuthApplicationId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 258, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Auth-Application-Id AVP.
+func (v *AuthApplicationId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 258, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AuthApplicationId(d)
+	return nil
+}
+
+// AuthRequestType is Auth-Request-Type AVP (code 274, vendor 0).
+type AuthRequestType diameter.Enumerated
+
+const (
+	AuthRequestTypeAuthorizeOnly         AuthRequestType = 0 // AUTHORIZE_ONLY
+	AuthRequestTypeAuthorizeAuthenticate AuthRequestType = 1 // AUTHORIZE_AUTHENTICATE
+)
+
+func (v AuthRequestType) String() string {
+	switch v {
+	case 0:
+		return "AUTHORIZE_ONLY"
+	case 1:
+		return "AUTHORIZE_AUTHENTICATE"
+	}
+	return fmt.Sprintf("AuthRequestType(%d)", v)
+}
+
+// ToAVP makes Auth-Request-Type AVP.
+func (v AuthRequestType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 274, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Auth-Request-Type AVP.
+func (v *AuthRequestType) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 274, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AuthRequestType(d)
+	return nil
+}
+
+// AuthorizationLifetime is Authorization-Lifetime AVP (code 291, vendor 0).
+type AuthorizationLifetime uint32
+
+// ToAVP makes Authorization-Lifetime AVP.
+func (v AuthorizationLifetime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 291, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Authorization-Lifetime AVP.
+func (v *AuthorizationLifetime) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 291, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AuthorizationLifetime(d)
+	return nil
+}
+
+// AuthGracePeriod is Auth-Grace-Period AVP (code 276, vendor 0).
+type AuthGracePeriod uint32
+
+// ToAVP makes Auth-Grace-Period AVP.
+func (v AuthGracePeriod) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 276, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Auth-Grace-Period AVP.
+func (v *AuthGracePeriod) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 276, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AuthGracePeriod(d)
+	return nil
+}
+
+// AuthSessionState is Auth-Session-State AVP (code 277, vendor 0).
+type AuthSessionState diameter.Enumerated
+
+const (
+	AuthSessionStateStateMaintained   AuthSessionState = 0 // STATE_MAINTAINED
+	AuthSessionStateNoStateMaintained AuthSessionState = 1 // NO_STATE_MAINTAINED
+)
+
+func (v AuthSessionState) String() string {
+	switch v {
+	case 0:
+		return "STATE_MAINTAINED"
+	case 1:
+		return "NO_STATE_MAINTAINED"
+	}
+	return fmt.Sprintf("AuthSessionState(%d)", v)
+}
+
+// ToAVP makes Auth-Session-State AVP.
+func (v AuthSessionState) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 277, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Auth-Session-State AVP.
+func (v *AuthSessionState) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 277, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = AuthSessionState(d)
+	return nil
+}
+
+// ReAuthRequestType is Re-Auth-Request-Type AVP (code 285, vendor 0).
+type ReAuthRequestType diameter.Enumerated
+
+const (
+	ReAuthRequestTypeAuthorizeOnly         ReAuthRequestType = 0 // AUTHORIZE_ONLY
+	ReAuthRequestTypeAuthorizeAuthenticate ReAuthRequestType = 1 // AUTHORIZE_AUTHENTICATE
+)
+
+func (v ReAuthRequestType) String() string {
+	switch v {
+	case 0:
+		return "AUTHORIZE_ONLY"
+	case 1:
+		return "AUTHORIZE_AUTHENTICATE"
+	}
+	return fmt.Sprintf("ReAuthRequestType(%d)", v)
+}
+
+// ToAVP makes Re-Auth-Request-Type AVP.
+func (v ReAuthRequestType) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 285, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Re-Auth-Request-Type AVP.
+func (v *ReAuthRequestType) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 285, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ReAuthRequestType(d)
+	return nil
+}
+
+// Class is Class AVP (code 25, vendor 0).
+type Class []byte
+
+// ToAVP makes Class AVP.
+func (v Class) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 25, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Class AVP.
+func (v *Class) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 25, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = Class(d)
+	return nil
+}
+
+// DestinationHost is Destination-Host AVP (code 293, vendor 0).
+type DestinationHost diameter.Identity
+
+// ToAVP makes Destination-Host AVP.
+func (v DestinationHost) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 293, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Destination-Host AVP.
+func (v *DestinationHost) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 293, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = DestinationHost(d)
+	return nil
+}
+
+// DestinationRealm is Destination-Realm AVP (code 283, vendor 0).
+type DestinationRealm diameter.Identity
+
+// ToAVP makes Destination-Realm AVP.
+func (v DestinationRealm) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 283, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Destination-Realm AVP.
+func (v *DestinationRealm) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 283, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = DestinationRealm(d)
+	return nil
+}
+
+// DisconnectCause is Disconnect-Cause AVP (code 273, vendor 0).
+type DisconnectCause diameter.Enumerated
+
+const (
+	DisconnectCauseRebooting            DisconnectCause = 0 // REBOOTING
+	DisconnectCauseBusy                 DisconnectCause = 1 // BUSY
+	DisconnectCauseDoNotWantToTalkToYou DisconnectCause = 2 // DO_NOT_WANT_TO_TALK_TO_YOU
+)
+
+func (v DisconnectCause) String() string {
+	switch v {
+	case 0:
+		return "REBOOTING"
+	case 1:
+		return "BUSY"
+	case 2:
+		return "DO_NOT_WANT_TO_TALK_TO_YOU"
+	}
+	return fmt.Sprintf("DisconnectCause(%d)", v)
+}
+
+// ToAVP makes Disconnect-Cause AVP.
+func (v DisconnectCause) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 273, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Disconnect-Cause AVP.
+func (v *DisconnectCause) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 273, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = DisconnectCause(d)
+	return nil
+}
+
+// E2ESequence is E2E-Sequence AVP (code 300, vendor 0).
+type E2ESequence struct {
+	AVP []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of E2E-Sequence.
+func (v E2ESequence) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of E2E-Sequence.
+func (v *E2ESequence) FromAVPs(avps []diameter.AVP) error {
+	*v = E2ESequence{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ToAVP makes E2E-Sequence AVP.
+func (v E2ESequence) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 300, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads E2E-Sequence AVP.
+func (v *E2ESequence) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 300, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ErrorMessage is Error-Message AVP (code 281, vendor 0).
+type ErrorMessage string
+
+// ToAVP makes Error-Message AVP.
+func (v ErrorMessage) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 281, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Error-Message AVP.
+func (v *ErrorMessage) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 281, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ErrorMessage(d)
+	return nil
+}
+
+// ErrorReportingHost is Error-Reporting-Host AVP (code 294, vendor 0).
+type ErrorReportingHost diameter.Identity
+
+// ToAVP makes Error-Reporting-Host AVP.
+func (v ErrorReportingHost) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 294, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Error-Reporting-Host AVP.
+func (v *ErrorReportingHost) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 294, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ErrorReportingHost(d)
+	return nil
+}
+
+// EventTimestamp is Event-Timestamp AVP (code 55, vendor 0).
+type EventTimestamp time.Time
+
+// ToAVP makes Event-Timestamp AVP.
+func (v EventTimestamp) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 55, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(time.Time(v))
+	return a
+}
+
+// FromAVP reads Event-Timestamp AVP.
+func (v *EventTimestamp) FromAVP(a diameter.AVP) error {
+	var d time.Time
+	if e := checkAVP(a, 55, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = EventTimestamp(d)
+	return nil
+}
+
+// ExperimentalResult is Experimental-Result AVP (code 297, vendor 0).
+type ExperimentalResult struct {
+	VendorId               VendorId               // { Vendor-Id }
+	ExperimentalResultCode ExperimentalResultCode // { Experimental-Result-Code }
+}
+
+// ToAVPs returns AVPs of Experimental-Result.
+func (v ExperimentalResult) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 2)
+	avps = append(avps, v.VendorId.ToAVP())
+	avps = append(avps, v.ExperimentalResultCode.ToAVP())
+	return avps
+}
+
+// FromAVPs reads AVPs of Experimental-Result.
+func (v *ExperimentalResult) FromAVPs(avps []diameter.AVP) error {
+	*v = ExperimentalResult{}
+	var seen [2]bool
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 266: // Vendor-Id
+			e = decodeOne(a, &v.VendorId, &seen[0])
+		case 298: // Experimental-Result-Code
+			e = decodeOne(a, &v.ExperimentalResultCode, &seen[1])
+		default:
+			e = diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(266, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(298, 0, true)
+	}
+	return nil
+}
+
+// ToAVP makes Experimental-Result AVP.
+func (v ExperimentalResult) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 297, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Experimental-Result AVP.
+func (v *ExperimentalResult) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 297, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ExperimentalResultCode is Experimental-Result-Code AVP (code 298, vendor 0).
+type ExperimentalResultCode uint32
+
+// ToAVP makes Experimental-Result-Code AVP.
+func (v ExperimentalResultCode) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 298, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Experimental-Result-Code AVP.
+func (v *ExperimentalResultCode) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 298, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ExperimentalResultCode(d)
+	return nil
+}
+
+// FailedAVP is Failed-AVP AVP (code 279, vendor 0).
+type FailedAVP struct {
+	AVP []diameter.AVP // *{ AVP }
+}
+
+// ToAVPs returns AVPs of Failed-AVP.
+func (v FailedAVP) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 1)
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Failed-AVP.
+func (v *FailedAVP) FromAVPs(avps []diameter.AVP) error {
+	*v = FailedAVP{}
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if len(v.AVP) < 1 {
+		return diameter.InvalidAVP{Code: diameter.MissingAvp}
+	}
+	return nil
+}
+
+// ToAVP makes Failed-AVP AVP.
+func (v FailedAVP) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 279, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Failed-AVP AVP.
+func (v *FailedAVP) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 279, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// FirmwareRevision is Firmware-Revision AVP (code 267, vendor 0).
+type FirmwareRevision uint32
+
+// ToAVP makes Firmware-Revision AVP.
+func (v FirmwareRevision) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 267, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Firmware-Revision AVP.
+func (v *FirmwareRevision) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 267, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = FirmwareRevision(d)
+	return nil
+}
+
+// HostIPAddress is Host-IP-Address AVP (code 257, vendor 0).
+type HostIPAddress net.IP
+
+// ToAVP makes Host-IP-Address AVP.
+func (v HostIPAddress) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 257, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(net.IP(v))
+	return a
+}
+
+// FromAVP reads Host-IP-Address AVP.
+func (v *HostIPAddress) FromAVP(a diameter.AVP) error {
+	var d net.IP
+	if e := checkAVP(a, 257, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = HostIPAddress(d)
+	return nil
+}
+
+// InbandSecurityId is Inband-Security-Id AVP (code 299, vendor 0).
+type InbandSecurityId uint32
+
+// ToAVP makes Inband-Security-Id AVP.
+func (v InbandSecurityId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 299, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Inband-Security-Id AVP.
+func (v *InbandSecurityId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 299, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = InbandSecurityId(d)
+	return nil
+}
+
+// MultiRoundTimeOut is Multi-Round-Time-Out AVP (code 272, vendor 0).
+type MultiRoundTimeOut uint32
+
+// ToAVP makes Multi-Round-Time-Out AVP.
+func (v MultiRoundTimeOut) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 272, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Multi-Round-Time-Out AVP.
+func (v *MultiRoundTimeOut) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 272, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = MultiRoundTimeOut(d)
+	return nil
+}
+
+// OriginHost is Origin-Host AVP (code 264, vendor 0).
+type OriginHost diameter.Identity
+
+// ToAVP makes Origin-Host AVP.
+func (v OriginHost) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 264, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Origin-Host AVP.
+func (v *OriginHost) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 264, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = OriginHost(d)
+	return nil
+}
+
+// OriginRealm is Origin-Realm AVP (code 296, vendor 0).
+type OriginRealm diameter.Identity
+
+// ToAVP makes Origin-Realm AVP.
+func (v OriginRealm) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 296, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Origin-Realm AVP.
+func (v *OriginRealm) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 296, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = OriginRealm(d)
+	return nil
+}
+
+// OriginStateId is Origin-State-Id AVP (code 278, vendor 0).
+type OriginStateId uint32
+
+// ToAVP makes Origin-State-Id AVP.
+func (v OriginStateId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 278, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Origin-State-Id AVP.
+func (v *OriginStateId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 278, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = OriginStateId(d)
+	return nil
+}
+
+// ProductName is Product-Name AVP (code 269, vendor 0).
+type ProductName string
+
+// ToAVP makes Product-Name AVP.
+func (v ProductName) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 269, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Product-Name AVP.
+func (v *ProductName) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 269, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ProductName(d)
+	return nil
+}
+
+// ProxyHost is Proxy-Host AVP (code 280, vendor 0).
+type ProxyHost diameter.Identity
+
+// ToAVP makes Proxy-Host AVP.
+func (v ProxyHost) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 280, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Proxy-Host AVP.
+func (v *ProxyHost) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 280, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ProxyHost(d)
+	return nil
+}
+
+// ProxyInfo is Proxy-Info AVP (code 284, vendor 0).
+type ProxyInfo struct {
+	ProxyHost  ProxyHost      // { Proxy-Host }
+	ProxyState ProxyState     // { Proxy-State }
+	AVP        []diameter.AVP // *[ AVP ]
+}
+
+// ToAVPs returns AVPs of Proxy-Info.
+func (v ProxyInfo) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 3)
+	avps = append(avps, v.ProxyHost.ToAVP())
+	avps = append(avps, v.ProxyState.ToAVP())
+	avps = append(avps, v.AVP...)
+	return avps
+}
+
+// FromAVPs reads AVPs of Proxy-Info.
+func (v *ProxyInfo) FromAVPs(avps []diameter.AVP) error {
+	*v = ProxyInfo{}
+	var seen [2]bool
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 280: // Proxy-Host
+			e = decodeOne(a, &v.ProxyHost, &seen[0])
+		case 33: // Proxy-State
+			e = decodeOne(a, &v.ProxyState, &seen[1])
+		default:
+			v.AVP = append(v.AVP, a)
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(280, 0, true)
+	}
+	if !seen[1] {
+		return missingAVP(33, 0, true)
+	}
+	return nil
+}
+
+// ToAVP makes Proxy-Info AVP.
+func (v ProxyInfo) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 284, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Proxy-Info AVP.
+func (v *ProxyInfo) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 284, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// ProxyState is Proxy-State AVP (code 33, vendor 0).
+type ProxyState []byte
+
+// ToAVP makes Proxy-State AVP.
+func (v ProxyState) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 33, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode([]byte(v))
+	return a
+}
+
+// FromAVP reads Proxy-State AVP.
+func (v *ProxyState) FromAVP(a diameter.AVP) error {
+	var d []byte
+	if e := checkAVP(a, 33, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ProxyState(d)
+	return nil
+}
+
+// RedirectHost is Redirect-Host AVP (code 292, vendor 0).
+type RedirectHost diameter.URI
+
+// ToAVP makes Redirect-Host AVP.
+func (v RedirectHost) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 292, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.URI(v))
+	return a
+}
+
+// FromAVP reads Redirect-Host AVP.
+func (v *RedirectHost) FromAVP(a diameter.AVP) error {
+	var d diameter.URI
+	if e := checkAVP(a, 292, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RedirectHost(d)
+	return nil
+}
+
+// RedirectHostUsage is Redirect-Host-Usage AVP (code 261, vendor 0).
+type RedirectHostUsage diameter.Enumerated
+
+const (
+	RedirectHostUsageDontCache           RedirectHostUsage = 0 // DONT_CACHE
+	RedirectHostUsageAllSession          RedirectHostUsage = 1 // ALL_SESSION
+	RedirectHostUsageAllRealm            RedirectHostUsage = 2 // ALL_REALM
+	RedirectHostUsageRealmAndApplication RedirectHostUsage = 3 // REALM_AND_APPLICATION
+	RedirectHostUsageAllApplication      RedirectHostUsage = 4 // ALL_APPLICATION
+	RedirectHostUsageAllHost             RedirectHostUsage = 5 // ALL_HOST
+	RedirectHostUsageAllUser             RedirectHostUsage = 6 // ALL_USER
+)
+
+func (v RedirectHostUsage) String() string {
+	switch v {
+	case 0:
+		return "DONT_CACHE"
+	case 1:
+		return "ALL_SESSION"
+	case 2:
+		return "ALL_REALM"
+	case 3:
+		return "REALM_AND_APPLICATION"
+	case 4:
+		return "ALL_APPLICATION"
+	case 5:
+		return "ALL_HOST"
+	case 6:
+		return "ALL_USER"
+	}
+	return fmt.Sprintf("RedirectHostUsage(%d)", v)
+}
+
+// ToAVP makes Redirect-Host-Usage AVP.
+func (v RedirectHostUsage) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 261, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Redirect-Host-Usage AVP.
+func (v *RedirectHostUsage) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 261, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RedirectHostUsage(d)
+	return nil
+}
+
+// RedirectMaxCacheTime is Redirect-Max-Cache-Time AVP (code 262, vendor 0).
+type RedirectMaxCacheTime uint32
+
+// ToAVP makes Redirect-Max-Cache-Time AVP.
+func (v RedirectMaxCacheTime) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 262, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Redirect-Max-Cache-Time AVP.
+func (v *RedirectMaxCacheTime) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 262, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RedirectMaxCacheTime(d)
+	return nil
+}
+
+// ResultCode is Result-Code AVP (code 268, vendor 0).
+type ResultCode uint32
+
+// ToAVP makes Result-Code AVP.
+func (v ResultCode) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 268, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Result-Code AVP.
+func (v *ResultCode) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 268, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = ResultCode(d)
+	return nil
+}
+
+// RouteRecord is Route-Record AVP (code 282, vendor 0).
+type RouteRecord diameter.Identity
+
+// ToAVP makes Route-Record AVP.
+func (v RouteRecord) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 282, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Identity(v))
+	return a
+}
+
+// FromAVP reads Route-Record AVP.
+func (v *RouteRecord) FromAVP(a diameter.AVP) error {
+	var d diameter.Identity
+	if e := checkAVP(a, 282, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = RouteRecord(d)
+	return nil
+}
+
+// SessionId is Session-Id AVP (code 263, vendor 0).
+type SessionId string
+
+// ToAVP makes Session-Id AVP.
+func (v SessionId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 263, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads Session-Id AVP.
+func (v *SessionId) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 263, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SessionId(d)
+	return nil
+}
+
+// SessionTimeout is Session-Timeout AVP (code 27, vendor 0).
+type SessionTimeout uint32
+
+// ToAVP makes Session-Timeout AVP.
+func (v SessionTimeout) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 27, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Session-Timeout AVP.
+func (v *SessionTimeout) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 27, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SessionTimeout(d)
+	return nil
+}
+
+// SessionBinding is Session-Binding AVP (code 270, vendor 0).
+type SessionBinding uint32
+
+// ToAVP makes Session-Binding AVP.
+func (v SessionBinding) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 270, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Session-Binding AVP.
+func (v *SessionBinding) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 270, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SessionBinding(d)
+	return nil
+}
+
+// SessionServerFailover is Session-Server-Failover AVP (code 271, vendor 0).
+type SessionServerFailover diameter.Enumerated
+
+const (
+	SessionServerFailoverRefuseService        SessionServerFailover = 0 // REFUSE_SERVICE
+	SessionServerFailoverTryAgain             SessionServerFailover = 1 // TRY_AGAIN
+	SessionServerFailoverAllowService         SessionServerFailover = 2 // ALLOW_SERVICE
+	SessionServerFailoverTryAgainAllowService SessionServerFailover = 3 // TRY_AGAIN_ALLOW_SERVICE
+)
+
+func (v SessionServerFailover) String() string {
+	switch v {
+	case 0:
+		return "REFUSE_SERVICE"
+	case 1:
+		return "TRY_AGAIN"
+	case 2:
+		return "ALLOW_SERVICE"
+	case 3:
+		return "TRY_AGAIN_ALLOW_SERVICE"
+	}
+	return fmt.Sprintf("SessionServerFailover(%d)", v)
+}
+
+// ToAVP makes Session-Server-Failover AVP.
+func (v SessionServerFailover) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 271, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Session-Server-Failover AVP.
+func (v *SessionServerFailover) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 271, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SessionServerFailover(d)
+	return nil
+}
+
+// SupportedVendorId is Supported-Vendor-Id AVP (code 265, vendor 0).
+type SupportedVendorId uint32
+
+// ToAVP makes Supported-Vendor-Id AVP.
+func (v SupportedVendorId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 265, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Supported-Vendor-Id AVP.
+func (v *SupportedVendorId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 265, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = SupportedVendorId(d)
+	return nil
+}
+
+// TerminationCause is Termination-Cause AVP (code 295, vendor 0).
+type TerminationCause diameter.Enumerated
+
+const (
+	TerminationCauseReserved                     TerminationCause = 0  // Reserved
+	TerminationCauseDiameterLogout               TerminationCause = 1  // DIAMETER_LOGOUT
+	TerminationCauseDiameterServiceNotProvided   TerminationCause = 2  // DIAMETER_SERVICE_NOT_PROVIDED
+	TerminationCauseDiameterBadAnswer            TerminationCause = 3  // DIAMETER_BAD_ANSWER
+	TerminationCauseDiameterAdministrative       TerminationCause = 4  // DIAMETER_ADMINISTRATIVE
+	TerminationCauseDiameterLinkBroken           TerminationCause = 5  // DIAMETER_LINK_BROKEN
+	TerminationCauseDiameterAuthExpired          TerminationCause = 6  // DIAMETER_AUTH_EXPIRED
+	TerminationCauseDiameterUserMoved            TerminationCause = 7  // DIAMETER_USER_MOVED
+	TerminationCauseDiameterSessionTimeout       TerminationCause = 8  // DIAMETER_SESSION_TIMEOUT
+	TerminationCauseUserRequest                  TerminationCause = 11 // User Request
+	TerminationCauseLostCarrier                  TerminationCause = 12 // Lost Carrier
+	TerminationCauseLostService                  TerminationCause = 13 // Lost Service
+	TerminationCauseIdleTimeout                  TerminationCause = 14 // Idle Timeout
+	TerminationCauseSessionTimeout               TerminationCause = 15 // Session Timeout
+	TerminationCauseAdminReset                   TerminationCause = 16 // Admin Reset
+	TerminationCauseAdminReboot                  TerminationCause = 17 // Admin Reboot
+	TerminationCausePortError                    TerminationCause = 18 // Port Error
+	TerminationCauseNASError                     TerminationCause = 19 // NAS Error
+	TerminationCauseNASRequest                   TerminationCause = 20 // NAS Request
+	TerminationCauseNASReboot                    TerminationCause = 21 // NAS Reboot
+	TerminationCausePortUnneeded                 TerminationCause = 22 // Port Unneeded
+	TerminationCausePortPreempted                TerminationCause = 23 // Port Preempted
+	TerminationCausePortSuspended                TerminationCause = 24 // Port Suspended
+	TerminationCauseServiceUnavailable           TerminationCause = 25 // Service Unavailable
+	TerminationCauseCallback                     TerminationCause = 26 // Callback
+	TerminationCauseUserError                    TerminationCause = 27 // User Error
+	TerminationCauseHostRequest                  TerminationCause = 28 // Host Request
+	TerminationCauseSupplicantRestart            TerminationCause = 29 // Supplicant Restart
+	TerminationCauseReauthenticationFailure      TerminationCause = 30 // Reauthentication Failure
+	TerminationCausePortReinitialized            TerminationCause = 31 // Port Reinitialized
+	TerminationCausePortAdministrativelyDisabled TerminationCause = 32 // Port Administratively Disabled
+)
+
+func (v TerminationCause) String() string {
+	switch v {
+	case 0:
+		return "Reserved"
+	case 1:
+		return "DIAMETER_LOGOUT"
+	case 2:
+		return "DIAMETER_SERVICE_NOT_PROVIDED"
+	case 3:
+		return "DIAMETER_BAD_ANSWER"
+	case 4:
+		return "DIAMETER_ADMINISTRATIVE"
+	case 5:
+		return "DIAMETER_LINK_BROKEN"
+	case 6:
+		return "DIAMETER_AUTH_EXPIRED"
+	case 7:
+		return "DIAMETER_USER_MOVED"
+	case 8:
+		return "DIAMETER_SESSION_TIMEOUT"
+	case 11:
+		return "User Request"
+	case 12:
+		return "Lost Carrier"
+	case 13:
+		return "Lost Service"
+	case 14:
+		return "Idle Timeout"
+	case 15:
+		return "Session Timeout"
+	case 16:
+		return "Admin Reset"
+	case 17:
+		return "Admin Reboot"
+	case 18:
+		return "Port Error"
+	case 19:
+		return "NAS Error"
+	case 20:
+		return "NAS Request"
+	case 21:
+		return "NAS Reboot"
+	case 22:
+		return "Port Unneeded"
+	case 23:
+		return "Port Preempted"
+	case 24:
+		return "Port Suspended"
+	case 25:
+		return "Service Unavailable"
+	case 26:
+		return "Callback"
+	case 27:
+		return "User Error"
+	case 28:
+		return "Host Request"
+	case 29:
+		return "Supplicant Restart"
+	case 30:
+		return "Reauthentication Failure"
+	case 31:
+		return "Port Reinitialized"
+	case 32:
+		return "Port Administratively Disabled"
+	}
+	return fmt.Sprintf("TerminationCause(%d)", v)
+}
+
+// ToAVP makes Termination-Cause AVP.
+func (v TerminationCause) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 295, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads Termination-Cause AVP.
+func (v *TerminationCause) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 295, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = TerminationCause(d)
+	return nil
+}
+
+// UserName is User-Name AVP (code 1, vendor 0).
+type UserName string
+
+// ToAVP makes User-Name AVP.
+func (v UserName) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 1, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(string(v))
+	return a
+}
+
+// FromAVP reads User-Name AVP.
+func (v *UserName) FromAVP(a diameter.AVP) error {
+	var d string
+	if e := checkAVP(a, 1, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = UserName(d)
+	return nil
+}
+
+// VendorId is Vendor-Id AVP (code 266, vendor 0).
+type VendorId uint32
+
+// ToAVP makes Vendor-Id AVP.
+func (v VendorId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 266, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(uint32(v))
+	return a
+}
+
+// FromAVP reads Vendor-Id AVP.
+func (v *VendorId) FromAVP(a diameter.AVP) error {
+	var d uint32
+	if e := checkAVP(a, 266, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = VendorId(d)
+	return nil
+}
+
+// VendorSpecificApplicationId is Vendor-Specific-Application-Id AVP (code 260, vendor 0).
+type VendorSpecificApplicationId struct {
+	VendorId          VendorId           // { Vendor-Id }
+	AuthApplicationId *AuthApplicationId // [ Auth-Application-Id ]
+	AcctApplicationId *AcctApplicationId // [ Acct-Application-Id ]
+}
+
+// ToAVPs returns AVPs of Vendor-Specific-Application-Id.
+func (v VendorSpecificApplicationId) ToAVPs() []diameter.AVP {
+	avps := make([]diameter.AVP, 0, 3)
+	avps = append(avps, v.VendorId.ToAVP())
+	if v.AuthApplicationId != nil {
+		avps = append(avps, v.AuthApplicationId.ToAVP())
+	}
+	if v.AcctApplicationId != nil {
+		avps = append(avps, v.AcctApplicationId.ToAVP())
+	}
+	return avps
+}
+
+// FromAVPs reads AVPs of Vendor-Specific-Application-Id.
+func (v *VendorSpecificApplicationId) FromAVPs(avps []diameter.AVP) error {
+	*v = VendorSpecificApplicationId{}
+	var seen [1]bool
+	for _, a := range avps {
+		var e error
+		switch uint64(a.VendorID)<<32 | uint64(a.Code) {
+		case 266: // Vendor-Id
+			e = decodeOne(a, &v.VendorId, &seen[0])
+		case 258: // Auth-Application-Id
+			e = decodeOptional(a, &v.AuthApplicationId)
+		case 259: // Acct-Application-Id
+			e = decodeOptional(a, &v.AcctApplicationId)
+		default:
+			e = diameter.InvalidAVP{Code: diameter.AvpNotAllowed, AVP: a}
+		}
+		if e != nil {
+			return e
+		}
+	}
+
+	if !seen[0] {
+		return missingAVP(266, 0, true)
+	}
+	return nil
+}
+
+// ToAVP makes Vendor-Specific-Application-Id AVP.
+func (v VendorSpecificApplicationId) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 260, VendorID: 0, Mandatory: true, Protected: false}
+	a.Encode(v.ToAVPs())
+	return a
+}
+
+// FromAVP reads Vendor-Specific-Application-Id AVP.
+func (v *VendorSpecificApplicationId) FromAVP(a diameter.AVP) error {
+	var d []diameter.AVP
+	if e := checkAVP(a, 260, 0, true); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	return v.FromAVPs(d)
+}
+
+// DRMP is DRMP AVP (code 301, vendor 0).
+type DRMP diameter.Enumerated
+
+const (
+	DRMPPriority0  DRMP = 0  // PRIORITY_0
+	DRMPPriority1  DRMP = 1  // PRIORITY_1
+	DRMPPriority2  DRMP = 2  // PRIORITY_2
+	DRMPPriority3  DRMP = 3  // PRIORITY_3
+	DRMPPriority4  DRMP = 4  // PRIORITY_4
+	DRMPPriority5  DRMP = 5  // PRIORITY_5
+	DRMPPriority6  DRMP = 6  // PRIORITY_6
+	DRMPPriority7  DRMP = 7  // PRIORITY_7
+	DRMPPriority8  DRMP = 8  // PRIORITY_8
+	DRMPPriority9  DRMP = 9  // PRIORITY_9
+	DRMPPriority10 DRMP = 10 // PRIORITY_10
+	DRMPPriority11 DRMP = 11 // PRIORITY_11
+	DRMPPriority12 DRMP = 12 // PRIORITY_12
+	DRMPPriority13 DRMP = 13 // PRIORITY_13
+	DRMPPriority14 DRMP = 14 // PRIORITY_14
+	DRMPPriority15 DRMP = 15 // PRIORITY_15
+)
+
+func (v DRMP) String() string {
+	switch v {
+	case 0:
+		return "PRIORITY_0"
+	case 1:
+		return "PRIORITY_1"
+	case 2:
+		return "PRIORITY_2"
+	case 3:
+		return "PRIORITY_3"
+	case 4:
+		return "PRIORITY_4"
+	case 5:
+		return "PRIORITY_5"
+	case 6:
+		return "PRIORITY_6"
+	case 7:
+		return "PRIORITY_7"
+	case 8:
+		return "PRIORITY_8"
+	case 9:
+		return "PRIORITY_9"
+	case 10:
+		return "PRIORITY_10"
+	case 11:
+		return "PRIORITY_11"
+	case 12:
+		return "PRIORITY_12"
+	case 13:
+		return "PRIORITY_13"
+	case 14:
+		return "PRIORITY_14"
+	case 15:
+		return "PRIORITY_15"
+	}
+	return fmt.Sprintf("DRMP(%d)", v)
+}
+
+// ToAVP makes DRMP AVP.
+func (v DRMP) ToAVP() diameter.AVP {
+	a := diameter.AVP{Code: 301, VendorID: 0, Mandatory: false, Protected: false}
+	a.Encode(diameter.Enumerated(v))
+	return a
+}
+
+// FromAVP reads DRMP AVP.
+func (v *DRMP) FromAVP(a diameter.AVP) error {
+	var d diameter.Enumerated
+	if e := checkAVP(a, 301, 0, false); e != nil {
+		return e
+	} else if e = decodeAVP(a, &d); e != nil {
+		return e
+	}
+	*v = DRMP(d)
+	return nil
+}
+
+func checkAVP(a diameter.AVP, code, vid uint32, m bool) error {
+	if a.Code != code || a.VendorID != vid {
+		return fmt.Errorf("AVP code %d (vendor %d) is not %d (vendor %d)",
+			a.Code, a.VendorID, code, vid)
+	}
+	if m && !a.Mandatory {
+		return diameter.InvalidAVP{Code: diameter.InvalidAvpBits, AVP: a}
+	}
+	return nil
+}
+
+func decodeAVP(a diameter.AVP, d any) error {
+	err := a.Decode(d)
+	if err == io.EOF {
+		err = diameter.InvalidAVP{Code: diameter.InvalidAvpLength, AVP: a}
+	} else if err != nil {
+		err = diameter.InvalidAVP{Code: diameter.InvalidAvpValue, AVP: a, E: err}
+	}
+	return err
+}
+
+// avpPtr is pointer of generated AVP type.
+type avpPtr[T any] interface {
+	*T
+	FromAVP(diameter.AVP) error
+}
+
+func decodeOne[T any, P avpPtr[T]](a diameter.AVP, v *T, seen *bool) error {
+	if *seen {
+		return diameter.InvalidAVP{Code: diameter.AvpOccursTooManyTimes, AVP: a}
+	}
+	*seen = true
+	return P(v).FromAVP(a)
+}
+
+func decodeOptional[T any, P avpPtr[T]](a diameter.AVP, v **T) error {
+	if *v != nil {
+		return diameter.InvalidAVP{Code: diameter.AvpOccursTooManyTimes, AVP: a}
+	}
+	*v = new(T)
+	return P(*v).FromAVP(a)
+}
+
+func decodeMulti[T any, P avpPtr[T]](a diameter.AVP, v *[]T, max int) error {
+	if max >= 0 && len(*v) >= max {
+		return diameter.InvalidAVP{Code: diameter.AvpOccursTooManyTimes, AVP: a}
+	}
+	var d T
+	if e := P(&d).FromAVP(a); e != nil {
+		return e
+	}
+	*v = append(*v, d)
+	return nil
+}
+
+func missingAVP(code, vid uint32, m bool) error {
+	return diameter.InvalidAVP{Code: diameter.MissingAvp,
+		AVP: diameter.AVP{Code: code, VendorID: vid, Mandatory: m}}
+}
+
+func checkMessage(m diameter.Message, req bool, code, appID uint32) error {
+	if m.FlgR != req || m.Code != code || m.AppID != appID {
+		return diameter.InvalidMessage{
+			Code:   diameter.CommandUnspported,
+			ErrMsg: fmt.Sprintf("message (R=%t, code=%d, app=%d) is not expected", m.FlgR, m.Code, m.AppID)}
+	}
+	return nil
+}
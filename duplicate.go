@@ -0,0 +1,162 @@
+package diameter
+
+import (
+	"time"
+)
+
+// DuplicateWindow is period to keep received request for detecting retransmitted request.
+// Zero disables duplicate detection.
+var DuplicateWindow time.Duration
+
+// History of received request and its answer, keyed by End-to-End ID and Origin-Host.
+var duplicates = make(chan dupHistory, 1)
+
+func init() {
+	duplicates <- dupHistory{seen: make(map[dupKey]dupEntry)}
+}
+
+type dupKey struct {
+	eteID uint32
+	host  Identity
+}
+
+type dupEntry struct {
+	at    time.Time
+	done  chan struct{} // closed when handling of the request is finished
+	cache bool          // answer is cached for retransmitted request
+	ans   *Message
+}
+
+type dupHistory struct {
+	seen  map[dupKey]dupEntry
+	order []dupKey
+
+	hits   uint64
+	misses uint64
+}
+
+func requestKey(m Message) dupKey {
+	k := dupKey{eteID: m.EtEID}
+	for it := NewAVPIterator(m.AVPs); it.Next(); {
+		a := it.AVP()
+		if a.VendorID == 0 && a.Code == 264 {
+			k.host, _ = GetOriginHost(a)
+			break
+		}
+	}
+	return k
+}
+
+// checkDuplicate records the request and returns true
+// if the request is retransmission (T flag is set) of the request
+// with same End-to-End ID and Origin-Host (RFC 6733 section 5.5.4).
+// Retransmission of the request that is being handled waits for the end of handling,
+// and cached answer is returned if the answer of previous request is already sent.
+// Returned done must be passed to finishDuplicate after handling the request.
+func (n *Node) checkDuplicate(m Message) (k dupKey, done chan struct{}, ans *Message, seen bool) {
+	if n.DuplicateWindow <= 0 {
+		return
+	}
+	k = requestKey(m)
+
+	for {
+		now := time.Now()
+		h := <-n.duplicates
+		for len(h.order) != 0 {
+			o := h.order[0]
+			if now.Sub(h.seen[o].at) < n.DuplicateWindow {
+				break
+			}
+			delete(h.seen, o)
+			h.order = h.order[1:]
+		}
+
+		e, ok := h.seen[k]
+		switch {
+		case !ok:
+			done = make(chan struct{})
+			h.seen[k] = dupEntry{at: now, done: done}
+			h.order = append(h.order, k)
+		case !m.FlgT:
+			// request that is not retransmission is not duplicated
+		case e.ans != nil:
+			ans, seen = e.ans, true
+		case isClosed(e.done):
+			// previous request is not answered, then retransmission is handled
+			// and its answer is cached for next retransmission
+			done, seen = make(chan struct{}), true
+			e.done, e.cache = done, true
+			h.seen[k] = e
+		default:
+			// previous request is being handled
+			e.cache = true
+			h.seen[k] = e
+			n.duplicates <- h
+			<-e.done
+			continue
+		}
+
+		if ans != nil {
+			h.hits++
+		} else {
+			h.misses++
+		}
+		n.duplicates <- h
+		return
+	}
+}
+
+// finishDuplicate records end of handling the request,
+// and stores the answer if it is required for replaying to retransmitted request.
+func (n *Node) finishDuplicate(k dupKey, done chan struct{}, ans *Message) {
+	if done == nil {
+		return
+	}
+
+	h := <-n.duplicates
+	if e, ok := h.seen[k]; ok && e.done == done && e.cache && ans != nil {
+		e.ans = ans
+		h.seen[k] = e
+	}
+	close(done)
+	n.duplicates <- h
+}
+
+func isClosed(c chan struct{}) bool {
+	select {
+	case <-c:
+		return true
+	default:
+		return false
+	}
+}
+
+// Duplicated returns true if the request is retransmission of the request
+// with same End-to-End ID and Origin-Host that is received in DuplicateWindow before.
+func (m Message) Duplicated() bool {
+	return m.dup
+}
+
+// DuplicateHits returns count of duplicated request that is answered by cached answer.
+func (n *Node) DuplicateHits() uint64 {
+	h := <-n.duplicates
+	n.duplicates <- h
+	return h.hits
+}
+
+// DuplicateMisses returns count of request that is not answered by cached answer.
+func (n *Node) DuplicateMisses() uint64 {
+	h := <-n.duplicates
+	n.duplicates <- h
+	return h.misses
+}
+
+// DuplicateHits returns count of duplicated request that is answered by cached answer.
+func DuplicateHits() uint64 {
+	return DefaultNode().DuplicateHits()
+}
+
+// DuplicateMisses returns count of request that is not answered by cached answer.
+func DuplicateMisses() uint64 {
+	return DefaultNode().DuplicateMisses()
+}
@@ -0,0 +1,192 @@
+package diameter
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// dupPeers is test peers whose handler answers Result-Code with count of handled requests.
+type dupPeers struct {
+	*testPeers
+	calls atomic.Uint32
+	retry chan bool
+	block chan struct{}
+}
+
+// newDupPeers returns test peers whose handler waits for close of block if blocked.
+func newDupPeers(t *testing.T, window time.Duration, blocked bool) *dupPeers {
+	t.Helper()
+	d := &dupPeers{retry: make(chan bool, 10), block: make(chan struct{})}
+	if !blocked {
+		close(d.block)
+	}
+	d.testPeers = newTestPeers(t, func(retry bool, _ []AVP) (bool, []AVP) {
+		d.retry <- retry
+		<-d.block
+		return false, []AVP{
+			SetResultCode(2000 + d.calls.Add(1)),
+			SetOriginHost("b.local"), SetOriginRealm("local")}
+	}, func(_, b *Node) { b.DuplicateWindow = window })
+	return d
+}
+
+// send sends request with the End-to-End ID, and returns Result-Code of the answer.
+// It may be called from other goroutine, then error is reported without t.Fatal.
+func (d *dupPeers) send(t *testing.T, eteID uint32, retx bool) uint32 {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// request without Session-Id is handled by shared workers
+	m := Message{
+		FlgR: true, FlgP: true, FlgT: retx, Code: testCode, AppID: testAppID,
+		HbHID: nextHbH(), EtEID: eteID}
+	m.SetAVP([]AVP{
+		SetOriginHost(d.a.Host), SetOriginRealm(d.a.Realm),
+		SetDestinationRealm(d.b.Realm)})
+	a, err := d.ca.SendContext(ctx, m)
+	if err != nil {
+		t.Error(err)
+		return 0
+	}
+	if a.HbHID != m.HbHID || a.EtEID != eteID {
+		t.Errorf("answer ID is %d/%d, not %d/%d", a.HbHID, a.EtEID, m.HbHID, eteID)
+	}
+	avp, err := a.GetAVP()
+	if err != nil {
+		t.Error(err)
+		return 0
+	}
+	for _, v := range avp {
+		if v.Code == 268 && v.VendorID == 0 {
+			c, _ := GetResultCode(v)
+			return c
+		}
+	}
+	t.Error("Result-Code not found")
+	return 0
+}
+
+// handled returns retry flag of handled request, or fails if the request is not handled.
+func (d *dupPeers) handled(t *testing.T) bool {
+	t.Helper()
+	select {
+	case r := <-d.retry:
+		return r
+	default:
+		t.Fatal("request is not handled")
+	}
+	return false
+}
+
+func (d *dupPeers) notHandled(t *testing.T) {
+	t.Helper()
+	select {
+	case <-d.retry:
+		t.Error("duplicated request is handled")
+	default:
+	}
+}
+
+func (d *dupPeers) counts(t *testing.T, hits, misses uint64) {
+	t.Helper()
+	if h, m := d.b.DuplicateHits(), d.b.DuplicateMisses(); h != hits || m != misses {
+		t.Errorf("hits/misses is %d/%d, not %d/%d", h, m, hits, misses)
+	}
+}
+
+func TestDuplicateReplay(t *testing.T) {
+	d := newDupPeers(t, time.Second*5, false)
+	ete := nextEtE()
+
+	if c := d.send(t, ete, false); c != 2001 || d.handled(t) {
+		t.Errorf("answer of first request is %d, retry is true", c)
+	}
+	// request without T flag is not duplicated
+	if c := d.send(t, ete, false); c != 2002 || d.handled(t) {
+		t.Errorf("answer of same request is %d, retry is true", c)
+	}
+	d.counts(t, 0, 2)
+
+	// answer of the request that is not retransmitted is not cached
+	if c := d.send(t, ete, true); c != 2003 || !d.handled(t) {
+		t.Errorf("answer of retransmission is %d, retry is false", c)
+	}
+	d.counts(t, 0, 3)
+
+	// answer of retransmission is replayed
+	if c := d.send(t, ete, true); c != 2003 {
+		t.Errorf("answer of second retransmission is %d, not cached 2003", c)
+	}
+	d.notHandled(t)
+	d.counts(t, 1, 3)
+
+	// other End-to-End ID is not duplicated
+	if c := d.send(t, nextEtE(), true); c != 2004 || d.handled(t) {
+		t.Errorf("answer of other request is %d, retry is true", c)
+	}
+	d.counts(t, 1, 4)
+}
+
+func TestDuplicateInFlight(t *testing.T) {
+	d := newDupPeers(t, time.Second*5, true)
+	ete := nextEtE()
+
+	first := make(chan uint32, 1)
+	go func() { first <- d.send(t, ete, false) }()
+	select {
+	case <-d.retry:
+	case <-time.After(time.Second * 5):
+		t.Fatal("request is not handled")
+	}
+
+	second := make(chan uint32, 1)
+	go func() { second <- d.send(t, ete, true) }()
+	time.Sleep(time.Millisecond * 100)
+	d.notHandled(t)
+	close(d.block)
+
+	if c := <-first; c != 2001 {
+		t.Errorf("answer of first request is %d", c)
+	}
+	if c := <-second; c != 2001 {
+		t.Errorf("answer of retransmission is %d, not replayed 2001", c)
+	}
+	d.notHandled(t)
+	d.counts(t, 1, 1)
+}
+
+func TestDuplicateExpiry(t *testing.T) {
+	d := newDupPeers(t, time.Millisecond*100, false)
+	ete := nextEtE()
+
+	d.send(t, ete, false)
+	d.handled(t)
+	d.send(t, ete, true)
+	if !d.handled(t) {
+		t.Error("retransmission in window is not duplicated")
+	}
+	d.send(t, ete, true)
+	d.notHandled(t)
+
+	time.Sleep(time.Millisecond * 150)
+	d.send(t, ete, true)
+	if d.handled(t) {
+		t.Error("retransmission after window is duplicated")
+	}
+	d.counts(t, 1, 3)
+}
+
+func TestDuplicateDisabled(t *testing.T) {
+	d := newDupPeers(t, 0, false)
+	ete := nextEtE()
+
+	d.send(t, ete, false)
+	d.handled(t)
+	if c := d.send(t, ete, true); c != 2002 || !d.handled(t) {
+		t.Errorf("answer of retransmission is %d, retry is false", c)
+	}
+	d.counts(t, 0, 0)
+}
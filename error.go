@@ -54,16 +54,21 @@ func (e InvalidAVP) Error() string {
 	case AvpUnsupported:
 		return fmt.Sprintf("unsupported AVP with mandatory flag (code=%d)%v",
 			e.AVP.Code, err)
+	case AvpNotAllowed:
+		return fmt.Sprintf("AVP is not allowed (code=%d)%v",
+			e.AVP.Code, err)
 	}
 	return fmt.Sprintf("generic invalid AVP (code=%d)%v", e.AVP.Code, err)
 }
 
 // FailureAnswer is error response from peer.
+// Host is the host that reported the error, empty means local node.
 type FailureAnswer struct {
 	Code   uint32
 	VenID  uint32
 	ErrMsg string
 	Avps   []AVP
+	Host   Identity
 }
 
 func (e FailureAnswer) Error() string {
@@ -85,6 +90,43 @@ func (err RejectRxMessage) Error() string {
 		err.State, err.ErrMsg)
 }
 
+// RejectTxMessage is error of Tx request message that is not acceptable.
+type RejectTxMessage struct {
+	State  conState
+	ErrMsg string
+}
+
+func (err RejectTxMessage) Error() string {
+	return fmt.Sprintf("Tx message is rejected in state %s: %s",
+		err.State, err.ErrMsg)
+}
+
+// CanceledRequest is error of Tx request message that is canceled before receiving answer.
+type CanceledRequest struct {
+	HbHID uint32
+	err   error
+}
+
+func (err CanceledRequest) Error() string {
+	return fmt.Sprintf("request (Hop-by-Hop ID=%#x) is canceled: %s",
+		err.HbHID, err.err)
+}
+
+func (err CanceledRequest) Unwrap() error {
+	return err.err
+}
+
+// AbortedRequest is error of Tx request message that may be sent to peer
+// but connection is closed before receiving answer.
+type AbortedRequest struct {
+	HbHID uint32
+}
+
+func (err AbortedRequest) Error() string {
+	return fmt.Sprintf("request (Hop-by-Hop ID=%#x) is aborted by closing connection",
+		err.HbHID)
+}
+
 type TransportTxError struct {
 	err error
 }
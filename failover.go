@@ -0,0 +1,76 @@
+package diameter
+
+import (
+	"context"
+	"slices"
+)
+
+// Failover selects alternate connection for pending request
+// when the connection is closed before receiving answer (RFC 6733 section 5.5.4).
+// Inputs are the request and the closed connection, output nil means no failover.
+// It is called by the state machine of the closed connection.
+var Failover func(Message, *Connection) *Connection
+
+// sndRequest is request that is waiting for answer.
+// m is zero value for CER, DWR and DPR, that are not retransmitted.
+type sndRequest struct {
+	m  Message
+	ch chan Message
+}
+
+// retxRequest is alternate connection and Hop-by-Hop ID of retransmitted request.
+type retxRequest struct {
+	c     *Connection
+	hbhID uint32
+}
+
+// retransmit sends pending request to alternate connection with T flag
+// when the connection is closed. Answer is notified to original channel.
+func (c *Connection) retransmit(r sndRequest) bool {
+	n := c.local()
+	if !r.m.FlgR || n.Failover == nil {
+		return false
+	}
+	alt := n.Failover(r.m, c)
+	if alt == nil || alt == c || !alt.isOpen() {
+		return false
+	}
+
+	m := r.m
+	m.FlgT = true
+	m.HbHID = nextHbH()
+	if c.retxQueue == nil {
+		c.retxQueue = make(map[chan Message]retxRequest)
+	}
+	c.retxQueue[r.ch] = retxRequest{c: alt, hbhID: m.HbHID}
+	go func() {
+		if !alt.post(eventSndMsg{m, r.ch}) {
+			close(r.ch)
+		}
+	}()
+	return true
+}
+
+// sendFailover sends request and resends it to alternate connection
+// when the connection rejects the request.
+// Pending request on closed connection is retransmitted by the state machine.
+func (n *Node) sendFailover(ctx context.Context, c *Connection, m Message) (Message, error) {
+	tried := []*Connection{c}
+	for {
+		r, err := c.SendContext(ctx, m)
+		if n.Failover == nil {
+			return r, err
+		}
+		if _, ok := err.(RejectTxMessage); !ok {
+			return r, err
+		}
+
+		alt := n.Failover(m, c)
+		if alt == nil || slices.Contains(tried, alt) {
+			return r, err
+		}
+		tried = append(tried, alt)
+		c = alt
+		m.HbHID = nextHbH()
+	}
+}
@@ -0,0 +1,65 @@
+package diameter
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRetransmitOnPeerDisc(t *testing.T) {
+	c := NewNode("c.local", "local")
+	defer c.Close()
+	c.WDInterval = time.Second * 5
+	retry := make(chan bool, 1)
+	c.HandleContext(testCode, testAppID, 0, func(r bool, avp []AVP) (bool, []AVP) {
+		retry <- r
+		return false, []AVP{avp[0],
+			SetResultCode(Success),
+			SetOriginHost(c.Host), SetOriginRealm(c.Realm)}
+	}, nil)
+
+	recv := make(chan struct{})
+	block := make(chan struct{})
+	p := newTestPeers(t, func(_ bool, avp []AVP) (bool, []AVP) {
+		close(recv)
+		<-block
+		return false, nil
+	})
+	defer close(block)
+
+	alt := connectNode(t, p.a, c)
+	p.a.Failover = func(_ Message, failed *Connection) *Connection {
+		if failed == p.ca {
+			return alt
+		}
+		return nil
+	}
+
+	go func() {
+		// request is pending in b when the connection is lost
+		<-recv
+		if con := p.cb.snapshot().conn; con != nil {
+			con.Close()
+		}
+	}()
+
+	// forwarded request is not sent through sendFailover
+	req := Message{
+		FlgR: true, FlgP: true, Code: testCode, AppID: testAppID,
+		HbHID: nextHbH(), EtEID: nextEtE()}
+	req.SetAVP(p.request())
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	ans, err := p.ca.ForwardContext(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ans.HbHID != req.HbHID || ans.EtEID != req.EtEID {
+		t.Errorf("answer IDs %#x/%#x do not match request %#x/%#x",
+			ans.HbHID, ans.EtEID, req.HbHID, req.EtEID)
+	}
+	if !<-retry {
+		t.Error("retransmitted request does not have T flag")
+	}
+	p.wait(t)
+}
@@ -1,23 +1,64 @@
 package diameter
 
 import (
+	"context"
 	"errors"
+	"io"
+	"slices"
 	"time"
 )
 
 // Acceptable Application-ID and commands of the application.
 // Empty map indicate that accept any application.
-var applications = make(map[uint32]application)
+// The map is replaced with updated copy on every registration.
+var applications = make(chan map[uint32]application, 1)
+
+// Connections of peer that is opened or being opened, keyed by peer host.
+var peers = make(chan map[Identity]*Connection, 1)
+
+func init() {
+	applications <- make(map[uint32]application)
+	peers <- make(map[Identity]*Connection)
+}
 
 type application struct {
 	venID    uint32
+	acct     bool // accounting application
 	handlers map[uint32]Handler
 }
 
+// marshalApplications writes application IDs for CER/CEA.
+func marshalApplications(w io.Writer, apps map[uint32]application) {
+	vmap := make(map[uint32]interface{})
+	for aid, app := range apps {
+		if app.venID == 0 && app.acct {
+			SetAcctAppID(aid).MarshalTo(w)
+		} else if app.venID == 0 {
+			SetAuthAppID(aid).MarshalTo(w)
+		} else {
+			if _, ok := vmap[app.venID]; !ok {
+				setSupportedVendorID(app.venID).MarshalTo(w)
+				vmap[app.venID] = nil
+			}
+			if app.acct {
+				SetVendorSpecAcctAppID(app.venID, aid).MarshalTo(w)
+			} else {
+				SetVendorSpecAppID(app.venID, aid).MarshalTo(w)
+			}
+		}
+	}
+}
+
 // Handler handles Diameter message.
 // Inputs are Retry flag and AVPs of Request. Outputs are Error flag and AVPs of Answer.
+// Retry flag is true only for duplicated request when DuplicateWindow is enabled.
 type Handler func(bool, []AVP) (bool, []AVP)
 
+// ContextHandler sends Diameter request message with context.
+// Inputs are context, Retry flag and AVPs of Request.
+// Outputs are Error flag and AVPs of Answer, and error while sending request.
+type ContextHandler func(context.Context, bool, []AVP) (bool, []AVP, error)
+
 // Router select destination peer for specific message.
 type Router func(Message) *Connection
 
@@ -25,51 +66,157 @@ type Router func(Message) *Connection
 // Input Handler is called when when request is from peer.
 // Output Handler is used when send request to peer.
 func Handle(code, appID, venID uint32, h Handler, rt Router) Handler {
-	if _, ok := applications[appID]; !ok {
-		applications[appID] = application{
-			venID:    venID,
-			handlers: make(map[uint32]Handler)}
+	return withTimeout(
+		HandleContext(code, appID, venID, h, rt), defaultParams)
+}
+
+// HandleContext registers Diameter request handler for specified command.
+// Input Handler is called when when request is from peer.
+// Output ContextHandler is used when send request to peer,
+// and it returns error when no answer is received.
+func HandleContext(code, appID, venID uint32, h Handler, rt Router) ContextHandler {
+	return DefaultNode().HandleContext(code, appID, venID, h, rt)
+}
+
+// Handle registers Diameter request handler for specified command of the node.
+func (n *Node) Handle(code, appID, venID uint32, h Handler, rt Router) Handler {
+	return withTimeout(
+		n.HandleContext(code, appID, venID, h, rt), n.params)
+}
+
+// HandleContext registers Diameter request handler for specified command of the node.
+func (n *Node) HandleContext(code, appID, venID uint32, h Handler, rt Router) ContextHandler {
+	return n.register(code, appID, venID, false, h, rt)
+}
+
+// HandleAcct registers Diameter request handler for specified command of accounting application.
+// The application is advertised with Acct-Application-Id in CER/CEA.
+func HandleAcct(code, appID, venID uint32, h Handler, rt Router) Handler {
+	return withTimeout(
+		HandleAcctContext(code, appID, venID, h, rt), defaultParams)
+}
+
+// HandleAcctContext registers Diameter request handler for specified command of accounting application.
+func HandleAcctContext(code, appID, venID uint32, h Handler, rt Router) ContextHandler {
+	return DefaultNode().HandleAcctContext(code, appID, venID, h, rt)
+}
+
+// HandleAcct registers Diameter request handler for specified command of accounting application of the node.
+func (n *Node) HandleAcct(code, appID, venID uint32, h Handler, rt Router) Handler {
+	return withTimeout(
+		n.HandleAcctContext(code, appID, venID, h, rt), n.params)
+}
+
+// HandleAcctContext registers Diameter request handler for specified command of accounting application of the node.
+func (n *Node) HandleAcctContext(code, appID, venID uint32, h Handler, rt Router) ContextHandler {
+	return n.register(code, appID, venID, true, h, rt)
+}
+
+func (n *Node) register(code, appID, venID uint32, acct bool, h Handler, rt Router) ContextHandler {
+	apps := <-n.applications
+	napps := make(map[uint32]application, len(apps)+1)
+	for aid, app := range apps {
+		napps[aid] = app
 	}
-	applications[appID].handlers[code] = h
+	app, ok := napps[appID]
+	if !ok {
+		app = application{venID: venID, acct: acct}
+	}
+	handlers := make(map[uint32]Handler, len(app.handlers)+1)
+	for cid, f := range app.handlers {
+		handlers[cid] = f
+	}
+	handlers[code] = h
+	app.handlers = handlers
+	napps[appID] = app
+	n.applications <- napps
 
-	return func(r bool, avp []AVP) (bool, []AVP) {
+	return n.Sender(code, appID, rt)
+}
+
+// Sender returns ContextHandler that sends request of specified command by the Router.
+// Handler of the command is not registered, so that registered Handler is not changed.
+func Sender(code, appID uint32, rt Router) ContextHandler {
+	return DefaultNode().Sender(code, appID, rt)
+}
+
+// Sender returns ContextHandler that sends request of specified command of the node by the Router.
+func (n *Node) Sender(code, appID uint32, rt Router) ContextHandler {
+	return func(ctx context.Context, r bool, avp []AVP) (bool, []AVP, error) {
 		m := Message{
 			FlgR: true, FlgP: true, FlgE: false, FlgT: r,
 			Code: code, AppID: appID,
 			HbHID: nextHbH(), EtEID: nextEtE()}
 		m.SetAVP(avp)
 
-		var err error
-		if rt == nil {
-			err = errors.New("no route found")
-		} else if c := rt(m); c == nil {
-			err = errors.New("no route found")
-		} else {
-			m = c.send(m)
-			avp, err = m.GetAVP()
+		var c *Connection
+		if rt != nil {
+			c = rt(m)
+		}
+		if c == nil {
+			return true, nil, InvalidMessage{
+				Code: UnableToDeliver, ErrMsg: "no route found"}
 		}
 
+		m, err := n.sendContext(ctx, c, m)
+		if err == nil {
+			avp, err = m.GetAVP()
+		}
 		if err != nil {
-			return true, []AVP{
-				SetResultCode(UnableToDeliver),
-				SetOriginHost(Host),
-				SetOriginRealm(Realm)}
+			return true, nil, err
 		}
-		return m.FlgE, avp
+		return m.FlgE, avp, nil
 	}
 }
 
+// defaultParams returns parameters of default node for withTimeout.
+func defaultParams() (time.Duration, Identity, Identity) {
+	return WDInterval, Host, Realm
+}
+
+// params returns parameters of the node for withTimeout.
+func (n *Node) params() (time.Duration, Identity, Identity) {
+	return n.WDInterval, n.Host, n.Realm
+}
+
+// withTimeout makes Handler that waits answer until WDInterval.
+// params returns WDInterval, Host and Realm that are read on each call.
+func withTimeout(hc ContextHandler, params func() (time.Duration, Identity, Identity)) Handler {
+	return func(r bool, avp []AVP) (bool, []AVP) {
+		wd, host, realm := params()
+		ctx, cancel := context.WithTimeout(context.Background(), wd)
+		defer cancel()
+
+		e, avp, err := hc(ctx, r, avp)
+		if err == nil {
+			return e, avp
+		}
+		result := UnableToDeliver
+		if errors.Is(err, context.DeadlineExceeded) {
+			result = TooBusy
+		}
+		return true, []AVP{
+			SetResultCode(result),
+			SetOriginHost(host),
+			SetOriginRealm(realm)}
+	}
+}
+
+// ValidateRequest checks AVPs of received request before calling Handler.
+// Error answer with Failed-AVP is sent when InvalidAVP is returned.
+var ValidateRequest func(Message, []AVP) error
+
 // DefaultRxHandler for receiving Diameter request message without Handler or ralay application.
 var DefaultRxHandler func(Message) Message = func(m Message) Message {
 	return m.GenerateAnswerBy(UnableToDeliver)
 }
 
 // DefaultTxHandler for sending Diameter request message without Handler or relay application.
+// Redirect indication answer is followed when FollowRedirect of the node is enabled.
 func (c *Connection) DefaultTxHandler(m Message) Message {
-	if c.state != open {
+	if s := c.snapshot(); s.state != open {
 		return m.GenerateAnswerBy(UnableToDeliver)
-	}
-	if _, ok := c.commonApp[m.AppID]; !ok && len(c.commonApp) != 0 {
+	} else if !s.anyApp && !slices.Contains(s.apps, m.AppID) {
 		return m.GenerateAnswerBy(UnableToDeliver)
 	}
 
@@ -80,26 +227,82 @@ func (c *Connection) DefaultTxHandler(m Message) Message {
 	return c.send(m)
 }
 
+// ForwardContext sends request message that is received from other peer.
+// Hop-by-Hop ID is replaced with new value for the connection,
+// and original value is restored in the answer.
+func (c *Connection) ForwardContext(ctx context.Context, m Message) (Message, error) {
+	hbh := m.HbHID
+	m.HbHID = nextHbH()
+	m.FlgR = true
+	m.FlgE = false
+
+	r, err := c.SendContext(ctx, m)
+	r.HbHID = hbh
+	return r, err
+}
+
 func (c *Connection) send(m Message) Message {
-	if c.state != open {
-		return m.GenerateAnswerBy(UnableToDeliver)
+	n := c.local()
+	ctx, cancel := context.WithTimeout(context.Background(), n.WDInterval)
+	defer cancel()
+
+	r, err := n.sendContext(ctx, c, m)
+	if errors.Is(err, context.DeadlineExceeded) {
+		r = m.GenerateAnswerBy(TooBusy)
+	} else if err != nil {
+		r = m.GenerateAnswerBy(UnableToDeliver)
+	}
+	return r
+}
+
+// SendContext sends request message to peer and waits answer of the request.
+// Waiting is aborted with CanceledRequest error when ctx is done,
+// and the request is removed from Tx queue.
+// Pending request is retransmitted to alternate connection that is selected by Failover
+// when connection is closed before receiving answer,
+// and AbortedRequest error is returned when no alternate connection is available.
+// RejectTxMessage error is returned when transmit queue of the connection is full.
+func (c *Connection) SendContext(ctx context.Context, m Message) (Message, error) {
+	if s := c.snapshot(); s.state != open {
+		return m, RejectTxMessage{
+			State: s.state, ErrMsg: "connection is not open"}
 	}
 
-	ch := make(chan Message)
-	c.notify <- eventSndMsg{m, ch}
+	ch := make(chan Message, 1)
+	if !c.post(eventSndMsg{m, ch}) {
+		return m, RejectTxMessage{
+			State: closed, ErrMsg: "connection is not open"}
+	}
 
-	t := time.AfterFunc(WDInterval, func() {
-		c.notify <- eventRcvAns{m.GenerateAnswerBy(TooBusy)}
-	})
-	r, ok := <-ch
-	t.Stop()
+	var r Message
+	var ok bool
+	for rc, hbh := c, m.HbHID; ; {
+		select {
+		case r, ok = <-ch:
+		case <-rc.done:
+			// answer may be received before the connection is closed
+			select {
+			case r, ok = <-ch:
+			default:
+				// request may be retransmitted to alternate connection
+				if rt, moved := rc.retxQueue[ch]; moved {
+					rc, hbh = rt.c, rt.hbhID
+					continue
+				}
+			}
+		case <-ctx.Done():
+			rc.post(eventAbortMsg{hbh})
+			return m, CanceledRequest{HbHID: m.HbHID, err: ctx.Err()}
+		}
+		break
+	}
 
 	if !ok {
-		m = m.GenerateAnswerBy(UnableToDeliver)
-	} else if m.Code != r.Code || m.AppID != r.AppID || m.EtEID != r.EtEID {
-		m = m.GenerateAnswerBy(UnableToDeliver)
-	} else {
-		m = r
+		return m, AbortedRequest{HbHID: m.HbHID}
+	}
+	if m.Code != r.Code || m.AppID != r.AppID || m.EtEID != r.EtEID {
+		return r, InvalidMessage{
+			Code: UnableToComply, ErrMsg: "answer is not match with request"}
 	}
-	return m
+	return r, nil
 }
@@ -0,0 +1,26 @@
+package diameter
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSenderKeepsHandler(t *testing.T) {
+	p := newTestPeers(t, nil)
+	// request of same command is sent from b without changing Handler of b
+	p.b.Sender(testCode, testAppID, func(Message) *Connection { return p.cb })
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	e, _, err := p.send(ctx, false, p.request())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e {
+		t.Error("request is not handled by registered Handler")
+	}
+
+	p.ca.Close(Rebooting)
+	p.wait(t)
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"bytes"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -93,11 +92,8 @@ func getDestination(m diameter.Message) (peers []diameter.Identity) {
 	}
 
 	avps := []diameter.AVP{}
-	for rdr := bytes.NewReader(m.AVPs); rdr.Len() != 0; {
-		a := diameter.AVP{}
-		if e := a.UnmarshalFrom(rdr); e == nil {
-			avps = append(avps, a)
-		}
+	for it := diameter.NewAVPIterator(m.AVPs); it.Next(); {
+		avps = append(avps, it.AVP())
 	}
 	root, _ := dictionary.DecodeAVPs(avps)
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/fkgi/abnf"
 )
@@ -23,6 +24,11 @@ func (i Identity) String() string {
 	return string(i)
 }
 
+// less compares identities in case-insensitive, since DiameterIdentity is FQDN.
+func (i Identity) less(o Identity) bool {
+	return strings.ToLower(string(i)) < strings.ToLower(string(o))
+}
+
 // ParseIdentity parse Diamter identity form string
 func ParseIdentity(str string) (id Identity, err error) {
 	if len(str) == 0 {
@@ -51,10 +57,16 @@ func ParseURI(str string) (uri URI, err error) {
 	} else {
 		uri.Scheme = string(t.Child(idSCHEME).V)
 		uri.Fqdn = Identity(t.Child(idFQDN).V)
-		p, _ := strconv.ParseInt(string(t.Child(idPORT).V), 10, 32)
-		uri.Port = int(p)
-		uri.Transport = string(t.Child(idTRANSPORT).V)
-		uri.Protocol = string(t.Child(idPROTOCOL).V)
+		if c := t.Child(idPORT); c != nil {
+			p, _ := strconv.ParseInt(string(c.V), 10, 32)
+			uri.Port = int(p)
+		}
+		if c := t.Child(idTRANSPORT); c != nil {
+			uri.Transport = string(c.V)
+		}
+		if c := t.Child(idPROTOCOL); c != nil {
+			uri.Protocol = string(c.V)
+		}
 	}
 	return
 }
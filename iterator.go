@@ -0,0 +1,104 @@
+package diameter
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"sync"
+)
+
+/*
+AVPIterator reads AVPs from binary data without allocation.
+Data of the AVP refers the binary data, so it must not be modified.
+
+	it := diameter.NewAVPIterator(m.AVPs)
+	for it.Next() {
+		a := it.AVP()
+	}
+	if e := it.Err(); e != nil {
+	}
+*/
+type AVPIterator struct {
+	b   []byte
+	a   AVP
+	err error
+}
+
+// NewAVPIterator returns AVPIterator of the binary data.
+func NewAVPIterator(b []byte) AVPIterator {
+	return AVPIterator{b: b}
+}
+
+// Next reads next AVP. Output is false when no AVP remains or error is found.
+func (it *AVPIterator) Next() bool {
+	if it.err != nil || len(it.b) == 0 {
+		return false
+	}
+	if len(it.b) < 8 {
+		it.err = InvalidAVP{Code: InvalidAvpLength, AVP: AVP{}}
+		return false
+	}
+	hl := it.a.unmarshalHeader(it.b)
+	lng := int(binary.BigEndian.Uint32(it.b[4:8]) & 0x00ffffff)
+	if lng < hl || len(it.b) < lng {
+		it.a.Data = nil
+		it.err = InvalidAVP{Code: InvalidAvpLength, AVP: it.a}
+		return false
+	}
+	if hl == 12 {
+		it.a.VendorID = binary.BigEndian.Uint32(it.b[8:12])
+	}
+	it.a.Data = it.b[hl:lng:lng]
+
+	lng += (4 - lng%4) % 4
+	if lng > len(it.b) {
+		// padding of the last AVP may be omitted
+		lng = len(it.b)
+	}
+	it.b = it.b[lng:]
+	return true
+}
+
+// AVP returns current AVP.
+func (it *AVPIterator) AVP() AVP {
+	return it.a
+}
+
+// Err returns error that is found in iteration.
+// The error is InvalidAVP with InvalidAvpLength.
+func (it *AVPIterator) Err() error {
+	return it.err
+}
+
+// maxPooledBuffer is maximum size of buffer that is returned to pool.
+const maxPooledBuffer = 64 * 1024
+
+var bufferPool = sync.Pool{New: func() any {
+	b := make([]byte, 0, 4096)
+	return &b
+}}
+
+func getBuffer() *[]byte {
+	return bufferPool.Get().(*[]byte)
+}
+
+func putBuffer(b *[]byte) {
+	if cap(*b) <= maxPooledBuffer {
+		bufferPool.Put(b)
+	}
+}
+
+var readerPool = sync.Pool{New: func() any {
+	return bufio.NewReaderSize(nil, 8192)
+}}
+
+func getReader(r io.Reader) *bufio.Reader {
+	br := readerPool.Get().(*bufio.Reader)
+	br.Reset(r)
+	return br
+}
+
+func putReader(br *bufio.Reader) {
+	br.Reset(nil)
+	readerPool.Put(br)
+}
@@ -86,25 +86,30 @@ type Message struct {
 	PeerName  Identity // peer node that send this message
 	PeerRealm Identity
 
-	notify chan stateEvent // channel for sending answer of this message
+	rcv    *Connection // connection that receive this message and send answer
+	local  *Node       // local node that receive this message
+	dup    bool        // duplicated request is received before
+	parsed *avpCache   // cache of parsed AVPs
 }
 
 func (m *Message) SetAVP(avp []AVP) {
-	buf := new(bytes.Buffer)
+	var b []byte
 	for _, a := range avp {
-		a.MarshalTo(buf)
+		b = a.AppendTo(b)
 	}
-	m.AVPs = buf.Bytes()
+	m.AVPs = b
 }
 
+// GetAVP returns AVPs of the message.
+// Data of each AVP refers AVPs binary of the message.
 func (m *Message) GetAVP() ([]AVP, error) {
 	avp := make([]AVP, 0, avpBufferSize)
-	for rdr := bytes.NewReader(m.AVPs); rdr.Len() != 0; {
-		a := AVP{}
-		if e := a.wrapedUnmarshalFrom(rdr); e != nil {
-			return nil, e
-		}
-		avp = append(avp, a)
+	it := NewAVPIterator(m.AVPs)
+	for it.Next() {
+		avp = append(avp, it.AVP())
+	}
+	if e := it.Err(); e != nil {
+		return nil, e
 	}
 	return avp, nil
 }
@@ -119,24 +124,45 @@ func (m Message) String() string {
 	fmt.Fprintf(w, "\nHop-by-Hop-ID =%d", m.HbHID)
 	fmt.Fprintf(w, "\nEnd-to-End-ID =%d", m.EtEID)
 
-	for rdr := bytes.NewReader(m.AVPs); rdr.Len() != 0; {
-		a := AVP{}
-		if e := a.UnmarshalFrom(rdr); e != nil {
-			break
-		}
+	for it := NewAVPIterator(m.AVPs); it.Next(); {
+		a := it.AVP()
 		fmt.Fprintf(w, "\nAVP [%d]      =%x", a.Code, a.Data)
 	}
 
 	return w.String()
 }
 
+// GenerateAnswerBy returns answer for the request with the Result-Code.
+// E bit is set only for protocol error.
 func (m Message) GenerateAnswerBy(result uint32) Message {
+	return m.generateAnswer(0, result, nil)
+}
+
+// GenerateAnswerByExperimental returns answer for the request with the Experimental-Result.
+// E bit is set only for protocol error.
+func (m Message) GenerateAnswerByExperimental(venID, result uint32) Message {
+	return m.generateAnswer(venID, result, nil)
+}
+
+/*
+GenerateAnswerByError returns error answer for the request with the error.
+Result-Code is taken from InvalidAVP or InvalidMessage, and UnableToComply is used for other error.
+Experimental-Result is used for FailureAnswer with VenID.
+Error-Message and Failed-AVP with the offending AVP are added.
+Error-Reporting-Host is added only for FailureAnswer that is reported by other host,
+because Origin-Host is the reporting host for error of local node (RFC 6733 section 7.3).
+E bit is set only for protocol error.
+*/
+func (m Message) GenerateAnswerByError(err error) Message {
+	venID, result := errorResultCode(err)
+	return m.generateAnswer(venID, result, err)
+}
+
+func (m Message) generateAnswer(venID, result uint32, err error) Message {
 	buf := new(bytes.Buffer)
-	for rdr := bytes.NewReader(m.AVPs); rdr.Len() != 0; {
-		a := AVP{}
-		if e := a.UnmarshalFrom(rdr); e != nil {
-			continue
-		}
+	pinfo := []AVP{}
+	for it := NewAVPIterator(m.AVPs); it.Next(); {
+		a := it.AVP()
 		if a.VendorID != 0 {
 			continue
 		}
@@ -145,27 +171,94 @@ func (m Message) GenerateAnswerBy(result uint32) Message {
 			a.MarshalTo(buf)
 		case 263:
 			a.MarshalTo(buf)
+		case 284:
+			pinfo = append(pinfo, a)
 		}
 	}
-	SetResultCode(result).MarshalTo(buf)
-	SetOriginHost(Host).MarshalTo(buf)
-	SetOriginRealm(Realm).MarshalTo(buf)
+	if venID != 0 {
+		SetExperimentalResult(venID, result).MarshalTo(buf)
+	} else {
+		SetResultCode(result).MarshalTo(buf)
+	}
+	host, realm := Host, Realm
+	if m.local != nil {
+		host, realm = m.local.Host, m.local.Realm
+	}
+	SetOriginHost(host).MarshalTo(buf)
+	SetOriginRealm(realm).MarshalTo(buf)
+	if fa, ok := err.(FailureAnswer); ok && fa.Host != "" && fa.Host != host {
+		SetErrorReportingHost(fa.Host).MarshalTo(buf)
+	}
+	if err != nil {
+		marshalErrorTo(buf, err)
+	}
+	// Proxy-Info in request must be added to answer in same order
+	for _, a := range pinfo {
+		a.MarshalTo(buf)
+	}
 
 	return Message{
-		FlgR: false, FlgP: m.FlgP, FlgE: true, FlgT: false,
+		FlgR: false, FlgP: m.FlgP, FlgE: IsProtocolError(result), FlgT: false,
 		Code: m.Code, AppID: m.AppID,
 		HbHID: m.HbHID, EtEID: m.EtEID,
-		AVPs: buf.Bytes()}
+		AVPs: buf.Bytes(), local: m.local}
+}
+
+// errorResultCode returns Vendor-Id and result code for the error.
+func errorResultCode(err error) (uint32, uint32) {
+	switch e := err.(type) {
+	case InvalidAVP:
+		return 0, e.Code
+	case InvalidMessage:
+		return 0, e.Code
+	case FailureAnswer:
+		return e.VenID, e.Code
+	}
+	return 0, UnableToComply
+}
+
+// IsProtocolError returns true for Result-Code of protocol error that requires E bit.
+func IsProtocolError(result uint32) bool {
+	return result/1000 == 3
+}
+
+// marshalErrorTo writes Error-Message and Failed-AVP of the error.
+func marshalErrorTo(w io.Writer, err error) {
+	msg := err.Error()
+	var failed []AVP
+	switch e := err.(type) {
+	case InvalidAVP:
+		failed = []AVP{e.AVP}
+	case FailureAnswer:
+		if e.ErrMsg != "" {
+			msg = e.ErrMsg
+		}
+		failed = e.Avps
+	}
+	SetErrorMessage(msg).MarshalTo(w)
+	if len(failed) != 0 {
+		setFailedAVP(failed).MarshalTo(w)
+	}
 }
 
 // MarshalTo write binary data to io.Writer
 func (m Message) MarshalTo(w io.Writer) error {
-	var b, buf bytes.Buffer
+	p := getBuffer()
+	*p = m.AppendTo((*p)[:0])
+	_, err := w.Write(*p)
+	putBuffer(p)
+	return err
+}
 
-	b.WriteByte(1)
+// AppendTo appends binary data of the message to b and returns the extended buffer.
+func (m Message) AppendTo(b []byte) []byte {
+	return append(m.appendHeader(b), m.AVPs...)
+}
 
-	binary.Write(&buf, binary.BigEndian, uint32(20+len(m.AVPs)))
-	b.Write(buf.Bytes()[1:4])
+// appendHeader appends 20 bytes header of the message to b.
+func (m Message) appendHeader(b []byte) []byte {
+	lng := 20 + len(m.AVPs)
+	b = append(b, 1, byte(lng>>16), byte(lng>>8), byte(lng))
 
 	var flags byte
 	if m.FlgR {
@@ -180,67 +273,44 @@ func (m Message) MarshalTo(w io.Writer) error {
 	if m.FlgT {
 		flags |= 0x10
 	}
-	b.WriteByte(flags)
-
-	buf.Reset()
-	binary.Write(&buf, binary.BigEndian, m.Code)
-	b.Write(buf.Bytes()[1:4])
-
-	binary.Write(&b, binary.BigEndian, m.AppID)
-	binary.Write(&b, binary.BigEndian, m.HbHID)
-	binary.Write(&b, binary.BigEndian, m.EtEID)
-
-	b.Write(m.AVPs)
+	b = append(b, flags, byte(m.Code>>16), byte(m.Code>>8), byte(m.Code))
 
-	_, err := b.WriteTo(w)
-	return err
+	b = binary.BigEndian.AppendUint32(b, m.AppID)
+	b = binary.BigEndian.AppendUint32(b, m.HbHID)
+	return binary.BigEndian.AppendUint32(b, m.EtEID)
 }
 
 // UnmarshalFrom read binary data from io.Reader
 func (m *Message) UnmarshalFrom(r io.Reader) error {
-	b, err := readUntil(r, 20)
-	if err != nil {
+	var h [20]byte
+	if _, err := io.ReadFull(r, h[:]); err != nil {
 		return err
 	}
-	if b[0] != 1 {
+	if h[0] != 1 {
 		return InvalidMessage{
 			Code: UnsupportedVersion}
 	}
+	lng := int(binary.BigEndian.Uint32(h[0:4]) & 0x00ffffff)
+	if lng < 20 {
+		return InvalidMessage{
+			Code: InvalidMessageLength}
+	}
 
-	b[0] = 0x00
-	var lng uint32
-	binary.Read(bytes.NewBuffer(b[0:4]), binary.BigEndian, &lng)
-
-	m.FlgR = b[4]&0x80 == 0x80
-	m.FlgP = b[4]&0x40 == 0x40
-	m.FlgE = b[4]&0x20 == 0x20
-	m.FlgT = b[4]&0x10 == 0x10
-
-	b[4] = 0x00
-	binary.Read(bytes.NewBuffer(b[4:8]), binary.BigEndian, &m.Code)
+	m.FlgR = h[4]&0x80 == 0x80
+	m.FlgP = h[4]&0x40 == 0x40
+	m.FlgE = h[4]&0x20 == 0x20
+	m.FlgT = h[4]&0x10 == 0x10
 
-	binary.Read(bytes.NewBuffer(b[8:12]), binary.BigEndian, &m.AppID)
-	binary.Read(bytes.NewBuffer(b[12:16]), binary.BigEndian, &m.HbHID)
-	binary.Read(bytes.NewBuffer(b[16:20]), binary.BigEndian, &m.EtEID)
+	m.Code = binary.BigEndian.Uint32(h[4:8]) & 0x00ffffff
+	m.AppID = binary.BigEndian.Uint32(h[8:12])
+	m.HbHID = binary.BigEndian.Uint32(h[12:16])
+	m.EtEID = binary.BigEndian.Uint32(h[16:20])
 
-	if m.AVPs, err = readUntil(r, int(lng)-20); err != nil {
+	m.AVPs = make([]byte, lng-20)
+	if _, err := io.ReadFull(r, m.AVPs); err != nil {
 		return InvalidMessage{
 			Code: InvalidMessageLength}
 	}
 
 	return nil
 }
-
-func readUntil(r io.Reader, l int) ([]byte, error) {
-	buf := make([]byte, l)
-	offset := 0
-
-	for offset < l {
-		i, err := r.Read(buf[offset:])
-		offset += i
-		if err != nil {
-			return buf, err
-		}
-	}
-	return buf, nil
-}
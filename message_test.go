@@ -0,0 +1,156 @@
+package diameter
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func errorReportingHost(t *testing.T, m Message) (Identity, int) {
+	t.Helper()
+	avps, err := m.GetAVP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var h Identity
+	n := 0
+	for _, a := range avps {
+		if a.VendorID == 0 && a.Code == 294 {
+			h, _ = GetErrorReportingHost(a)
+			n++
+		}
+	}
+	return h, n
+}
+
+func TestErrorReportingHost(t *testing.T) {
+	local := NewNode("a.local", "local")
+	defer local.Close()
+	req := Message{
+		FlgR: true, FlgP: true, Code: testCode, AppID: testAppID,
+		HbHID: nextHbH(), EtEID: nextEtE(), local: local}
+	req.SetAVP([]AVP{SetSessionID("a.local;1;2")})
+
+	for _, err := range []error{
+		InvalidAVP{Code: InvalidAvpValue, AVP: SetSessionID("")},
+		InvalidMessage{Code: UnableToDeliver, ErrMsg: "no route"},
+		FailureAnswer{Code: UnableToComply, Host: local.Host},
+	} {
+		if _, n := errorReportingHost(t, req.GenerateAnswerByError(err)); n != 0 {
+			t.Errorf("Error-Reporting-Host is added for local error %v", err)
+		}
+	}
+
+	ans := req.GenerateAnswerByError(FailureAnswer{Code: UnableToComply, Host: "c.local"})
+	if h, n := errorReportingHost(t, ans); n != 1 || h != "c.local" {
+		t.Errorf("Error-Reporting-Host is %s (%d AVPs), not c.local", h, n)
+	}
+
+	avps := []AVP{
+		SetSessionID("a.local;1;2"), SetResultCode(UnableToComply),
+		SetOriginHost("c.local"), SetOriginRealm("local")}
+	if fa, ok := FailureOf(avps).(FailureAnswer); !ok || fa.Host != "c.local" {
+		t.Errorf("reporting host of failure answer is not Origin-Host: %v", fa.Host)
+	}
+}
+
+func benchMessage() Message {
+	m := Message{
+		FlgR: true, FlgP: true, Code: testCode, AppID: testAppID,
+		HbHID: 1, EtEID: 1}
+	m.SetAVP([]AVP{
+		SetSessionID("a.local;1234567890;1"),
+		SetOriginHost("a.local"), SetOriginRealm("local"),
+		SetDestinationRealm("local"), SetDestinationHost("b.local"),
+		SetAuthAppID(testAppID), SetAuthSessionState(false),
+		SetUserName("user@local"),
+		SetRouteRecord("r1.local"), SetRouteRecord("r2.local")})
+	return m
+}
+
+func BenchmarkAVPIterator(b *testing.B) {
+	m := benchMessage()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for it := NewAVPIterator(m.AVPs); it.Next(); {
+			_ = it.AVP()
+		}
+	}
+}
+
+func BenchmarkGetAVP(b *testing.B) {
+	m := benchMessage()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, e := m.GetAVP(); e != nil {
+			b.Fatal(e)
+		}
+	}
+}
+
+func BenchmarkAppendTo(b *testing.B) {
+	m := benchMessage()
+	buf := make([]byte, 0, 1024)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = m.AppendTo(buf[:0])
+	}
+}
+
+func BenchmarkMarshalTo(b *testing.B) {
+	m := benchMessage()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if e := m.MarshalTo(io.Discard); e != nil {
+			b.Fatal(e)
+		}
+	}
+}
+
+// benchReceived returns binary of benchMessage as received from peer.
+func benchReceived(b *testing.B) []byte {
+	buf := new(bytes.Buffer)
+	if e := benchMessage().MarshalTo(buf); e != nil {
+		b.Fatal(e)
+	}
+	return buf.Bytes()
+}
+
+func BenchmarkUnmarshalFrom(b *testing.B) {
+	data := benchReceived(b)
+	r := bytes.NewReader(data)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Reset(data)
+		var m Message
+		if e := m.UnmarshalFrom(r); e != nil {
+			b.Fatal(e)
+		}
+	}
+}
+
+func BenchmarkReceiveGetAVP(b *testing.B) {
+	data := benchReceived(b)
+	r := bytes.NewReader(data)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Reset(data)
+		var m Message
+		if e := m.UnmarshalFrom(r); e != nil {
+			b.Fatal(e)
+		}
+		avps, e := m.GetAVP()
+		if e != nil {
+			b.Fatal(e)
+		}
+		if _, e = GetSessionID(avps[0]); e != nil {
+			b.Fatal(e)
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/fkgi/diameter"
 	"github.com/fkgi/diameter/connector"
+	"github.com/fkgi/diameter/routing"
 )
 
 var upLink diameter.Identity
@@ -23,9 +24,12 @@ func main() {
 	if err != nil {
 		hostname = "multiplexer.internal"
 	}
-	dlocal := flag.String("l", hostname, "Diameter local host. `[(tcp|sctp)://][realm/]hostname[:port]`")
+	dlocal := flag.String("l", hostname, "Diameter local host. `[(tcp|sctp|tls|aaas)://][realm/]hostname[:port]`")
 	hlocal := flag.String("i", ":12001", "HTTP local interface address. `[host]:port`")
 	to := flag.Int("t", int(diameter.WDInterval/time.Second), "Message timeout timer [s]")
+	cert := flag.String("cert", "", "TLS certificate `file` for tls or aaas transport")
+	key := flag.String("key", "", "TLS private key `file` for tls or aaas transport")
+	ca := flag.String("ca", "", "TLS CA certificate `file` for verifying peer")
 	help := flag.Bool("h", false, "Print usage")
 	flag.Parse()
 
@@ -51,6 +55,11 @@ func main() {
 	log.Printf("[INFO] booting multiplexer for Round-Robin <%s REV.%d>...",
 		diameter.ProductName, diameter.FirmwareRev)
 	log.Printf("[INFO] uplink peer hostname is %s", upLink)
+	upRoute.Add(routing.Entry{
+		AppID: routing.AnyApplication, Action: routing.Relay,
+		Peers: []diameter.Identity{upLink}})
+	downRoute.Add(routing.Entry{
+		AppID: routing.AnyApplication, Action: routing.Relay})
 
 	http.HandleFunc("/diastate/v1/connection", conStateHandler)
 	log.Println("[INFO] listening HTTP local port:", *hlocal)
@@ -64,6 +73,12 @@ func main() {
 
 	diameter.DefaultRxHandler = rxhandler
 
+	if *cert != "" || *ca != "" {
+		if connector.TLSConfig, err = connector.LoadTLSConfig(*cert, *key, *ca); err != nil {
+			log.Fatalln("[ERROR]", "failed to load TLS certificate:", err)
+		}
+	}
+
 	log.Println("[INFO]", "listening Diameter...")
 	var l net.Listener
 	l, err = connector.Listen(*dlocal)
@@ -13,7 +13,7 @@ func init() {
 	diameter.ConnectionUpNotify = func(c *diameter.Connection) {
 		buf := new(strings.Builder)
 		fmt.Fprintln(buf, "diameter connection up")
-		fmt.Fprintln(buf, "| peer host/realm:", c.Host, "/", c.Realm)
+		fmt.Fprintln(buf, "| peer host/realm:", c.PeerHost(), "/", c.PeerRealm())
 		fmt.Fprintf(buf, "| applications:    %d\n", c.AvailableApplications())
 		log.Print("[INFO] ", buf)
 	}
@@ -23,10 +23,17 @@ func init() {
 		}
 		buf := new(strings.Builder)
 		fmt.Fprintln(buf, "diameter connection down")
-		fmt.Fprintln(buf, "| peer host/realm:", c.Host, "/", c.Realm)
+		fmt.Fprintln(buf, "| peer host/realm:", c.PeerHost(), "/", c.PeerRealm())
 		fmt.Fprintf(buf, "| reason:          %v\n", e)
 		log.Print("[INFO] ", buf)
 	}
+	diameter.TransportEventNotify = func(c *diameter.Connection, ev diameter.TransportEvent) {
+		level := "[INFO]"
+		if ev.Failure() {
+			level = "[WARN]"
+		}
+		log.Printf("%s transport event on connection with %s: %s", level, c.PeerHost(), ev)
+	}
 	diameter.TraceEvent = func(old, new, event string, err error) {
 		if old != new || err != nil {
 			log.Printf("[INFO] diameter state update: %s->%s by event %s: error=%v",
@@ -22,12 +22,15 @@ func conStateHandler(w http.ResponseWriter, r *http.Request) {
 
 	stats := []constat{}
 	for _, c := range refConnection() {
-		stats = append(stats, constat{
-			Host:  c.Host.String(),
-			Realm: c.Realm.String(),
-			Addr:  c.PeerAddr().String(),
+		stat := constat{
+			Host:  c.PeerHost().String(),
+			Realm: c.PeerRealm().String(),
 			State: c.State(),
-			Apps:  c.AvailableApplications()})
+			Apps:  c.AvailableApplications()}
+		if addr := c.PeerAddr(); addr != nil {
+			stat.Addr = addr.String()
+		}
+		stats = append(stats, stat)
 	}
 	if b, e := json.Marshal(stats); e != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -0,0 +1,223 @@
+package diameter
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+)
+
+// Node is local Diameter node.
+// It owns identity, application registry, handlers, hooks, timers and worker pool,
+// so that multiple Diameter nodes can run in one process.
+type Node struct {
+	Host    Identity // Local diameter hostname
+	Realm   Identity // Local diameter realm
+	StateID uint32   // Local diameter state ID
+
+	WDInterval time.Duration // WDInterval is watchdog send interval time
+	WDMaxSend  int           // WDMaxSend is watchdog expired count
+
+	OverwriteAddr []net.IP // Overwrite IP addresses of local host in CER
+
+	// TLSConfig enables in-band TLS negotiation with Inband-Security-Id.
+	TLSConfig *tls.Config
+	// AcceptAnonymousPeer accepts TLS peer that presents no certificate.
+	AcceptAnonymousPeer bool
+
+	// FollowRedirect enables resending request to Redirect-Host.
+	FollowRedirect bool
+	// ConnectRedirect returns connection of Redirect-Host, and connects to the host if it is not connected.
+	ConnectRedirect func(context.Context, URI) *Connection
+	// Failover selects alternate connection for pending request.
+	Failover func(Message, *Connection) *Connection
+	// DuplicateWindow is period to keep received request for detecting retransmitted request.
+	DuplicateWindow time.Duration
+
+	// DefaultRxHandler for receiving Diameter request message without Handler.
+	DefaultRxHandler func(Message) Message
+	// ValidateRequest checks AVPs of received request before calling Handler.
+	ValidateRequest func(Message, []AVP) error
+
+	// TraceMessage is called when Diameter message is receved or sent.
+	TraceMessage func(Message, Direction, error)
+	// TraceEvent is called on event.
+	TraceEvent func(string, string, string, error)
+	// ConnectionUpNotify is called when Diameter connection up.
+	ConnectionUpNotify func(*Connection)
+	// ConnectionDownNotify is called when Diameter connection down.
+	ConnectionDownNotify func(*Connection, error)
+	// TransportEventNotify is called on event of transport connection.
+	TransportEventNotify func(*Connection, TransportEvent)
+
+	applications  chan map[uint32]application
+	peers         chan map[Identity]*Connection
+	redirects     chan map[redirectKey]redirectEntry
+	duplicates    chan dupHistory
+	sharedQ       chan Message
+	activeWorkers chan int
+	lanes         chan []chan Message
+	stopped       bool // worker pool is stopped, guarded by lanes
+}
+
+// NewNode returns Node with default timer parameters and starts its worker pool.
+func NewNode(host, realm Identity) *Node {
+	n := &Node{
+		Host:       host,
+		Realm:      realm,
+		StateID:    uint32(time.Now().Unix()),
+		WDInterval: time.Second * 30,
+		WDMaxSend:  3,
+		DefaultRxHandler: func(m Message) Message {
+			return m.GenerateAnswerBy(UnableToDeliver)
+		},
+		applications:  make(chan map[uint32]application, 1),
+		peers:         make(chan map[Identity]*Connection, 1),
+		redirects:     make(chan map[redirectKey]redirectEntry, 1),
+		duplicates:    make(chan dupHistory, 1),
+		sharedQ:       make(chan Message, maxWorkers),
+		activeWorkers: make(chan int, 1),
+		lanes:         make(chan []chan Message, 1)}
+	n.applications <- make(map[uint32]application)
+	n.peers <- make(map[Identity]*Connection)
+	n.redirects <- make(map[redirectKey]redirectEntry)
+	n.duplicates <- dupHistory{seen: make(map[dupKey]dupEntry)}
+	n.activeWorkers <- 0
+	n.startWorkers()
+	return n
+}
+
+// DefaultNode returns Node that refers package level parameters.
+// Returned value is snapshot of parameters, so update of the Node fields
+// does not affect package level parameters.
+// Application registry and worker pool are shared with package level functions.
+func DefaultNode() *Node {
+	return &Node{
+		Host:                 Host,
+		Realm:                Realm,
+		StateID:              stateID,
+		WDInterval:           WDInterval,
+		WDMaxSend:            WDMaxSend,
+		OverwriteAddr:        OverwriteAddr,
+		TLSConfig:            TLSConfig,
+		AcceptAnonymousPeer:  AcceptAnonymousPeer,
+		FollowRedirect:       FollowRedirect,
+		ConnectRedirect:      ConnectRedirect,
+		Failover:             Failover,
+		DuplicateWindow:      DuplicateWindow,
+		DefaultRxHandler:     DefaultRxHandler,
+		ValidateRequest:      ValidateRequest,
+		TraceMessage:         TraceMessage,
+		TraceEvent:           TraceEvent,
+		ConnectionUpNotify:   ConnectionUpNotify,
+		ConnectionDownNotify: ConnectionDownNotify,
+		TransportEventNotify: TransportEventNotify,
+		applications:         applications,
+		peers:                peers,
+		redirects:            redirects,
+		duplicates:           duplicates,
+		sharedQ:              sharedQ,
+		activeWorkers:        activeWorkers,
+		lanes:                lanes}
+}
+
+// bind makes snapshot of default node for the connection without Local,
+// before the connection is started. The snapshot is made only once.
+func (c *Connection) bind() {
+	if c.Local == nil && c.node.Load() == nil {
+		c.node.CompareAndSwap(nil, DefaultNode())
+	}
+}
+
+func (c *Connection) local() *Node {
+	if c.Local != nil {
+		return c.Local
+	} else if n := c.node.Load(); n != nil {
+		return n
+	}
+	// connection is not started
+	return DefaultNode()
+}
+
+func (n *Node) loadApplications() map[uint32]application {
+	apps := <-n.applications
+	n.applications <- apps
+	return apps
+}
+
+// LookupPeer returns connection of the peer host.
+func (n *Node) LookupPeer(host Identity) *Connection {
+	ps := <-n.peers
+	n.peers <- ps
+	return ps[host]
+}
+
+// Peers returns all connections that is registered in the node.
+func (n *Node) Peers() []*Connection {
+	ps := <-n.peers
+	n.peers <- ps
+	r := make([]*Connection, 0, len(ps))
+	for _, c := range ps {
+		r = append(r, c)
+	}
+	return r
+}
+
+func (n *Node) setPeer(host Identity, c *Connection) {
+	ps := <-n.peers
+	nps := make(map[Identity]*Connection, len(ps)+1)
+	for k, v := range ps {
+		nps[k] = v
+	}
+	nps[host] = c
+	n.peers <- nps
+}
+
+func (n *Node) delPeer(host Identity, c *Connection) {
+	ps := <-n.peers
+	if ps[host] != c {
+		n.peers <- ps
+		return
+	}
+	nps := make(map[Identity]*Connection, len(ps))
+	for k, v := range ps {
+		if k != host {
+			nps[k] = v
+		}
+	}
+	n.peers <- nps
+}
+
+// isOpen returns true when the connection can handle application message.
+func (c *Connection) isOpen() bool {
+	s := c.snapshot().state
+	return s == open || s == locked
+}
+
+// NextSession generate new session ID data of the node
+func (n *Node) NextSession() string {
+	return NextSession(n.Host.String())
+}
+
+// SharedMessagegQueue return lengh of shared queue for recieved stateless message handling.
+func (n *Node) SharedMessagegQueue() int {
+	return len(n.sharedQ)
+}
+
+// ActiveSharedWorkers return count of active worker for recieved stateless message handling.
+func (n *Node) ActiveSharedWorkers() int {
+	a := <-n.activeWorkers
+	n.activeWorkers <- a
+	return a
+}
+
+// DispatchLaneQueues return lengh of each worker lane queue for recieved message with Session-Id.
+func (n *Node) DispatchLaneQueues() []int {
+	ls := <-n.lanes
+	n.lanes <- ls
+	r := make([]int, len(ls))
+	for i, q := range ls {
+		r[i] = len(q)
+	}
+	return r
+}
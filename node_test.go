@@ -0,0 +1,60 @@
+package diameter
+
+import (
+	"io"
+	"net"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestNodeClose(t *testing.T) {
+	before := runtime.NumGoroutine()
+	n := NewNode("a.local", "local")
+	if runtime.NumGoroutine() <= before {
+		t.Fatal("worker pool is not started")
+	}
+	n.Close()
+	n.Close()
+
+	for i := 0; i < 100 && runtime.NumGoroutine() > before; i++ {
+		time.Sleep(time.Millisecond * 10)
+	}
+	if g := runtime.NumGoroutine(); g > before {
+		t.Errorf("%d worker goroutines remain after Close", g-before)
+	}
+	if n.dispatch(Message{FlgR: true}) {
+		t.Error("request is dispatched after Close")
+	}
+	n.SetDispatchLanes(4)
+	if l := len(n.DispatchLaneQueues()); l != 0 {
+		t.Errorf("%d lanes are started after Close", l)
+	}
+}
+
+func TestDefaultNodeSnapshot(t *testing.T) {
+	l, r := net.Pipe()
+	go io.Copy(io.Discard, r)
+	c := &Connection{}
+	done := make(chan error, 1)
+	read := make(chan struct{})
+	go func() {
+		// local is read while the connection is started
+		defer close(read)
+		for i := 0; i < 100; i++ {
+			_ = c.local().WDInterval
+		}
+	}()
+	go func() { done <- c.DialAndServe(l) }()
+	waitState(t, c, "waitCEA")
+	<-read
+
+	if c.local() != c.local() {
+		t.Error("default node is not cached for the connection")
+	}
+	if a := testing.AllocsPerRun(100, func() { c.local() }); a != 0 {
+		t.Errorf("local allocates %.0f times", a)
+	}
+	r.Close()
+	<-done
+}
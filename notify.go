@@ -1,6 +1,8 @@
 package diameter
 
 import (
+	"crypto/tls"
+	"fmt"
 	"net"
 )
 
@@ -34,52 +36,129 @@ var (
 
 	// ConnectionDownNotify is called when Diameter connection down.
 	ConnectionDownNotify func(*Connection, error)
+
+	// TransportEventNotify is called on event of transport connection,
+	// such as path failure of multihomed SCTP association.
+	// Input event is sctp.Event for SCTP.
+	TransportEventNotify func(*Connection, TransportEvent)
 )
 
-// RxQueue returns length of Rx queue
+// TransportEvent is event notification of transport connection.
+// Failure is true for event that indicates failure of the transport,
+// such as unreachable peer address, and false for informational event, such as COMM_UP.
+type TransportEvent interface {
+	fmt.Stringer
+	Failure() bool
+}
+
+// eventConn is transport connection that notifies its events.
+type eventConn interface {
+	SetEventNotify(func(fmt.Stringer))
+}
+
+// unknownEvent is event without severity, that is handled as failure.
+type unknownEvent struct {
+	fmt.Stringer
+}
+
+func (unknownEvent) Failure() bool {
+	return true
+}
+
+// RxQueue returns count of received requests that are waiting answer from handler
 func (c *Connection) RxQueue() int {
-	return len(c.rcvQueue)
+	return int(c.rxPending.Load())
 }
 
 // TxQueue returns length of Tx queue
 func (c *Connection) TxQueue() int {
-	return len(c.sndQueue)
+	return c.snapshot().txQueue
+}
+
+// WriteQueue returns count of messages that are waiting to be written to transport
+func (c *Connection) WriteQueue() int {
+	return int(c.wLen.Load())
+}
+
+// TLSState returns TLS state of transport connection.
+// nil is returned when the connection is not TLS.
+func (c *Connection) TLSState() *tls.ConnectionState {
+	if tc, ok := c.snapshot().conn.(*tls.Conn); ok {
+		cs := tc.ConnectionState()
+		return &cs
+	}
+	return nil
 }
 
 // LocalAddr returns transport connection of state machine
 func (c *Connection) LocalAddr() net.Addr {
-	return c.conn.LocalAddr()
+	if con := c.snapshot().conn; con != nil {
+		return con.LocalAddr()
+	}
+	return nil
 }
 
 // PeerAddr returns transport connection of state machine
 func (c *Connection) PeerAddr() net.Addr {
-	return c.conn.RemoteAddr()
+	if con := c.snapshot().conn; con != nil {
+		return con.RemoteAddr()
+	}
+	return nil
+}
+
+// PeerHost returns diameter hostname of the peer
+func (c *Connection) PeerHost() Identity {
+	return c.snapshot().host
+}
+
+// PeerRealm returns diameter realm of the peer
+func (c *Connection) PeerRealm() Identity {
+	return c.snapshot().realm
 }
 
 // State returns state machine state
 func (c *Connection) State() string {
-	return c.state.String()
+	return c.snapshot().state.String()
+}
+
+// Opened returns channel that is closed when the connection is opened.
+func (c *Connection) Opened() <-chan struct{} {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.opened == nil {
+		c.opened = make(chan struct{})
+		if c.status.state == open {
+			close(c.opened)
+		}
+	}
+	return c.opened
 }
 
 // AvailableApplications returns supported application list
 func (c *Connection) AvailableApplications() []uint32 {
 	ret := []uint32{}
-	if c.state == open {
-		for k := range c.commonApp {
-			ret = append(ret, k)
-		}
+	if s := c.snapshot(); s.state == open {
+		ret = append(ret, s.apps...)
 	}
 	return ret
 }
 
 // SharedMessagegQueue return lengh of shared queue for recieved stateless message handling.
 func SharedMessagegQueue() int {
-	return len(sharedQ)
+	return DefaultNode().SharedMessagegQueue()
 }
 
 // ActiveSharedWorkers return count of active worker for recieved stateless message handling.
 func ActiveSharedWorkers() int {
-	a := <-activeWorkers
-	activeWorkers <- a
-	return a
+	return DefaultNode().ActiveSharedWorkers()
+}
+
+// DispatchLaneQueues return lengh of each worker lane queue for recieved message with Session-Id.
+func DispatchLaneQueues() []int {
+	return DefaultNode().DispatchLaneQueues()
+}
+
+// SetDispatchLanes changes count of worker lanes for recieved message with Session-Id.
+func SetDispatchLanes(k int) {
+	DefaultNode().SetDispatchLanes(k)
 }
@@ -0,0 +1,220 @@
+package diameter
+
+import (
+	"context"
+	"time"
+)
+
+// FollowRedirect enables resending request to Redirect-Host
+// when redirect indication answer is received.
+var FollowRedirect = false
+
+// ConnectRedirect returns connection of Redirect-Host, and connects to the host if it is not connected.
+// It is called for each request that is sent to Redirect-Host, so that usage of the host can be tracked.
+// Output is the connection after it is opened, nil means that the host is not available.
+var ConnectRedirect func(context.Context, URI) *Connection
+
+// Cached redirect hosts, keyed by Redirect-Host-Usage and related value of the request.
+// Value of ALL_HOST is the host that sent redirect indication.
+var redirects = make(chan map[redirectKey]redirectEntry, 1)
+
+func init() {
+	redirects <- make(map[redirectKey]redirectEntry)
+}
+
+type redirectKey struct {
+	usage Enumerated
+	value string
+	appID uint32
+}
+
+type redirectEntry struct {
+	hosts  []URI
+	expire time.Time
+}
+
+// precedence of cached redirect route (RFC 6733 section 6.13)
+var redirectUsages = []Enumerated{
+	AllSession, AllUser, RealmAndApplication, AllRealm, AllApplication, AllHost}
+
+// redirectKeys returns cache keys of the request.
+// host is the host that sent redirect indication for caching,
+// or the peer host that the request is sent to for lookup.
+func redirectKeys(m Message, host Identity) map[Enumerated]redirectKey {
+	var sid, user string
+	var dRealm Identity
+	for it := NewAVPIterator(m.AVPs); it.Next(); {
+		a := it.AVP()
+		if a.VendorID != 0 {
+			continue
+		}
+		switch a.Code {
+		case 263:
+			sid, _ = GetSessionID(a)
+		case 1:
+			user, _ = GetUserName(a)
+		case 283:
+			dRealm, _ = GetDestinationRealm(a)
+		}
+	}
+
+	keys := make(map[Enumerated]redirectKey)
+	if sid != "" {
+		keys[AllSession] = redirectKey{usage: AllSession, value: sid}
+	}
+	if user != "" {
+		keys[AllUser] = redirectKey{usage: AllUser, value: user}
+	}
+	if dRealm != "" {
+		keys[RealmAndApplication] = redirectKey{
+			usage: RealmAndApplication, value: string(dRealm), appID: m.AppID}
+		keys[AllRealm] = redirectKey{usage: AllRealm, value: string(dRealm)}
+	}
+	keys[AllApplication] = redirectKey{usage: AllApplication, appID: m.AppID}
+	if host != "" {
+		keys[AllHost] = redirectKey{usage: AllHost, value: string(host)}
+	}
+	return keys
+}
+
+// cachedRedirect returns cached redirect hosts of the request that is sent to the peer.
+func (n *Node) cachedRedirect(m Message, peer Identity) []URI {
+	cache := <-n.redirects
+	n.redirects <- cache
+	if len(cache) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	keys := redirectKeys(m, peer)
+	for _, u := range redirectUsages {
+		k, ok := keys[u]
+		if !ok {
+			continue
+		}
+		r, ok := cache[k]
+		if !ok || now.After(r.expire) {
+			continue
+		}
+		return r.hosts
+	}
+	return nil
+}
+
+// cacheRedirect caches redirect hosts of the request
+// that is redirected by the host.
+func (n *Node) cacheRedirect(m Message, host Identity, hosts []URI, usage Enumerated, ttl time.Duration) {
+	if usage == DontCache || ttl == 0 {
+		return
+	}
+	k, ok := redirectKeys(m, host)[usage]
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	cache := <-n.redirects
+	ncache := make(map[redirectKey]redirectEntry, len(cache)+1)
+	for ok, ov := range cache {
+		if now.Before(ov.expire) {
+			ncache[ok] = ov
+		}
+	}
+	ncache[k] = redirectEntry{hosts: hosts, expire: now.Add(ttl)}
+	n.redirects <- ncache
+}
+
+// getRedirect returns Redirect-Host, Redirect-Host-Usage and Redirect-Max-Cache-Time
+// of redirect indication answer, and the host that sent the answer.
+// The host is Origin-Host, or the peer that the answer is received from.
+func getRedirect(m Message) (from Identity, hosts []URI, usage Enumerated, ttl time.Duration, ok bool) {
+	if !m.FlgE {
+		return
+	}
+	from = m.PeerName
+	for it := NewAVPIterator(m.AVPs); it.Next(); {
+		a := it.AVP()
+		if a.VendorID != 0 {
+			continue
+		}
+		var e error
+		switch a.Code {
+		case 268:
+			var result uint32
+			result, e = GetResultCode(a)
+			ok = result == RedirectIndication
+		case 292:
+			var uri URI
+			if uri, e = GetRedirectHost(a); e == nil {
+				hosts = append(hosts, uri)
+			}
+		case 261:
+			usage, e = GetRedirectHostUsage(a)
+		case 262:
+			ttl, e = GetRedirectMaxCacheTime(a)
+		case 264:
+			var h Identity
+			if h, e = GetOriginHost(a); e == nil && h != "" {
+				from = h
+			}
+		}
+		if e != nil {
+			ok = false
+			return
+		}
+	}
+	ok = ok && len(hosts) != 0
+	return
+}
+
+// redirectPeer returns open connection of the Redirect-Host.
+// ConnectRedirect is used if it is available, otherwise registered peer is used.
+func (n *Node) redirectPeer(ctx context.Context, h URI) *Connection {
+	var c *Connection
+	if n.ConnectRedirect != nil {
+		c = n.ConnectRedirect(ctx, h)
+	} else {
+		c = n.LookupPeer(h.Fqdn)
+	}
+	if c != nil && c.isOpen() {
+		return c
+	}
+	return nil
+}
+
+// sendContext sends request with failover and following redirect indication answer.
+func (n *Node) sendContext(ctx context.Context, c *Connection, m Message) (Message, error) {
+	if !n.FollowRedirect {
+		return n.sendFailover(ctx, c, m)
+	}
+	for _, h := range n.cachedRedirect(m, c.PeerHost()) {
+		if rc := n.redirectPeer(ctx, h); rc != nil {
+			c = rc
+			break
+		}
+	}
+
+	r, err := n.sendFailover(ctx, c, m)
+	if err != nil {
+		return r, err
+	}
+	from, hosts, usage, ttl, ok := getRedirect(r)
+	if !ok {
+		return r, err
+	}
+	n.cacheRedirect(m, from, hosts, usage, ttl)
+
+	for _, h := range hosts {
+		rc := n.redirectPeer(ctx, h)
+		if rc == nil || rc == c {
+			continue
+		}
+		m.HbHID = nextHbH()
+		rr, e := n.sendFailover(ctx, rc, m)
+		if _, ok := e.(RejectTxMessage); ok {
+			continue
+		}
+		return rr, e
+	}
+	return r, err
+}
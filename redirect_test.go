@@ -0,0 +1,97 @@
+package diameter
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRedirectConnect(t *testing.T) {
+	c := NewNode("c.local", "local")
+	defer c.Close()
+	c.WDInterval = time.Second * 5
+	c.HandleContext(testCode, testAppID, 0, func(_ bool, avp []AVP) (bool, []AVP) {
+		return false, []AVP{avp[0],
+			SetResultCode(Success),
+			SetOriginHost(c.Host), SetOriginRealm(c.Realm)}
+	}, nil)
+
+	p := newTestPeers(t, func(_ bool, avp []AVP) (bool, []AVP) {
+		return true, []AVP{avp[0],
+			SetResultCode(RedirectIndication),
+			SetOriginHost("b.local"), SetOriginRealm("local"),
+			SetRedirectHost(URI{Scheme: "aaa", Fqdn: c.Host})}
+	})
+	p.a.FollowRedirect = true
+	p.a.ConnectRedirect = func(_ context.Context, h URI) *Connection {
+		if h.Fqdn != c.Host {
+			return nil
+		}
+		return connectNode(t, p.a, c)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	e, avp, err := p.send(ctx, false, p.request())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e {
+		t.Fatal("redirect indication is not followed")
+	}
+	for _, a := range avp {
+		if a.Code == 264 && a.VendorID == 0 {
+			if h, _ := GetOriginHost(a); h != c.Host {
+				t.Errorf("answer is from %s, not %s", h, c.Host)
+			}
+		}
+	}
+
+	p.ca.Close(Rebooting)
+	p.wait(t)
+}
+
+func TestRedirectAllHost(t *testing.T) {
+	n := NewNode("a.local", "local")
+	defer n.Close()
+	req := func(sid string) Message {
+		return NewRequest(testCode, testAppID).Add(
+			SetSessionID(sid),
+			SetOriginHost(n.Host), SetOriginRealm(n.Realm),
+			SetDestinationRealm("remote")).Message()
+	}
+	m := req("a.local;1;1")
+	ans := NewAnswer(m).Add(
+		SetResultCode(RedirectIndication),
+		SetOriginHost("r.local"), SetOriginRealm("local")).Message()
+	ans.FlgE = true
+	ans.PeerName = "b.local"
+	ans.AVPs = SetRedirectHost(URI{Scheme: "aaa", Fqdn: "c.local"}).AppendTo(ans.AVPs)
+	ans.AVPs = SetRedirectHostUsage(AllHost).AppendTo(ans.AVPs)
+	ans.AVPs = SetRedirectMaxCacheTime(time.Minute).AppendTo(ans.AVPs)
+
+	from, hosts, usage, ttl, ok := getRedirect(ans)
+	if !ok {
+		t.Fatal("redirect indication is not detected")
+	}
+	// Origin-Host of the answer is preferred to peer
+	if from != "r.local" {
+		t.Errorf("redirecting host is %s, not r.local", from)
+	}
+	ans.AVPs = nil
+	ans.AVPs = SetResultCode(RedirectIndication).AppendTo(ans.AVPs)
+	ans.AVPs = SetRedirectHost(URI{Scheme: "aaa", Fqdn: "c.local"}).AppendTo(ans.AVPs)
+	if from, _, _, _, _ = getRedirect(ans); from != "b.local" {
+		t.Errorf("redirecting host without Origin-Host is %s, not b.local", from)
+	}
+
+	n.cacheRedirect(m, "r.local", hosts, usage, ttl)
+	// other session that is sent to the redirecting host
+	if h := n.cachedRedirect(req("a.local;1;2"), "r.local"); len(h) != 1 || h[0].Fqdn != "c.local" {
+		t.Errorf("cached redirect host is %v", h)
+	}
+	// request that is sent to other host
+	if h := n.cachedRedirect(req("a.local;1;3"), "d.local"); len(h) != 0 {
+		t.Errorf("redirect host %v of other host is used", h)
+	}
+}
@@ -23,7 +23,8 @@ const apiPath = "/diamsg/v1/"
 
 var (
 	dicData dictionary.XDictionary
-	con     diameter.Connection
+	peers   connector.PeerTable
+	peer    *connector.Peer
 )
 
 func main() {
@@ -36,6 +37,10 @@ func main() {
 	hpeer := flag.String("b", "localhost", "HTTP backend host address. `host[:port]`")
 	dict := flag.String("d", "dictionary.xml", "Diameter dictionary file `path`.")
 	to := flag.Int("t", int(diameter.WDInterval/time.Second), "Message timeout timer [s]")
+	tc := flag.Int("c", int(connector.DefaultTc/time.Second), "Reconnect timer [s]")
+	cert := flag.String("cert", "", "TLS certificate `file` for tls or aaas transport")
+	key := flag.String("key", "", "TLS private key `file` for tls or aaas transport")
+	ca := flag.String("ca", "", "TLS CA certificate `file` for verifying peer")
 	verbose := flag.Bool("v", false, "Verbose log output")
 	help := flag.Bool("h", false, "Print usage")
 	flag.Parse()
@@ -43,7 +48,7 @@ func main() {
 	dpeer := flag.Arg(0)
 	if *help || dpeer == "" {
 		fmt.Printf("usage: %s [OPTION]... DIAMETER_PEER\n", os.Args[0])
-		fmt.Println("DIAMETER_PEER format is [(tcp|sctp)://][realm/]hostname[:port]")
+		fmt.Println("DIAMETER_PEER format is [(tcp|sctp|tls|aaas)://][realm/]hostname[:port]")
 		fmt.Println()
 		flag.PrintDefaults()
 		return
@@ -133,9 +138,29 @@ func main() {
 		},
 		apiPath,
 		func(diameter.Message) *diameter.Connection {
-			return &con
+			return peer.Connection()
 		})
 
+	if *cert != "" || *ca != "" {
+		if connector.TLSConfig, err = connector.LoadTLSConfig(*cert, *key, *ca); err != nil {
+			log.Fatalln("[ERROR]", "failed to load TLS certificate:", err)
+		}
+	}
+
+	log.Println("[INFO]", "connecting Diameter...")
+	_, diameter.Host, diameter.Realm, _, _, err = connector.ResolveIdentity(*dlocal)
+	if err != nil {
+		log.Fatalln("[ERROR]", "invalid local identity:", err)
+	}
+	peers.Local = *dlocal
+	peers.Tc = time.Duration(*tc) * time.Second
+	peers.PeerDownNotify = func(p *connector.Peer, err error) {
+		log.Println("[INFO]", "closed, error=", err)
+	}
+	if peer, err = peers.Add(dpeer); err != nil {
+		log.Fatalln("[ERROR]", err)
+	}
+
 	http.HandleFunc("/diastate/v1/connection", conStateHandler)
 	http.HandleFunc("/diastate/v1/statistics", statsHandler)
 	log.Println("[INFO]", "listening HTTP...\n | local port:", *hlocal)
@@ -146,27 +171,12 @@ func main() {
 		}
 	}()
 
-	log.Println("[INFO]", "connecting Diameter...")
-	var c net.Conn
-	con = diameter.Connection{}
-	c, con.Host, con.Realm, err = connector.Dial(*dlocal, dpeer)
-	if err != nil {
-		log.Fatalln("[ERROR]", err)
-	}
-
-	buf := new(strings.Builder)
-	fmt.Fprint(buf, "transport connection up")
-	fmt.Fprintf(buf, "\n| local: %s://%s", c.LocalAddr().Network(), c.LocalAddr().String())
-	fmt.Fprintf(buf, "\n| peer : %s://%s", c.RemoteAddr().Network(), c.RemoteAddr().String())
-	log.Println("[INFO]", buf)
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
+	<-sigc
 
-	go func() {
-		sigc := make(chan os.Signal, 1)
-		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
-		<-sigc
-
-		con.Close(diameter.Rebooting)
-	}()
-
-	log.Println("[INFO]", "closed, error=", con.DialAndServe(c))
+	peers.Close(diameter.Rebooting)
+	for peer.State() != "closed" {
+		time.Sleep(time.Millisecond * 100)
+	}
 }
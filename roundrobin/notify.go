@@ -14,7 +14,7 @@ func init() {
 		buf := new(strings.Builder)
 		fmt.Fprintln(buf, "diameter connection up")
 		fmt.Fprintln(buf, "| local host/realm:", diameter.Host, "/", diameter.Realm)
-		fmt.Fprintln(buf, "| peer  host/realm:", c.Host, "/", c.Realm)
+		fmt.Fprintln(buf, "| peer  host/realm:", c.PeerHost(), "/", c.PeerRealm())
 		fmt.Fprint(buf, "| available application: ")
 		for _, ap := range c.AvailableApplications() {
 			for _, v := range dicData.V {
@@ -35,6 +35,13 @@ func init() {
 		log.Printf("[INFO] diameter state update: %s->%s by event %s: error=%v",
 			old, new, event, err)
 	}
+	diameter.TransportEventNotify = func(c *diameter.Connection, ev diameter.TransportEvent) {
+		level := "[INFO]"
+		if ev.Failure() {
+			level = "[WARN]"
+		}
+		log.Printf("%s transport event on connection with %s: %s", level, c.PeerHost(), ev)
+	}
 	diameter.TraceMessage = func(msg diameter.Message, dct diameter.Direction, err error) {
 		buf := new(strings.Builder)
 		fmt.Fprintf(buf, "%s diameter message handling: error=%v", dct, err)
@@ -52,25 +59,16 @@ func init() {
 				txReq++
 			}
 		} else {
-			var code uint32
-			if avps, e := msg.GetAVP(); e == nil {
-				for _, a := range avps {
-					switch a.Code {
-					case 268:
-						code, _ = diameter.GetResultCode(a)
-					case 297:
-						code, _ = diameter.GetResultCode(a)
-						code %= 10000
-					}
-					if code != 0 {
-						break
-					}
-				}
-			}
+			vid, code, _ := msg.Result()
 			if dct == diameter.Rx {
 				if _, ok := err.(diameter.FailureAnswer); err != nil && !ok {
 					rxIvld++
-				} else if code < 1000 {
+					return
+				}
+				if vid != 0 {
+					rxExp++
+				}
+				if code < 1000 {
 					rxAns[0]++
 				} else if code < 2000 {
 					rxAns[1]++
@@ -86,6 +84,9 @@ func init() {
 					rxAns[0]++
 				}
 			} else {
+				if vid != 0 {
+					txExp++
+				}
 				if code < 1000 {
 					txAns[0]++
 				} else if code < 2000 {
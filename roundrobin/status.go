@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"net"
 	"net/http"
 
 	"github.com/fkgi/diameter"
@@ -29,24 +30,31 @@ func conStateHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
+	var laddr, paddr net.Addr
+	if con := peer.Connection(); con != nil {
+		laddr = con.LocalAddr()
+		paddr = con.PeerAddr()
+	}
 	w.Write([]byte(fmt.Sprintf(constatFmt,
-		con.State(),
+		peer.State(),
 		diameter.Host,
 		diameter.Realm,
-		con.LocalAddr(),
-		con.Host,
-		con.Realm,
-		con.PeerAddr())))
+		laddr,
+		peer.Host,
+		peer.Realm,
+		paddr)))
 }
 
 var (
 	rxReq  uint64
 	txDisc uint64
 	txAns  [6]uint64
+	txExp  uint64 // answer with Experimental-Result
 
 	txReq  uint64
 	rxIvld uint64
 	rxAns  [6]uint64
+	rxExp  uint64 // answer with Experimental-Result
 )
 
 const statsFmt = `{
@@ -58,6 +66,7 @@ const statsFmt = `{
 	"tx_3xxx": %d,
 	"tx_4xxx": %d,
 	"tx_5xxx": %d,
+	"tx_experimental": %d,
 	"tx_request": %d,
 	"rx_invalid": %d,
 	"rx_etc": %d,
@@ -65,7 +74,8 @@ const statsFmt = `{
 	"rx_2xxx": %d,
 	"rx_3xxx": %d,
 	"rx_4xxx": %d,
-	"rx_5xxx": %d
+	"rx_5xxx": %d,
+	"rx_experimental": %d
 }`
 
 func statsHandler(w http.ResponseWriter, r *http.Request) {
@@ -78,7 +88,7 @@ func statsHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(fmt.Sprintf(statsFmt,
 		rxReq, txDisc,
-		txAns[0], txAns[1], txAns[2], txAns[3], txAns[4], txAns[5],
+		txAns[0], txAns[1], txAns[2], txAns[3], txAns[4], txAns[5], txExp,
 		txReq, rxIvld,
-		rxAns[0], rxAns[1], rxAns[2], rxAns[3], rxAns[4], rxAns[5])))
+		rxAns[0], rxAns[1], rxAns[2], rxAns[3], rxAns[4], rxAns[5], rxExp)))
 }
@@ -0,0 +1,133 @@
+package routing
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+
+	"github.com/fkgi/diameter"
+)
+
+/*
+Relay forwards request that is not for local node to next hop peer.
+It is used as DefaultRxHandler of relay, proxy or redirect agent.
+
+Request that has local hostname in Route-Record is rejected by LoopDetected,
+and request without P flag is rejected by UnableToDeliver.
+Route-Record of the peer that the request is received from is added,
+and Proxy-Info of local node is added for Proxy entry and removed from the answer.
+Candidate peers are tried in order until the request is accepted,
+and the request is resent with T flag when the connection is closed before answer.
+Resending is left to Failover of the node when it is available,
+so that the request is not sent twice to alternate peers.
+Redirect entry is answered with RedirectIndication and Redirect-Host of the Peers.
+*/
+func (t *Table) Relay(m diameter.Message) diameter.Message {
+	n := t.node()
+
+	it := diameter.NewAVPIterator(m.AVPs)
+	for it.Next() {
+		a := it.AVP()
+		if a.VendorID != 0 || a.Code != 282 {
+			continue
+		}
+		if h, e := diameter.GetRouteRecord(a); e != nil {
+			return m.GenerateAnswerByError(e)
+		} else if h == n.Host {
+			return m.GenerateAnswerBy(diameter.LoopDetected)
+		}
+	}
+	if e := it.Err(); e != nil {
+		return m.GenerateAnswerByError(e)
+	}
+
+	e, cands, err := t.lookup(m)
+	if _, ok := err.(diameter.InvalidAVP); ok {
+		return m.GenerateAnswerByError(err)
+	} else if _, ok := err.(diameter.InvalidMessage); ok {
+		return m.GenerateAnswerByError(err)
+	} else if err != nil {
+		return m.GenerateAnswerBy(diameter.UnableToDeliver)
+	}
+
+	switch e.Action {
+	case Local:
+		return m.GenerateAnswerBy(diameter.CommandUnspported)
+	case Redirect:
+		return redirectAnswer(m, e)
+	}
+	if !m.FlgP {
+		return m.GenerateAnswerBy(diameter.UnableToDeliver)
+	}
+
+	buf := bytes.NewBuffer(append([]byte{}, m.AVPs...))
+	if m.PeerName != "" {
+		diameter.SetRouteRecord(m.PeerName).MarshalTo(buf)
+	}
+	var state []byte
+	if e.Action == Proxy {
+		state = binary.BigEndian.AppendUint32(nil, m.HbHID)
+		diameter.SetProxyInfo(n.Host, state).MarshalTo(buf)
+	}
+	fm := m
+	fm.AVPs = buf.Bytes()
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.WDInterval)
+	defer cancel()
+	for _, c := range cands {
+		a, err := c.ForwardContext(ctx, fm)
+		if _, ok := err.(diameter.RejectTxMessage); ok {
+			continue
+		} else if _, ok := err.(diameter.AbortedRequest); ok && n.Failover == nil {
+			fm.FlgT = true
+			continue
+		} else if errors.Is(err, context.DeadlineExceeded) {
+			return m.GenerateAnswerBy(diameter.TooBusy)
+		} else if err != nil {
+			return m.GenerateAnswerBy(diameter.UnableToDeliver)
+		}
+		if state != nil {
+			a.AVPs = stripProxyInfo(a.AVPs, n.Host, state)
+		}
+		return a
+	}
+	return m.GenerateAnswerBy(diameter.UnableToDeliver)
+}
+
+func stripProxyInfo(avps []byte, host diameter.Identity, state []byte) []byte {
+	buf := make([]byte, 0, len(avps))
+	it := diameter.NewAVPIterator(avps)
+	for it.Next() {
+		a := it.AVP()
+		if a.VendorID == 0 && a.Code == 284 {
+			h, s, e := diameter.GetProxyInfo(a)
+			if e == nil && h == host && bytes.Equal(s, state) {
+				continue
+			}
+		}
+		buf = a.AppendTo(buf)
+	}
+	if it.Err() != nil {
+		return avps
+	}
+	return buf
+}
+
+func redirectAnswer(m diameter.Message, e Entry) diameter.Message {
+	if len(e.Peers) == 0 {
+		return m.GenerateAnswerBy(diameter.UnableToDeliver)
+	}
+
+	a := m.GenerateAnswerBy(diameter.RedirectIndication)
+	buf := bytes.NewBuffer(a.AVPs)
+	for _, h := range e.Peers {
+		diameter.SetRedirectHost(diameter.URI{Scheme: "aaa", Fqdn: h}).MarshalTo(buf)
+	}
+	if e.RedirectUsage != diameter.DontCache {
+		diameter.SetRedirectHostUsage(e.RedirectUsage).MarshalTo(buf)
+		diameter.SetRedirectMaxCacheTime(e.RedirectCacheTime).MarshalTo(buf)
+	}
+	a.AVPs = buf.Bytes()
+	return a
+}
@@ -0,0 +1,172 @@
+package routing
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fkgi/diameter"
+)
+
+// newRelayNetwork makes network of client, agent and servers.
+// Agent relays request from client to servers by the table.
+func newRelayNetwork(t *testing.T, servers ...diameter.Identity) (*testNetwork, *Table, *diameter.Connection) {
+	t.Helper()
+	nw := newTestNetwork(t, "agent.local", servers...)
+	tb := &Table{Node: nw.local}
+	nw.local.DefaultRxHandler = tb.Relay
+
+	client := newTestNode(t, "client.local")
+	c, _ := connect(t, client, nw.local)
+	return nw, tb, c
+}
+
+func answerAVPs(t *testing.T, c *diameter.Connection, m diameter.Message) (uint32, []diameter.AVP) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	a, err := c.SendContext(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	avps, err := a.GetAVP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result uint32
+	for _, a := range avps {
+		if a.Code == 268 && a.VendorID == 0 {
+			result, _ = diameter.GetResultCode(a)
+		}
+	}
+	return result, avps
+}
+
+func TestRelayLoopDetected(t *testing.T) {
+	nw, tb, c := newRelayNetwork(t, "server.local")
+	tb.Add(Entry{Realm: "server.realm", AppID: AnyApplication, Action: Relay})
+	var received atomic.Int32
+	nw.peers["server.local"].Handle(272, 4, 0, func(_ bool, avp []diameter.AVP) (bool, []diameter.AVP) {
+		received.Add(1)
+		return false, []diameter.AVP{avp[0], diameter.SetResultCode(diameter.Success),
+			diameter.SetOriginHost("server.local"), diameter.SetOriginRealm("local")}
+	}, nil)
+
+	// request that is already relayed by agent
+	m := request("", "server.realm")
+	m.AVPs = diameter.SetRouteRecord("agent.local").AppendTo(m.AVPs)
+	if r, _ := answerAVPs(t, c, m); r != diameter.LoopDetected {
+		t.Errorf("result is %d, not LoopDetected", r)
+	}
+	if received.Load() != 0 {
+		t.Error("looped request is relayed")
+	}
+
+	// request without loop
+	if r, _ := answerAVPs(t, c, request("", "server.realm")); r != diameter.Success {
+		t.Errorf("result is %d, not Success", r)
+	}
+}
+
+func TestProxyInfo(t *testing.T) {
+	nw, tb, c := newRelayNetwork(t, "server.local")
+	tb.Add(Entry{Realm: "server.realm", AppID: AnyApplication, Action: Proxy})
+
+	rcv := make(chan []diameter.AVP, 1)
+	nw.peers["server.local"].Handle(272, 4, 0, func(_ bool, avp []diameter.AVP) (bool, []diameter.AVP) {
+		rcv <- avp
+		ans := []diameter.AVP{avp[0], diameter.SetResultCode(diameter.Success),
+			diameter.SetOriginHost("server.local"), diameter.SetOriginRealm("local")}
+		// Proxy-Info is copied to answer
+		for _, a := range avp {
+			if a.Code == 284 {
+				ans = append(ans, a)
+			}
+		}
+		return false, ans
+	}, nil)
+
+	// Proxy-Info of client is kept
+	m := request("", "server.realm")
+	m.AVPs = diameter.SetProxyInfo("client.local", []byte("client")).AppendTo(m.AVPs)
+	r, avps := answerAVPs(t, c, m)
+	if r != diameter.Success {
+		t.Fatalf("result is %d, not Success", r)
+	}
+
+	var route []diameter.Identity
+	var proxies []diameter.Identity
+	for _, a := range <-rcv {
+		switch a.Code {
+		case 282:
+			h, _ := diameter.GetRouteRecord(a)
+			route = append(route, h)
+		case 284:
+			h, _, _ := diameter.GetProxyInfo(a)
+			proxies = append(proxies, h)
+		}
+	}
+	if len(route) != 1 || route[0] != "client.local" {
+		t.Errorf("Route-Record of relayed request is %v", route)
+	}
+	if len(proxies) != 2 || proxies[0] != "client.local" || proxies[1] != "agent.local" {
+		t.Errorf("Proxy-Info of relayed request is %v", proxies)
+	}
+
+	proxies = nil
+	for _, a := range avps {
+		if a.Code == 284 {
+			h, s, _ := diameter.GetProxyInfo(a)
+			proxies = append(proxies, h)
+			if string(s) != "client" {
+				t.Errorf("Proxy-State of %s is %q", h, s)
+			}
+		}
+	}
+	if len(proxies) != 1 || proxies[0] != "client.local" {
+		t.Errorf("Proxy-Info of answer is %v", proxies)
+	}
+}
+
+func TestRelayWithNodeFailover(t *testing.T) {
+	nw, tb, c := newRelayNetwork(t, "s1.local", "s2.local")
+	tb.Add(Entry{Realm: "server.realm", AppID: AnyApplication, Action: Relay,
+		Peers: []diameter.Identity{"s1.local", "s2.local"}})
+	// failover of the node is active but no alternate peer is selected
+	var failover atomic.Int32
+	nw.local.Failover = func(diameter.Message, *diameter.Connection) *diameter.Connection {
+		failover.Add(1)
+		return nil
+	}
+
+	block := make(chan struct{})
+	defer close(block)
+	received := make(chan diameter.Identity, 2)
+	for h, n := range nw.peers {
+		h := h
+		n.Handle(272, 4, 0, func(_ bool, avp []diameter.AVP) (bool, []diameter.AVP) {
+			received <- h
+			<-block
+			return false, nil
+		}, nil)
+	}
+
+	go func() {
+		// s1 is disconnected while handling request
+		if h := <-received; h == "s1.local" {
+			nw.trans[h].Close()
+		}
+	}()
+	if r, _ := answerAVPs(t, c, request("", "server.realm")); r != diameter.UnableToDeliver {
+		t.Errorf("result is %d, not UnableToDeliver", r)
+	}
+	if failover.Load() != 1 {
+		t.Errorf("failover of node is called %d times", failover.Load())
+	}
+	select {
+	case h := <-received:
+		t.Errorf("request is resent to %s by relay", h)
+	case <-time.After(time.Millisecond * 200):
+	}
+}
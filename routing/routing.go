@@ -0,0 +1,244 @@
+package routing
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/fkgi/diameter"
+)
+
+// Action of routing entry (RFC 6733 section 2.7).
+type Action int
+
+const (
+	Local Action = iota
+	Relay
+	Proxy
+	Redirect
+)
+
+func (a Action) String() string {
+	switch a {
+	case Local:
+		return "Local"
+	case Relay:
+		return "Relay"
+	case Proxy:
+		return "Proxy"
+	case Redirect:
+		return "Redirect"
+	}
+	return "Unknown"
+}
+
+// AnyApplication is Application-ID of entry that matches with any application.
+const AnyApplication uint32 = 0xffffffff
+
+// Entry of realm-based routing table.
+type Entry struct {
+	Realm  diameter.Identity // Realm of the entry, empty is default route
+	AppID  uint32            // Application-ID of the entry, AnyApplication matches any
+	Action Action
+	// Peers are hostname of next hop peer in order of preference.
+	// Empty Peers means all connected peers.
+	// Peers are Redirect-Host for Redirect entry.
+	Peers []diameter.Identity
+
+	RedirectUsage     diameter.Enumerated // Redirect-Host-Usage of Redirect entry
+	RedirectCacheTime time.Duration       // Redirect-Max-Cache-Time of Redirect entry
+}
+
+// Table is realm-based routing table.
+type Table struct {
+	// Node is local diameter node. nil is default node.
+	Node *diameter.Node
+	// Connection returns connection of the peer.
+	// nil means that Node.LookupPeer is used.
+	Connection func(diameter.Identity) *diameter.Connection
+	// Connections returns all connected peers for entry without Peers.
+	// nil means that Node.Peers is used.
+	Connections func() []*diameter.Connection
+
+	lock    sync.RWMutex
+	entries []Entry
+}
+
+func (t *Table) node() *diameter.Node {
+	if t.Node != nil {
+		return t.Node
+	}
+	return diameter.DefaultNode()
+}
+
+// Add adds entry to the table.
+// Entry that has same Realm and AppID is replaced.
+func (t *Table) Add(e Entry) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for i, o := range t.entries {
+		if o.Realm == e.Realm && o.AppID == e.AppID {
+			t.entries[i] = e
+			return
+		}
+	}
+	t.entries = append(t.entries, e)
+}
+
+// Remove removes entry that has the realm and application-id.
+func (t *Table) Remove(realm diameter.Identity, appID uint32) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.entries = slices.DeleteFunc(t.entries, func(e Entry) bool {
+		return e.Realm == realm && e.AppID == appID
+	})
+}
+
+// Entries returns all entries in the table.
+func (t *Table) Entries() []Entry {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return slices.Clone(t.entries)
+}
+
+// Find returns entry for the realm and application-id.
+// Entry with matched application is preferred to AnyApplication,
+// and default route is used when no entry is matched with the realm.
+func (t *Table) Find(realm diameter.Identity, appID uint32) (Entry, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	for _, r := range []diameter.Identity{realm, ""} {
+		for _, aid := range []uint32{appID, AnyApplication} {
+			for _, e := range t.entries {
+				if e.Realm == r && e.AppID == aid {
+					return e, true
+				}
+			}
+		}
+	}
+	return Entry{}, false
+}
+
+// Lookup returns action and ordered candidate connections for the request.
+// Directly connected Destination-Host is the first candidate,
+// and peer that the request is received from is excluded.
+func (t *Table) Lookup(m diameter.Message) (Action, []*diameter.Connection, error) {
+	e, cands, err := t.lookup(m)
+	return e.Action, cands, err
+}
+
+func (t *Table) lookup(m diameter.Message) (Entry, []*diameter.Connection, error) {
+	var dHost, dRealm diameter.Identity
+	it := diameter.NewAVPIterator(m.AVPs)
+	for it.Next() {
+		a := it.AVP()
+		if a.VendorID != 0 {
+			continue
+		}
+		var e error
+		switch a.Code {
+		case 293:
+			dHost, e = diameter.GetDestinationHost(a)
+		case 283:
+			dRealm, e = diameter.GetDestinationRealm(a)
+		}
+		if e != nil {
+			return Entry{}, nil, e
+		}
+	}
+	if e := it.Err(); e != nil {
+		return Entry{}, nil, e
+	}
+
+	n := t.node()
+	if dHost != "" && dHost == n.Host {
+		return Entry{Realm: dRealm, AppID: m.AppID, Action: Local}, nil, nil
+	}
+
+	e, ok := t.Find(dRealm, m.AppID)
+	if !ok {
+		return e, nil, diameter.InvalidMessage{
+			Code:   diameter.RealmNotServed,
+			ErrMsg: "no route for realm " + dRealm.String()}
+	}
+	if e.Action == Local || e.Action == Redirect {
+		return e, nil, nil
+	}
+
+	lookup := t.Connection
+	if lookup == nil {
+		lookup = n.LookupPeer
+	}
+	cands := make([]*diameter.Connection, 0, len(e.Peers)+1)
+	add := func(c *diameter.Connection) {
+		if c == nil || slices.Contains(cands, c) {
+		} else if c.PeerHost() == m.PeerName && m.PeerName != "" {
+		} else if available(c, m.AppID) {
+			cands = append(cands, c)
+		}
+	}
+
+	if dHost != "" {
+		add(lookup(dHost))
+	}
+	if len(e.Peers) != 0 {
+		for _, h := range e.Peers {
+			add(lookup(h))
+		}
+	} else {
+		cons := t.Connections
+		if cons == nil {
+			cons = n.Peers
+		}
+		all := cons()
+		rand.Shuffle(len(all), func(i, j int) {
+			all[i], all[j] = all[j], all[i]
+		})
+		for _, c := range all {
+			add(c)
+		}
+	}
+
+	if len(cands) == 0 {
+		return e, nil, diameter.InvalidMessage{
+			Code:   diameter.UnableToDeliver,
+			ErrMsg: "no available peer for realm " + dRealm.String()}
+	}
+	return e, cands, nil
+}
+
+// Router returns Router that select first candidate of Relay or Proxy entry.
+func (t *Table) Router() diameter.Router {
+	return func(m diameter.Message) *diameter.Connection {
+		a, cands, err := t.Lookup(m)
+		if err != nil || (a != Relay && a != Proxy) {
+			return nil
+		}
+		return cands[0]
+	}
+}
+
+// Failover returns next candidate of the request except the failed connection.
+// It is used as Failover of diameter.Node.
+func (t *Table) Failover(m diameter.Message, failed *diameter.Connection) *diameter.Connection {
+	_, cands, err := t.lookup(m)
+	if err != nil {
+		return nil
+	}
+	for _, c := range cands {
+		if c != failed {
+			return c
+		}
+	}
+	return nil
+}
+
+func available(c *diameter.Connection, appID uint32) bool {
+	if c.State() != "open" {
+		return false
+	}
+	apps := c.AvailableApplications()
+	return len(apps) == 0 || slices.Contains(apps, appID)
+}
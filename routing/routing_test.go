@@ -0,0 +1,215 @@
+package routing
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/fkgi/diameter"
+)
+
+func TestFind(t *testing.T) {
+	tb := &Table{}
+	for _, e := range []Entry{
+		{Realm: "a.realm", AppID: 4, Action: Relay},
+		{Realm: "a.realm", AppID: AnyApplication, Action: Proxy},
+		{Realm: "b.realm", AppID: 4, Action: Redirect},
+		{Realm: "", AppID: 16777251, Action: Local},
+		{Realm: "", AppID: AnyApplication, Action: Relay, Peers: []diameter.Identity{"default.local"}},
+	} {
+		tb.Add(e)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		realm  diameter.Identity
+		appID  uint32
+		realmE diameter.Identity
+		appE   uint32
+		action Action
+	}{
+		{"realm and application", "a.realm", 4, "a.realm", 4, Relay},
+		{"realm and any application", "a.realm", 5, "a.realm", AnyApplication, Proxy},
+		{"realm is preferred to default route", "a.realm", 16777251, "a.realm", AnyApplication, Proxy},
+		{"default route of application", "b.realm", 16777251, "", 16777251, Local},
+		{"default route of any application", "b.realm", 5, "", AnyApplication, Relay},
+		{"unknown realm", "c.realm", 4, "", AnyApplication, Relay},
+	} {
+		e, ok := tb.Find(tc.realm, tc.appID)
+		if !ok {
+			t.Errorf("%s: no entry is found", tc.name)
+		} else if e.Realm != tc.realmE || e.AppID != tc.appE || e.Action != tc.action {
+			t.Errorf("%s: entry is %s/%d %s, not %s/%d %s", tc.name,
+				e.Realm, e.AppID, e.Action, tc.realmE, tc.appE, tc.action)
+		}
+	}
+
+	// without default route
+	tb.Remove("", AnyApplication)
+	if e, ok := tb.Find("c.realm", 4); ok {
+		t.Errorf("entry %s/%d is found without default route", e.Realm, e.AppID)
+	}
+	// entry with same key is replaced
+	tb.Add(Entry{Realm: "a.realm", AppID: 4, Action: Proxy})
+	if e, _ := tb.Find("a.realm", 4); e.Action != Proxy {
+		t.Errorf("entry is not replaced, action is %s", e.Action)
+	}
+	if l := len(tb.Entries()); l != 4 {
+		t.Errorf("table has %d entries, not 4", l)
+	}
+}
+
+// testNetwork is local node that is connected to peer nodes.
+type testNetwork struct {
+	local *diameter.Node
+	peers map[diameter.Identity]*diameter.Node
+	cons  map[diameter.Identity]*diameter.Connection
+	trans map[diameter.Identity]net.Conn
+}
+
+func newTestNode(t *testing.T, host diameter.Identity) *diameter.Node {
+	n := diameter.NewNode(host, "local")
+	n.WDInterval = time.Second * 5
+	t.Cleanup(n.Close)
+	return n
+}
+
+// newTestNetwork connects local node to peer nodes with loopback TCP.
+func newTestNetwork(t *testing.T, local diameter.Identity, peers ...diameter.Identity) *testNetwork {
+	t.Helper()
+	nw := &testNetwork{
+		local: newTestNode(t, local),
+		peers: make(map[diameter.Identity]*diameter.Node),
+		cons:  make(map[diameter.Identity]*diameter.Connection),
+		trans: make(map[diameter.Identity]net.Conn)}
+	for _, h := range peers {
+		p := newTestNode(t, h)
+		nw.peers[h] = p
+		nw.cons[h], nw.trans[h] = connect(t, nw.local, p)
+	}
+	return nw
+}
+
+// connect connects node a to node b and returns opened connection of a
+// with its transport connection.
+func connect(t *testing.T, a, b *diameter.Node) (*diameter.Connection, net.Conn) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	done := make(chan error, 2)
+	go func() {
+		con, err := l.Accept()
+		if err != nil {
+			done <- err
+			return
+		}
+		done <- (&diameter.Connection{Local: b}).ListenAndServe(con)
+	}()
+	con, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &diameter.Connection{Local: a, Host: b.Host, Realm: b.Realm}
+	go func() { done <- c.DialAndServe(con) }()
+	t.Cleanup(func() {
+		c.Close(diameter.Rebooting)
+		con.Close()
+		for i := 0; i < 2; i++ {
+			<-done
+		}
+	})
+
+	for i := 0; i < 500 && c.State() != "open"; i++ {
+		time.Sleep(time.Millisecond * 10)
+	}
+	if c.State() != "open" {
+		t.Fatalf("connection to %s is %s", b.Host, c.State())
+	}
+	return c, con
+}
+
+func request(dHost, dRealm diameter.Identity) diameter.Message {
+	b := diameter.NewRequest(272, 4).Add(
+		diameter.SetSessionID("test.local;1;1"),
+		diameter.SetOriginHost("client.local"),
+		diameter.SetOriginRealm("local"),
+		diameter.SetDestinationRealm(dRealm))
+	if dHost != "" {
+		b.Add(diameter.SetDestinationHost(dHost))
+	}
+	return b.Message()
+}
+
+func TestLookup(t *testing.T) {
+	nw := newTestNetwork(t, "agent.local", "p1.local", "p2.local", "p3.local")
+	tb := &Table{Node: nw.local}
+	tb.Add(Entry{Realm: "a.realm", AppID: 4, Action: Relay,
+		Peers: []diameter.Identity{"p2.local", "p1.local", "unknown.local"}})
+	tb.Add(Entry{Realm: "b.realm", AppID: AnyApplication, Action: Redirect,
+		Peers: []diameter.Identity{"p1.local"}})
+	tb.Add(Entry{Realm: "", AppID: AnyApplication, Action: Proxy})
+
+	for _, tc := range []struct {
+		name   string
+		m      diameter.Message
+		peer   diameter.Identity // peer that the request is received from
+		action Action
+		cands  []diameter.Identity // nil is any order of all peers
+	}{
+		{"peers in order", request("", "a.realm"), "", Relay,
+			[]diameter.Identity{"p2.local", "p1.local"}},
+		{"destination host is first", request("p3.local", "a.realm"), "", Relay,
+			[]diameter.Identity{"p3.local", "p2.local", "p1.local"}},
+		{"destination host is not duplicated", request("p1.local", "a.realm"), "", Relay,
+			[]diameter.Identity{"p1.local", "p2.local"}},
+		{"received peer is excluded", request("", "a.realm"), "p2.local", Relay,
+			[]diameter.Identity{"p1.local"}},
+		{"local host", request("agent.local", "a.realm"), "", Local, []diameter.Identity{}},
+		{"redirect", request("", "b.realm"), "", Redirect, []diameter.Identity{}},
+		{"default route with all peers", request("", "c.realm"), "", Proxy, nil},
+	} {
+		m := tc.m
+		m.PeerName = tc.peer
+		a, cands, err := tb.Lookup(m)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if a != tc.action {
+			t.Errorf("%s: action is %s, not %s", tc.name, a, tc.action)
+		}
+		if tc.cands == nil {
+			if len(cands) != len(nw.cons) {
+				t.Errorf("%s: %d candidates, not %d", tc.name, len(cands), len(nw.cons))
+			}
+			continue
+		}
+		if len(cands) != len(tc.cands) {
+			t.Errorf("%s: %d candidates, not %d", tc.name, len(cands), len(tc.cands))
+			continue
+		}
+		for i, h := range tc.cands {
+			if cands[i] != nw.cons[h] {
+				t.Errorf("%s: candidate %d is %s, not %s", tc.name, i, cands[i].PeerHost(), h)
+			}
+		}
+	}
+
+	// no available peer of the entry
+	tb.Add(Entry{Realm: "a.realm", AppID: 4, Action: Relay,
+		Peers: []diameter.Identity{"unknown.local"}})
+	_, _, err := tb.Lookup(request("", "a.realm"))
+	if ie, ok := err.(diameter.InvalidMessage); !ok || ie.Code != diameter.UnableToDeliver {
+		t.Errorf("error without peer is %v, not UnableToDeliver", err)
+	}
+
+	// no route for the realm
+	tb.Remove("", AnyApplication)
+	_, _, err = tb.Lookup(request("", "c.realm"))
+	if ie, ok := err.(diameter.InvalidMessage); !ok || ie.Code != diameter.RealmNotServed {
+		t.Errorf("error without route is %v, not RealmNotServed", err)
+	}
+}
@@ -0,0 +1,203 @@
+package sctp
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// RtoInfo is retransmission timeout parameters (SCTP_RTOINFO).
+// Zero value field is not changed.
+type RtoInfo struct {
+	Initial time.Duration
+	Max     time.Duration
+	Min     time.Duration
+}
+
+// AssocInfo is association parameters (SCTP_ASSOCINFO).
+// Zero value field is not changed.
+type AssocInfo struct {
+	MaxRetrans uint16 // Association.Max.Retrans
+	CookieLife time.Duration
+}
+
+// Flags of PeerAddrParams.
+const (
+	HBEnable         uint32 = 1 << 0 // SPP_HB_ENABLE
+	HBDisable        uint32 = 1 << 1 // SPP_HB_DISABLE
+	HBDemand         uint32 = 1 << 2 // SPP_HB_DEMAND
+	PMTUDEnable      uint32 = 1 << 3 // SPP_PMTUD_ENABLE
+	PMTUDDisable     uint32 = 1 << 4 // SPP_PMTUD_DISABLE
+	SackDelayEnable  uint32 = 1 << 5 // SPP_SACKDELAY_ENABLE
+	SackDelayDisable uint32 = 1 << 6 // SPP_SACKDELAY_DISABLE
+	HBTimeIsZero     uint32 = 1 << 7 // SPP_HB_TIME_IS_ZERO
+)
+
+// PeerAddrParams is heartbeat and path parameters of all peer addresses (SCTP_PEER_ADDR_PARAMS).
+// Zero value field is not changed.
+// PathMTU is used with PMTUDDisable flag.
+type PeerAddrParams struct {
+	HBInterval     time.Duration
+	PathMaxRetrans uint16 // Path.Max.Retrans
+	PathMTU        uint32
+	SackDelay      time.Duration
+	Flags          uint32
+}
+
+// DefaultStreams is number of outbound and inbound streams when Config does not specify it.
+const DefaultStreams uint16 = 16
+
+// Config contains parameters of SCTP socket that are applied before connect or listen.
+// Zero value field means default value of the system.
+type Config struct {
+	OutStreams uint16 // number of outbound streams, DefaultStreams is used when 0
+	InStreams  uint16 // maximum number of inbound streams, DefaultStreams is used when 0
+
+	MaxInitAttempts uint16        // count of INIT retransmission
+	MaxInitTimeout  time.Duration // maximum RTO of INIT
+	Timeout         time.Duration // timeout of association setup by Dial, 0 means no timeout
+
+	RtoInfo        RtoInfo
+	AssocInfo      AssocInfo
+	PeerAddrParams PeerAddrParams
+	NoDelay        bool
+}
+
+func (c *Config) apply(sock int) error {
+	if e := c.validate(); e != nil {
+		return e
+	}
+	out, in := c.OutStreams, c.InStreams
+	if out == 0 {
+		out = DefaultStreams
+	}
+	if in == 0 {
+		in = DefaultStreams
+	}
+	if e := sctpSetInitMsg(sock, out, in,
+		c.MaxInitAttempts, uint16(msec(c.MaxInitTimeout))); e != nil {
+		return e
+	}
+	if e := sctpSetEvents(sock); e != nil {
+		return e
+	}
+	if c.RtoInfo != (RtoInfo{}) {
+		if e := sctpSetRtoInfo(sock, c.RtoInfo); e != nil {
+			return e
+		}
+	}
+	if c.AssocInfo != (AssocInfo{}) {
+		if e := sctpSetAssocinfo(sock, c.AssocInfo); e != nil {
+			return e
+		}
+	}
+	if c.PeerAddrParams != (PeerAddrParams{}) {
+		if e := sctpSetPeerAddrParams(sock, c.PeerAddrParams); e != nil {
+			return e
+		}
+	}
+	if c.NoDelay {
+		if e := sctpSetNodelay(sock, true); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// validate checks that values of Config can be set to the socket without truncation.
+func (c *Config) validate() error {
+	if c.Timeout < 0 {
+		return fmt.Errorf("invalid Timeout: %s is negative", c.Timeout)
+	}
+	if e := checkMsec("MaxInitTimeout", c.MaxInitTimeout, math.MaxUint16); e != nil {
+		return e
+	}
+	if e := c.RtoInfo.validate(); e != nil {
+		return e
+	}
+	if e := c.AssocInfo.validate(); e != nil {
+		return e
+	}
+	return c.PeerAddrParams.validate()
+}
+
+func (p RtoInfo) validate() error {
+	if e := checkMsec("RtoInfo.Initial", p.Initial, math.MaxUint32); e != nil {
+		return e
+	}
+	if e := checkMsec("RtoInfo.Max", p.Max, math.MaxUint32); e != nil {
+		return e
+	}
+	return checkMsec("RtoInfo.Min", p.Min, math.MaxUint32)
+}
+
+func (p AssocInfo) validate() error {
+	return checkMsec("AssocInfo.CookieLife", p.CookieLife, math.MaxUint32)
+}
+
+func (p PeerAddrParams) validate() error {
+	if e := checkMsec("PeerAddrParams.HBInterval", p.HBInterval, math.MaxUint32); e != nil {
+		return e
+	}
+	return checkMsec("PeerAddrParams.SackDelay", p.SackDelay, math.MaxUint32)
+}
+
+// checkMsec checks that non-zero duration d is in range from 1 msec to max msec.
+func checkMsec(name string, d time.Duration, max uint64) error {
+	if d == 0 {
+		return nil
+	}
+	if d < time.Millisecond || uint64(d/time.Millisecond) > max {
+		return fmt.Errorf("invalid %s: %s is out of range from 1ms to %dms", name, d, max)
+	}
+	return nil
+}
+
+// msec converts duration that is checked by checkMsec to milliseconds.
+func msec(d time.Duration) uint32 {
+	return uint32(d / time.Millisecond)
+}
+
+// SetRtoInfo sets retransmission timeout parameters of the association.
+func (c *SCTPConn) SetRtoInfo(p RtoInfo) error {
+	if e := p.validate(); e != nil {
+		return c.opError("setsockopt", e)
+	}
+	return c.setsockopt(func(fd int) error {
+		return sctpSetRtoInfo(fd, p)
+	})
+}
+
+// SetAssocinfo sets association parameters of the association.
+func (c *SCTPConn) SetAssocinfo(p AssocInfo) error {
+	if e := p.validate(); e != nil {
+		return c.opError("setsockopt", e)
+	}
+	return c.setsockopt(func(fd int) error {
+		return sctpSetAssocinfo(fd, p)
+	})
+}
+
+// SetPeerAddrParams sets heartbeat and path parameters of all peer addresses.
+func (c *SCTPConn) SetPeerAddrParams(p PeerAddrParams) error {
+	if e := p.validate(); e != nil {
+		return c.opError("setsockopt", e)
+	}
+	return c.setsockopt(func(fd int) error {
+		return sctpSetPeerAddrParams(fd, p)
+	})
+}
+
+// SetNodelay disables or enables Nagle-like bundling of user messages.
+func (c *SCTPConn) SetNodelay(nodelay bool) error {
+	return c.setsockopt(func(fd int) error {
+		return sctpSetNodelay(fd, nodelay)
+	})
+}
+
+func (c *SCTPConn) setsockopt(fn func(int) error) (e error) {
+	if e = c.control(fn); e != nil {
+		e = c.opError("setsockopt", e)
+	}
+	return
+}
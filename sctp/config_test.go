@@ -0,0 +1,30 @@
+package sctp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConfigValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		c     Config
+		valid bool
+	}{
+		{"zero", Config{}, true},
+		{"max init timeout", Config{MaxInitTimeout: time.Millisecond * 65535}, true},
+		{"max init timeout overflow", Config{MaxInitTimeout: time.Millisecond * 65536}, false},
+		{"sub-millisecond init timeout", Config{MaxInitTimeout: time.Microsecond}, false},
+		{"negative timeout", Config{Timeout: -time.Second}, false},
+		{"rto", Config{RtoInfo: RtoInfo{Initial: time.Second, Max: time.Minute, Min: time.Millisecond}}, true},
+		{"sub-millisecond rto", Config{RtoInfo: RtoInfo{Min: time.Microsecond * 500}}, false},
+		{"negative rto", Config{RtoInfo: RtoInfo{Max: -time.Second}}, false},
+		{"cookie life overflow", Config{AssocInfo: AssocInfo{CookieLife: time.Hour * 24 * 50}}, false},
+		{"sub-millisecond sack delay", Config{PeerAddrParams: PeerAddrParams{SackDelay: time.Nanosecond}}, false},
+		{"heartbeat interval", Config{PeerAddrParams: PeerAddrParams{HBInterval: time.Second * 30}}, true},
+	} {
+		if e := tc.c.validate(); (e == nil) != tc.valid {
+			t.Errorf("%s: validation result is %v", tc.name, e)
+		}
+	}
+}
@@ -2,49 +2,73 @@ package sctp
 
 import (
 	"fmt"
+	"io"
 	"net"
+	"os"
+	"sync/atomic"
 	"syscall"
 	"time"
 )
 
 const (
 	ProtocolID uint32 = 46 // Diameter
+
+	msgEOR          = 0x80   // MSG_EOR
+	msgNotification = 0x8000 // MSG_NOTIFICATION
 )
 
 // SCTPConn is an implementation of the Conn interface for SCTP network connections.
+// Socket of the connection is non-blocking and handled by runtime network poller.
 type SCTPConn struct {
-	sock int
+	f      *os.File
+	rc     syscall.RawConn
+	closed atomic.Bool
+	notify func(Event)
+	nbuf   []byte
 }
 
 // DialSCTP connects from the local address laddr
-// to the remote address raddr.
-func DialSCTP(laddr, raddr *SCTPAddr) (c *SCTPConn, e error) {
-	c = &SCTPConn{}
+// to the remote address raddr with default configuration.
+func DialSCTP(laddr, raddr *SCTPAddr) (*SCTPConn, error) {
+	return (&Config{}).Dial(laddr, raddr)
+}
 
+// Dial connects from the local address laddr
+// to the remote address raddr with the configuration.
+func (c *Config) Dial(laddr, raddr *SCTPAddr) (con *SCTPConn, e error) {
+	var sock int
 	if laddr == nil {
 		e = fmt.Errorf("nil local address")
 	} else if laddr.IP[0].To4() != nil {
-		c.sock, e = sockOpenV4()
+		sock, e = sockOpenV4()
 	} else if laddr.IP[0].To16() != nil {
-		c.sock, e = sockOpenV6()
+		sock, e = sockOpenV6()
 	} else {
 		e = &net.AddrError{
 			Err:  "unknown address format",
 			Addr: laddr.String()}
 	}
 	if e == nil {
-		if e = sctpBindx(c.sock, laddr.rawBytes()); e != nil {
-			_ = sockClose(c.sock)
+		if e = c.apply(sock); e != nil {
+			_ = sockClose(sock)
+		}
+	}
+	if e == nil {
+		if e = sctpBindx(sock, laddr.rawBytes()); e != nil {
+			_ = sockClose(sock)
 		}
 	}
 
 	if e != nil {
 	} else if raddr == nil {
+		_ = sockClose(sock)
 		e = fmt.Errorf("nil peer address")
-	} else {
-		if e = sctpConnectx(c.sock, raddr.rawBytes()); e != nil {
-			_ = sockClose(c.sock)
-		}
+	} else if e = sockSetNonblock(sock); e != nil {
+		_ = sockClose(sock)
+	} else if con, e = newConn(sock); e != nil {
+	} else if e = con.connect(raddr.rawBytes(), c.Timeout); e != nil {
+		con.Close()
+		con = nil
 	}
 
 	if e != nil {
@@ -55,51 +79,188 @@ func DialSCTP(laddr, raddr *SCTPAddr) (c *SCTPConn, e error) {
 	return
 }
 
+func newConn(sock int) (*SCTPConn, error) {
+	f, rc, e := newFile(sock)
+	if e != nil {
+		return nil, e
+	}
+	return &SCTPConn{f: f, rc: rc}, nil
+}
+
+// connect starts association with non-blocking connectx,
+// and waits for the socket to be writable by runtime network poller until timeout.
+func (c *SCTPConn) connect(addr []byte, timeout time.Duration) (e error) {
+	if e = c.control(func(fd int) error {
+		return sctpConnectx(fd, addr)
+	}); e != syscall.EINPROGRESS {
+		return
+	}
+
+	if timeout > 0 {
+		_ = c.f.SetWriteDeadline(time.Now().Add(timeout))
+		defer c.f.SetWriteDeadline(time.Time{})
+	}
+	if we := c.rc.Write(func(fd uintptr) bool {
+		e = sockConnected(int(fd))
+		return e != syscall.EINPROGRESS
+	}); we != nil {
+		e = we
+	}
+	return
+}
+
+// newFile registers non-blocking socket to runtime network poller.
+func newFile(sock int) (*os.File, syscall.RawConn, error) {
+	f := os.NewFile(uintptr(sock), "sctp")
+	rc, e := f.SyscallConn()
+	if e != nil {
+		f.Close()
+		return nil, nil, e
+	}
+	return f, rc, nil
+}
+
+// control calls fn with socket of the connection.
+func (c *SCTPConn) control(fn func(int) error) (e error) {
+	if ce := c.rc.Control(func(fd uintptr) {
+		e = fn(int(fd))
+	}); ce != nil {
+		e = ce
+	}
+	return
+}
+
+// opError returns net.OpError of the operation.
+// Error of closed socket is converted to net.ErrClosed.
+func (c *SCTPConn) opError(op string, e error) error {
+	if c.closed.Load() {
+		e = net.ErrClosed
+	}
+	return &net.OpError{
+		Op: op, Net: "sctp",
+		Source: c.LocalAddr(), Addr: c.RemoteAddr(), Err: e}
+}
+
+// Read reads data from the association.
+// Event notifications are not returned as data, and passed to the handler of SetNotify.
 func (c *SCTPConn) Read(b []byte) (n int, e error) {
-	if n, e = sctpRecvmsg(c.sock, b); e != nil {
-		e = &net.OpError{
-			Op: "read", Net: "sctp",
-			Source: c.LocalAddr(), Addr: c.RemoteAddr(), Err: e}
+	if len(b) == 0 {
+		return
+	}
+	var flags int
+	for {
+		if re := c.rc.Read(func(fd uintptr) bool {
+			n, flags, e = sctpRecvmsg(int(fd), b)
+			return e != syscall.EAGAIN
+		}); re != nil {
+			n, e = 0, re
+		}
+		if e != nil || flags&msgNotification == 0 {
+			break
+		}
+		// notification may be truncated when b is short
+		c.nbuf = append(c.nbuf, b[:n]...)
+		if flags&msgEOR == 0 {
+			continue
+		}
+		if ev, ok := parseEvent(c.nbuf); ok && c.notify != nil {
+			c.notify(ev)
+		}
+		c.nbuf = c.nbuf[:0]
+	}
+	if e == io.EOF {
+	} else if e != nil {
+		e = c.opError("read", e)
 	}
 	return
 }
 
+// SetNotify sets handler of event notification of the association.
+// The handler is called in goroutine of Read, so it should return immediately.
+// It must be set before Read is called.
+func (c *SCTPConn) SetNotify(f func(Event)) {
+	c.notify = f
+}
+
+// SetEventNotify is same as SetNotify with input of fmt.Stringer,
+// for transport independent handling of event notification.
+func (c *SCTPConn) SetEventNotify(f func(fmt.Stringer)) {
+	if f == nil {
+		c.notify = nil
+	} else {
+		c.notify = func(ev Event) { f(ev) }
+	}
+}
+
+// Write writes data to stream 0 of the association.
 func (c *SCTPConn) Write(b []byte) (n int, e error) {
-	buf := make([]byte, len(b))
-	copy(buf, b)
+	return c.WriteStream(b, 0)
+}
 
-	if n, e = sctpSend(c.sock, b); e != nil {
-		e = &net.OpError{
-			Op: "write", Net: "sctp",
-			Source: c.LocalAddr(), Addr: c.RemoteAddr(), Err: e}
+// WriteStream writes data to the stream of the association with ordered delivery.
+// Data of one call is sent as one SCTP user message.
+func (c *SCTPConn) WriteStream(b []byte, stream uint16) (n int, e error) {
+	if we := c.rc.Write(func(fd uintptr) bool {
+		n, e = sctpSend(int(fd), b, stream)
+		return e != syscall.EAGAIN
+	}); we != nil {
+		n, e = 0, we
+	}
+	if e != nil {
+		e = c.opError("write", e)
+	}
+	return
+}
+
+// Streams returns number of inbound and outbound streams of the association.
+func (c *SCTPConn) Streams() (in, out uint16, e error) {
+	if e = c.control(func(fd int) (e error) {
+		in, out, e = sctpGetStreams(fd)
+		return
+	}); e != nil {
+		e = c.opError("getsockopt", e)
 	}
 	return
 }
 
 // Close closes the connection.
+// Blocked Read and Write are unblocked and return net.ErrClosed.
 func (c *SCTPConn) Close() (e error) {
-	if e = sockClose(c.sock); e != nil {
-		e = &net.OpError{
-			Op: "close", Net: "sctp",
-			Source: c.LocalAddr(), Addr: c.RemoteAddr(), Err: e}
+	if c.closed.Swap(true) {
+		return c.opError("close", net.ErrClosed)
+	}
+	_ = c.control(sockShutdown)
+	if e = c.f.Close(); e != nil {
+		e = c.opError("close", e)
 	}
 	return e
 }
 
 // LocalAddr returns the local network address.
-func (c *SCTPConn) LocalAddr() net.Addr {
-	ptr, n, e := sctpGetladdrs(c.sock)
-	defer sctpFreeladdrs(ptr)
-	if e != nil {
+func (c *SCTPConn) LocalAddr() (a net.Addr) {
+	c.control(func(fd int) error {
+		a = localAddr(fd)
 		return nil
-	}
-	return resolveFromRawAddr(ptr, n)
+	})
+	return
 }
 
 // RemoteAddr returns the remote network address.
-func (c *SCTPConn) RemoteAddr() net.Addr {
-	ptr, n, e := sctpGetpaddrs(c.sock)
-	defer sctpFreepaddrs(ptr)
+func (c *SCTPConn) RemoteAddr() (a net.Addr) {
+	c.control(func(fd int) error {
+		ptr, n, e := sctpGetpaddrs(fd)
+		defer sctpFreepaddrs(ptr)
+		if e == nil {
+			a = resolveFromRawAddr(ptr, n)
+		}
+		return e
+	})
+	return
+}
+
+func localAddr(fd int) net.Addr {
+	ptr, n, e := sctpGetladdrs(fd)
+	defer sctpFreeladdrs(ptr)
 	if e != nil {
 		return nil
 	}
@@ -108,98 +269,15 @@ func (c *SCTPConn) RemoteAddr() net.Addr {
 
 // SetDeadline implements the Conn SetDeadline method.
 func (c *SCTPConn) SetDeadline(t time.Time) error {
-	return syscall.EOPNOTSUPP
+	return c.f.SetDeadline(t)
 }
 
 // SetReadDeadline implements the Conn SetReadDeadline method.
 func (c *SCTPConn) SetReadDeadline(t time.Time) error {
-	return syscall.EOPNOTSUPP
+	return c.f.SetReadDeadline(t)
 }
 
 // SetWriteDeadline implements the Conn SetWriteDeadline method.
 func (c *SCTPConn) SetWriteDeadline(t time.Time) error {
-	return syscall.EOPNOTSUPP
-}
-
-/*
-// SetRtoInfo set retransmit timer options
-func (c *SCTPConn) SetRtoInfo(ini, min, max int) error {
-	type opt struct {
-		assocID assocT
-		ini     uint32
-		max     uint32
-		min     uint32
-	}
-	attr := opt{
-		assocID: c.id,
-		ini:     uint32(ini),
-		max:     uint32(max),
-		min:     uint32(min)}
-	l := unsafe.Sizeof(attr)
-	p := unsafe.Pointer(&attr)
-
-	return setSockOpt(c.l.sock, sctpRtoInfo, p, l)
-}
-
-// SetAssocinfo set association parameter
-func (c *SCTPConn) SetAssocinfo(pRwnd, lRwnd, cLife, assocMaxRxt, numPeerDest int) error {
-	type opt struct {
-		assocID     assocT
-		pRwnd       uint32
-		lRwnd       uint32
-		cLife       uint32
-		assocMaxRxt uint16
-		numPeerDest uint16
-	}
-	attr := opt{
-		assocID:     c.id,
-		pRwnd:       uint32(pRwnd),
-		lRwnd:       uint32(lRwnd),
-		cLife:       uint32(cLife),
-		assocMaxRxt: uint16(assocMaxRxt),
-		numPeerDest: uint16(numPeerDest)}
-	l := unsafe.Sizeof(attr)
-	p := unsafe.Pointer(&attr)
-
-	return setSockOpt(c.l.sock, sctpAssocInfo, p, l)
-}
-
-// SetNodelay set delay answer or not
-func (c *SCTPConn) SetNodelay(attr bool) error {
-	l := unsafe.Sizeof(attr)
-	p := unsafe.Pointer(&attr)
-
-	return setSockOpt(c.l.sock, sctpNodelay, p, l)
-}
-
-const (
-	HB_ENABLE         = uint32(C.SPP_HB_ENABLE)
-	HB_DISABLE        = uint32(C.SPP_HB_DISABLE)
-	HB_DEMAND         = uint32(C.SPP_HB_DEMAND)
-	PMTUD_ENABLE      = uint32(C.SPP_PMTUD_ENABLE)
-	PMTUD_DISABLE     = uint32(C.SPP_PMTUD_DISABLE)
-	SACKDELAY_ENABLE  = uint32(C.SPP_SACKDELAY_ENABLE)
-	SACKDELAY_DISABLE = uint32(C.SPP_SACKDELAY_DISABLE)
-	HB_TIME_IS_ZERO   = uint32(C.SPP_HB_TIME_IS_ZERO)
-)
-
-func (c *SCTPConn) SetPeerAddrParams(
-	hbinterval uint32, pathmaxrxt uint16, pathmtu, sackdelay, flags uint32) error {
-	attr := C.struct_sctp_paddrparams{}
-	l := C.socklen_t(unsafe.Sizeof(attr))
-
-	attr.spp_assoc_id = c.id
-	attr.spp_hbinterval = C.__u32(hbinterval)
-	attr.spp_pathmaxrxt = C.__u16(pathmaxrxt)
-	//attr.spp_pathmtu = C.__u32(pathmtu)
-	//attr.spp_sackdelay = C.__u32(sackdelay)
-	//attr.spp_flags = C.__u32(flags)
-
-	p := unsafe.Pointer(&attr)
-	i, e := C.setsockopt(c.sock, C.SOL_SCTP, C.SCTP_PEER_ADDR_PARAMS, p, l)
-	if int(i) < 0 {
-		return e
-	}
-	return nil
+	return c.f.SetWriteDeadline(t)
 }
-*/
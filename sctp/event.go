@@ -0,0 +1,147 @@
+package sctp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+)
+
+// EventType is type of SCTP event notification (RFC 6458 section 6.1).
+type EventType uint16
+
+const (
+	AssocChange    EventType = 0x8001 // SCTP_ASSOC_CHANGE
+	PeerAddrChange EventType = 0x8002 // SCTP_PEER_ADDR_CHANGE
+	SendFailed     EventType = 0x8003 // SCTP_SEND_FAILED
+	Shutdown       EventType = 0x8005 // SCTP_SHUTDOWN_EVENT
+)
+
+func (t EventType) String() string {
+	switch t {
+	case AssocChange:
+		return "association change"
+	case PeerAddrChange:
+		return "peer address change"
+	case SendFailed:
+		return "send failed"
+	case Shutdown:
+		return "shutdown"
+	}
+	return fmt.Sprintf("unknown(0x%04x)", uint16(t))
+}
+
+// State of association for AssocChange event.
+const (
+	CommUp       uint32 = 0 // SCTP_COMM_UP
+	CommLost     uint32 = 1 // SCTP_COMM_LOST
+	Restart      uint32 = 2 // SCTP_RESTART
+	ShutdownComp uint32 = 3 // SCTP_SHUTDOWN_COMP
+	CantStrAssoc uint32 = 4 // SCTP_CANT_STR_ASSOC
+)
+
+// State of peer address for PeerAddrChange event.
+const (
+	AddrAvailable   uint32 = 0 // SCTP_ADDR_AVAILABLE
+	AddrUnreachable uint32 = 1 // SCTP_ADDR_UNREACHABLE
+	AddrRemoved     uint32 = 2 // SCTP_ADDR_REMOVED
+	AddrAdded       uint32 = 3 // SCTP_ADDR_ADDED
+	AddrMadePrim    uint32 = 4 // SCTP_ADDR_MADE_PRIM
+	AddrConfirmed   uint32 = 5 // SCTP_ADDR_CONFIRMED
+	AddrPF          uint32 = 6 // SCTP_ADDR_POTENTIALLY_FAILED
+)
+
+var (
+	assocStates = []string{
+		"communication up", "communication lost", "restart",
+		"shutdown complete", "cannot start association"}
+	addrStates = []string{
+		"available", "unreachable", "removed", "added",
+		"made primary", "confirmed", "potentially failed"}
+)
+
+// Event is SCTP event notification of the association.
+type Event struct {
+	Type  EventType
+	State uint32 // state of association or peer address
+	Error uint32 // error cause code
+	Addr  net.IP // peer address for PeerAddrChange event
+}
+
+func (e Event) String() string {
+	switch e.Type {
+	case AssocChange:
+		return fmt.Sprintf("SCTP association %s (error=%d)",
+			stateName(assocStates, e.State), e.Error)
+	case PeerAddrChange:
+		return fmt.Sprintf("SCTP peer address %s %s (error=%d)",
+			e.Addr, stateName(addrStates, e.State), e.Error)
+	case SendFailed:
+		return fmt.Sprintf("SCTP send failed (error=%d)", e.Error)
+	}
+	return "SCTP " + e.Type.String()
+}
+
+// Failure returns true for event that indicates failure of the association or peer address.
+// COMM_UP, available, added or confirmed address and shutdown are not failure.
+func (e Event) Failure() bool {
+	switch e.Type {
+	case AssocChange:
+		return e.State != CommUp && e.State != ShutdownComp
+	case PeerAddrChange:
+		switch e.State {
+		case AddrAvailable, AddrAdded, AddrMadePrim, AddrConfirmed:
+			return false
+		}
+		return true
+	case Shutdown:
+		return false
+	}
+	return true
+}
+
+func stateName(names []string, s uint32) string {
+	if int(s) < len(names) {
+		return names[s]
+	}
+	return fmt.Sprintf("unknown state(%d)", s)
+}
+
+// parseEvent reads union sctp_notification.
+func parseEvent(b []byte) (ev Event, ok bool) {
+	if len(b) < 8 {
+		return
+	}
+	ev.Type = EventType(binary.LittleEndian.Uint16(b[0:2]))
+	switch ev.Type {
+	case AssocChange:
+		// struct sctp_assoc_change
+		if len(b) < 12 {
+			return
+		}
+		ev.State = uint32(binary.LittleEndian.Uint16(b[8:10]))
+		ev.Error = uint32(binary.LittleEndian.Uint16(b[10:12]))
+	case PeerAddrChange:
+		// struct sctp_paddr_change with struct sockaddr_storage
+		if len(b) < 144 {
+			return
+		}
+		switch binary.LittleEndian.Uint16(b[8:10]) {
+		case 2: // AF_INET
+			ev.Addr = net.IP(append([]byte{}, b[12:16]...))
+		case 10: // AF_INET6
+			ev.Addr = net.IP(append([]byte{}, b[16:32]...))
+		}
+		ev.State = binary.LittleEndian.Uint32(b[136:140])
+		ev.Error = binary.LittleEndian.Uint32(b[140:144])
+	case SendFailed:
+		// struct sctp_send_failed
+		if len(b) < 12 {
+			return
+		}
+		ev.Error = binary.LittleEndian.Uint32(b[8:12])
+	case Shutdown:
+	default:
+		return
+	}
+	return ev, true
+}
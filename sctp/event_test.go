@@ -0,0 +1,24 @@
+package sctp
+
+import "testing"
+
+func TestEventFailure(t *testing.T) {
+	for _, tc := range []struct {
+		ev      Event
+		failure bool
+	}{
+		{Event{Type: AssocChange, State: CommUp}, false},
+		{Event{Type: AssocChange, State: CommLost}, true},
+		{Event{Type: AssocChange, State: Restart}, true},
+		{Event{Type: PeerAddrChange, State: AddrAvailable}, false},
+		{Event{Type: PeerAddrChange, State: AddrConfirmed}, false},
+		{Event{Type: PeerAddrChange, State: AddrUnreachable}, true},
+		{Event{Type: PeerAddrChange, State: AddrPF}, true},
+		{Event{Type: SendFailed}, true},
+		{Event{Type: Shutdown}, false},
+	} {
+		if f := tc.ev.Failure(); f != tc.failure {
+			t.Errorf("failure of %s is %t", tc.ev, f)
+		}
+	}
+}
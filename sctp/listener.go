@@ -3,71 +3,67 @@ package sctp
 import (
 	"fmt"
 	"net"
+	"os"
+	"sync/atomic"
 	"syscall"
-	"time"
 )
 
 // SCTPListener is a SCTP network listener.
+// Socket of the listener is non-blocking and handled by runtime network poller.
 type SCTPListener struct {
-	sock int
+	f      *os.File
+	rc     syscall.RawConn
+	closed atomic.Bool
 }
 
 // ListenSCTP announces on the SCTP address laddr
-// and returns a SCTP listener.
-func ListenSCTP(laddr *SCTPAddr) (l *SCTPListener, e error) {
-	l = &SCTPListener{}
+// and returns a SCTP listener with default configuration.
+func ListenSCTP(laddr *SCTPAddr) (*SCTPListener, error) {
+	return (&Config{}).Listen(laddr)
+}
+
+// Listen announces on the SCTP address laddr
+// and returns a SCTP listener with the configuration.
+// The configuration is inherited by accepted associations.
+func (c *Config) Listen(laddr *SCTPAddr) (l *SCTPListener, e error) {
+	var sock int
 	if laddr == nil {
 		e = fmt.Errorf("no local address")
 	} else if laddr.IP[0].To4() != nil {
-		l.sock, e = sockOpenV4()
+		sock, e = sockOpenV4()
 	} else if laddr.IP[0].To16() != nil {
-		l.sock, e = sockOpenV6()
+		sock, e = sockOpenV6()
 	} else {
 		e = &net.AddrError{
 			Err:  "unknown address format",
 			Addr: laddr.String()}
 	}
-
-	/*
-		type opt struct {
-			o uint16
-			i uint16
-			a uint16
-			t uint16
-		}
-		attr := opt{
-			o: uint16(d.OutStream),
-			i: uint16(d.InStream),
-			a: uint16(d.MaxAttempts),
-			t: uint16(d.InitTimeout)}
-		l := unsafe.Sizeof(attr)
-		p := unsafe.Pointer(&attr)
-
-		e = setSockOpt(sock, sctpInitMsg, p, l)
-		if e != nil {
-			sockClose(sock)
-			return nil, &net.OpError{
-				Op:   "setsockopt",Net:  "sctp",
-				Addr: d.LocalAddr,Err:  e}
+	if e == nil {
+		if e = c.apply(sock); e != nil {
+			_ = sockClose(sock)
 		}
-	*/
+	}
 
 	// bind SCTP connection
 	if e == nil {
-		// ptr, n := laddr.rawAddr()
-		if e = sctpBindx(l.sock, laddr.rawBytes()); e != nil {
-			_ = sockClose(l.sock)
+		if e = sctpBindx(sock, laddr.rawBytes()); e != nil {
+			_ = sockClose(sock)
+		}
+	}
+	if e == nil {
+		if e = sockListen(sock); e != nil {
+			_ = sockClose(sock)
 		}
 	}
 	if e == nil {
-		e = sockListen(l.sock)
+		l = &SCTPListener{}
+		l.f, l.rc, e = newFile(sock)
 	}
 	if e != nil {
 		return nil, &net.OpError{
 			Op: "listen", Net: "sctp",
 			Addr: laddr, Err: e}
 	}
-
 	return
 }
 
@@ -79,23 +75,27 @@ func (l *SCTPListener) Accept() (net.Conn, error) {
 
 // AcceptSCTP accepts the next incoming call and returns the new connection.
 func (l *SCTPListener) AcceptSCTP() (c *SCTPConn, e error) {
-	c = &SCTPConn{}
-	for c.sock, e = sockAccept(l.sock); e != nil; c.sock, e = sockAccept(l.sock) {
-		v, ok := e.(syscall.Errno)
-		if !ok {
-			break
-		}
-		switch v {
-		case syscall.EAGAIN:
-			time.Sleep(time.Millisecond * 100)
-			continue
-		case syscall.EINTR:
-			continue
-		}
-		break
+	var sock int
+	if re := l.rc.Read(func(fd uintptr) bool {
+		sock, e = sockAccept(int(fd))
+		return e != syscall.EAGAIN
+	}); re != nil {
+		e = re
 	}
 
+	if e == nil {
+		// subscription of listener is not inherited on some platforms
+		if e = sctpSetEvents(sock); e != nil {
+			_ = sockClose(sock)
+		}
+	}
+	if e == nil {
+		c, e = newConn(sock)
+	}
 	if e != nil {
+		if l.closed.Load() {
+			e = net.ErrClosed
+		}
 		e = &net.OpError{
 			Op: "accept", Net: "sctp",
 			Addr: l.Addr(), Err: e}
@@ -104,17 +104,16 @@ func (l *SCTPListener) AcceptSCTP() (c *SCTPConn, e error) {
 }
 
 // Close stops listening on the SCTP address.
+// Blocked Accept is unblocked and returns net.ErrClosed.
 func (l *SCTPListener) Close() (e error) {
-	return sockClose(l.sock)
+	l.closed.Store(true)
+	return l.f.Close()
 }
 
 // Addr returns the listener's network address, a *SCTPAddr.
-func (l *SCTPListener) Addr() net.Addr {
-	ptr, n, e := sctpGetladdrs(l.sock)
-	if e != nil {
-		return nil
-	}
-	defer sctpFreeladdrs(ptr)
-
-	return resolveFromRawAddr(ptr, n)
+func (l *SCTPListener) Addr() (a net.Addr) {
+	l.rc.Control(func(fd uintptr) {
+		a = localAddr(int(fd))
+	})
+	return
 }
@@ -10,67 +10,17 @@ import (
 	"unsafe"
 )
 
-/*
-	func setNotify(fd int) error {
-		type opt struct {
-			dataIo          uint8
-			association     uint8
-			address         uint8
-			sendFailed      uint8
-			peerError       uint8
-			shutdown        uint8
-			partialDelivery uint8
-			adaptationLayer uint8
-			authentication  uint8
-			senderDry       uint8
-		}
-
-		event := opt{
-			dataIo:          1,
-			association:     1,
-			address:         0,
-			sendFailed:      0,
-			peerError:       0,
-			shutdown:        0,
-			partialDelivery: 0,
-			adaptationLayer: 0,
-			authentication:  0,
-			senderDry:       0}
-		l := unsafe.Sizeof(event)
-		p := unsafe.Pointer(&event)
-
-	_, _, e := syscall.Syscall6(syscall.SYS_SETSOCKOPT,
-		uintptr(fd),
-		132, // SOL_SCTP
-		11, // SCTP_EVENTS
-		uintptr(p),
-		uintptr(l),
-		0)
-	}
-
-	n, e := C.setsockopt(
-			C.int(fd),
-			C.SOL_SCTP,
-			C.int(opt),
-			p,
-			C.socklen_t(l))
-		if int(n) < 0 {
-			return e
-		}
-		return nil
-*/
-
 func sockOpenV4() (int, error) {
 	return syscall.Socket(
 		syscall.AF_INET,
-		syscall.SOCK_STREAM, //syscall.SOCK_SEQPACKET,
+		syscall.SOCK_STREAM|syscall.SOCK_CLOEXEC, //syscall.SOCK_SEQPACKET,
 		syscall.IPPROTO_SCTP)
 }
 
 func sockOpenV6() (int, error) {
 	return syscall.Socket(
 		syscall.AF_INET6,
-		syscall.SOCK_STREAM, //syscall.SOCK_SEQPACKET,
+		syscall.SOCK_STREAM|syscall.SOCK_CLOEXEC, //syscall.SOCK_SEQPACKET,
 		syscall.IPPROTO_SCTP)
 }
 
@@ -83,8 +33,36 @@ func sockListen(fd int) error {
 }
 
 func sockAccept(fd int) (nfd int, e error) {
-	nfd, _, e = syscall.Accept(fd)
-	return
+	for {
+		nfd, _, e = syscall.Accept4(fd, syscall.SOCK_NONBLOCK|syscall.SOCK_CLOEXEC)
+		if e != syscall.EINTR && e != syscall.ECONNABORTED {
+			return
+		}
+	}
+}
+
+func sockSetNonblock(fd int) error {
+	return syscall.SetNonblock(fd, true)
+}
+
+// sockConnected returns result of non-blocking connect.
+// EINPROGRESS is returned while the association is being established.
+func sockConnected(fd int) error {
+	v, e := syscall.GetsockoptInt(fd, syscall.SOL_SOCKET, syscall.SO_ERROR)
+	if e != nil {
+		return e
+	}
+	if v != 0 {
+		return syscall.Errno(v)
+	}
+	if _, e = syscall.Getpeername(fd); e == syscall.ENOTCONN {
+		return syscall.EINPROGRESS
+	}
+	return nil
+}
+
+func sockShutdown(fd int) error {
+	return syscall.Shutdown(fd, syscall.SHUT_RDWR)
 }
 
 func sockClose(fd int) error {
@@ -152,7 +130,92 @@ func sctpConnectx(fd int, addr []byte) error {
 	return nil
 }
 
-func sctpSend(fd int, b []byte) (int, error) {
+func sctpSetInitMsg(fd int, out, in, attempts, timeo uint16) error {
+	attr := struct {
+		numOstreams  uint16
+		maxInstreams uint16
+		maxAttempts  uint16
+		maxInitTimeo uint16
+	}{
+		numOstreams:  out,
+		maxInstreams: in,
+		maxAttempts:  attempts,
+		maxInitTimeo: timeo}
+	return setSockOpt(fd, 2, // SCTP_INITMSG
+		unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+}
+
+func sctpSetRtoInfo(fd int, p RtoInfo) error {
+	attr := struct {
+		assocID int32
+		initial uint32
+		max     uint32
+		min     uint32
+	}{
+		initial: msec(p.Initial),
+		max:     msec(p.Max),
+		min:     msec(p.Min)}
+	return setSockOpt(fd, 0, // SCTP_RTOINFO
+		unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+}
+
+func sctpSetAssocinfo(fd int, p AssocInfo) error {
+	attr := struct {
+		assocID     int32
+		maxRxt      uint16
+		numPeerDest uint16
+		peerRwnd    uint32
+		localRwnd   uint32
+		cookieLife  uint32
+	}{
+		maxRxt:     p.MaxRetrans,
+		cookieLife: msec(p.CookieLife)}
+	return setSockOpt(fd, 1, // SCTP_ASSOCINFO
+		unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+}
+
+func sctpSetNodelay(fd int, nodelay bool) error {
+	var attr int32
+	if nodelay {
+		attr = 1
+	}
+	return setSockOpt(fd, 3, // SCTP_NODELAY
+		unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+}
+
+func sctpSetPeerAddrParams(fd int, p PeerAddrParams) error {
+	// struct sctp_paddrparams is packed, assoc ID and address are zero for all peer address
+	var attr [156]byte
+	binary.LittleEndian.PutUint32(attr[132:], msec(p.HBInterval))
+	binary.LittleEndian.PutUint16(attr[136:], p.PathMaxRetrans)
+	binary.LittleEndian.PutUint32(attr[138:], p.PathMTU)
+	binary.LittleEndian.PutUint32(attr[142:], msec(p.SackDelay))
+	binary.LittleEndian.PutUint32(attr[146:], p.Flags)
+	return setSockOpt(fd, 9, // SCTP_PEER_ADDR_PARAMS
+		unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+}
+
+func sctpGetStreams(fd int) (in, out uint16, e error) {
+	// struct sctp_status with struct sctp_paddrinfo
+	attr := struct {
+		assocID       int32
+		state         int32
+		rwnd          uint32
+		unackdata     uint16
+		penddata      uint16
+		instrms       uint16
+		outstrms      uint16
+		fragmentation uint32
+		primary       [160]byte
+	}{}
+	if e = getSockOpt(fd, 14, // SCTP_STATUS
+		unsafe.Pointer(&attr), unsafe.Sizeof(attr)); e == nil {
+		in, out = attr.instrms, attr.outstrms
+	}
+	return
+}
+
+func sctpSend(fd int, b []byte, stream uint16) (int, error) {
 	hdr := &syscall.Cmsghdr{
 		Level: syscall.IPPROTO_SCTP,
 		Type:  2, //SCTP_SNDINFO
@@ -161,20 +224,76 @@ func sctpSend(fd int, b []byte) (int, error) {
 
 	buf := new(bytes.Buffer)
 	binary.Write(buf, binary.LittleEndian, hdr)
-	binary.Write(buf, binary.LittleEndian, uint16(0))      // stream ID=0
-	binary.Write(buf, binary.LittleEndian, uint16(0x0001)) // flag=SCTP_UNORDERED
-	binary.Write(buf, binary.BigEndian, ProtocolID)        // PPID=diameter
-	buf.Write(make([]byte, 8))                             // context(4 bytes) = empty, assoc ID(4 bytes) = 0
+	binary.Write(buf, binary.LittleEndian, stream)    // stream ID
+	binary.Write(buf, binary.LittleEndian, uint16(0)) // flag=ordered
+	binary.Write(buf, binary.BigEndian, ProtocolID)   // PPID=diameter
+	buf.Write(make([]byte, 8))                        // context(4 bytes) = empty, assoc ID(4 bytes) = 0
 
-	return syscall.SendmsgN(fd, b, buf.Bytes(), nil, 0)
+	for {
+		n, e := syscall.SendmsgN(fd, b, buf.Bytes(), nil, 0)
+		if e != syscall.EINTR {
+			return n, e
+		}
+	}
 }
 
-func sctpRecvmsg(fd int, b []byte) (int, error) {
-	n, on, _, _, e := syscall.Recvmsg(fd, b, make([]byte, 256), 0)
-	if e == nil && n == 0 && on == 0 {
+func setSockOpt(fd, opt int, p unsafe.Pointer, l uintptr) error {
+	_, _, e := syscall.Syscall6(syscall.SYS_SETSOCKOPT,
+		uintptr(fd),
+		132, // SOL_SCTP
+		uintptr(opt),
+		uintptr(p),
+		l,
+		0)
+	if e != 0 {
+		return e
+	}
+	return nil
+}
+
+func getSockOpt(fd, opt int, p unsafe.Pointer, l uintptr) error {
+	_, _, e := syscall.Syscall6(syscall.SYS_GETSOCKOPT,
+		uintptr(fd),
+		132, // SOL_SCTP
+		uintptr(opt),
+		uintptr(p),
+		uintptr(unsafe.Pointer(&l)),
+		0)
+	if e != 0 {
+		return e
+	}
+	return nil
+}
+
+func sctpSetEvents(fd int) error {
+	// struct sctp_event_subscribe, following fields are not used
+	attr := struct {
+		dataIo      uint8
+		association uint8
+		address     uint8
+		sendFailure uint8
+		peerError   uint8
+		shutdown    uint8
+	}{
+		association: 1,
+		address:     1,
+		sendFailure: 1,
+		shutdown:    1}
+	return setSockOpt(fd, 11, // SCTP_EVENTS
+		unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+}
+
+func sctpRecvmsg(fd int, b []byte) (n, flags int, e error) {
+	for {
+		n, _, flags, _, e = syscall.Recvmsg(fd, b, nil, 0)
+		if e != syscall.EINTR {
+			break
+		}
+	}
+	if e == nil && n == 0 {
 		e = io.EOF
 	}
-	return n, e
+	return
 }
 
 func sctpGetladdrs(fd int) (unsafe.Pointer, int, error) {
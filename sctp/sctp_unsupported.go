@@ -3,15 +3,16 @@
 package sctp
 
 import (
+	"syscall"
 	"unsafe"
 )
 
 func sockOpenV4() (int, error) {
-	return 0, nil
+	return 0, syscall.EOPNOTSUPP
 }
 
 func sockOpenV6() (int, error) {
-	return 0, nil
+	return 0, syscall.EOPNOTSUPP
 }
 
 func sockListen(int) error {
@@ -22,6 +23,18 @@ func sockAccept(int) (int, error) {
 	return 0, nil
 }
 
+func sockSetNonblock(int) error {
+	return syscall.EOPNOTSUPP
+}
+
+func sockConnected(int) error {
+	return syscall.EOPNOTSUPP
+}
+
+func sockShutdown(int) error {
+	return nil
+}
+
 func sockClose(int) error {
 	return nil
 }
@@ -34,14 +47,42 @@ func sctpConnectx(int, []byte) error {
 	return nil
 }
 
-func sctpSend(int, []byte) (int, error) {
-	return 0, nil
+func sctpSetInitMsg(int, uint16, uint16, uint16, uint16) error {
+	return nil
+}
+
+func sctpSetRtoInfo(int, RtoInfo) error {
+	return nil
+}
+
+func sctpSetAssocinfo(int, AssocInfo) error {
+	return nil
+}
+
+func sctpSetNodelay(int, bool) error {
+	return nil
+}
+
+func sctpSetPeerAddrParams(int, PeerAddrParams) error {
+	return nil
 }
 
-func sctpRecvmsg(int, []byte) (int, error) {
+func sctpGetStreams(int) (uint16, uint16, error) {
+	return 0, 0, nil
+}
+
+func sctpSend(int, []byte, uint16) (int, error) {
 	return 0, nil
 }
 
+func sctpSetEvents(int) error {
+	return nil
+}
+
+func sctpRecvmsg(int, []byte) (int, int, error) {
+	return 0, 0, nil
+}
+
 func sctpGetladdrs(int) (unsafe.Pointer, int, error) {
 	return nil, 0, nil
 }
@@ -0,0 +1,262 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fkgi/diameter"
+)
+
+/*
+Client is authorization session state machine of access device (RFC 6733 section 8.1).
+ASR and RAR for the application are handled by the Client,
+and STR is sent when the session is terminated.
+
+	<STR> ::= < Diameter Header: 275, REQ, PXY >
+		< Session-Id >
+		{ Origin-Host }
+		{ Origin-Realm }
+		{ Destination-Realm }
+		{ Auth-Application-Id }
+		{ Termination-Cause }
+		[ Destination-Host ]
+*/
+type Client struct {
+	// Node is local diameter node. nil is default node.
+	Node  *diameter.Node
+	AppID uint32
+
+	// AbortNotify is called when ASR is received. Output is Result-Code of ASA.
+	// The session is terminated when the result is Success. nil means always Success.
+	AbortNotify func(*Session) uint32
+	// ReAuthNotify is called when RAR is received or Authorization-Lifetime is expired.
+	// Output is Result-Code of RAA. nil means always Success.
+	ReAuthNotify func(*Session, diameter.Enumerated) uint32
+	// TerminateNotify is called when the session becomes Idle.
+	TerminateNotify func(*Session, diameter.Enumerated)
+
+	str diameter.ContextHandler
+	table
+}
+
+// NewClient registers handlers of ASR and RAR for the application,
+// and returns Client that sends STR by the Router.
+// Handler of STR is not registered, so that Server can be used on the same node.
+func NewClient(n *diameter.Node, appID, venID uint32, rt diameter.Router) *Client {
+	c := &Client{Node: n, AppID: appID}
+	local := node(n)
+	local.Handle(AbortCode, appID, venID, c.handleASR, nil)
+	local.Handle(ReAuthCode, appID, venID, c.handleRAR, nil)
+	c.str = local.Sender(TerminationCode, appID, rt)
+	return c
+}
+
+// New creates new Idle session with new Session-Id.
+// stateful is true for STATE_MAINTAINED session.
+func (c *Client) New(stateful bool) *Session {
+	s := &Session{
+		ID:       node(c.Node).NextSession(),
+		state:    Idle,
+		stateful: stateful}
+	c.add(s)
+	return s
+}
+
+/*
+Authorize sends service specific authorization request of the session with tx.
+Session-Id is added to the head of the request,
+and Auth-Session-State is added for stateless session.
+The session becomes Open when the answer is success,
+and timers are started with Authorization-Lifetime, Auth-Grace-Period and Session-Timeout of the answer.
+Failed answer for the first request makes the session Idle,
+and failed answer for re-authorization terminates the session.
+*/
+func (c *Client) Authorize(ctx context.Context, s *Session, tx diameter.ContextHandler, avps []diameter.AVP) (bool, []diameter.AVP, error) {
+	s.lock.Lock()
+	switch s.state {
+	case Idle:
+		s.state = Pending
+	case Open:
+	default:
+		s.lock.Unlock()
+		return true, nil, fmt.Errorf("session is in %s state", s.state)
+	}
+	stateful := s.stateful
+	s.lock.Unlock()
+
+	req := make([]diameter.AVP, 0, len(avps)+2)
+	req = append(req, diameter.SetSessionID(s.ID))
+	var realm diameter.Identity
+	hasState := false
+	for _, a := range avps {
+		if a.VendorID == 0 && a.Code == 263 {
+			continue
+		} else if a.VendorID == 0 && a.Code == 277 {
+			hasState = true
+		} else if a.VendorID == 0 && a.Code == 283 {
+			realm, _ = diameter.GetDestinationRealm(a)
+		}
+		req = append(req, a)
+	}
+	if !hasState && !stateful {
+		req = append(req, diameter.SetAuthSessionState(false))
+	}
+
+	e, ans, err := tx(ctx, false, req)
+	var p authParams
+	if err == nil {
+		p, err = parseAuth(ans)
+	}
+
+	s.lock.Lock()
+	if s.realm == "" {
+		s.realm = realm
+	}
+	if err != nil && s.state == Pending {
+		removed := c.cleanup(s)
+		s.lock.Unlock()
+		if removed {
+			c.notifyTerminate(s, diameter.BadAnswer)
+		}
+		return e, ans, err
+	} else if err != nil {
+		s.lock.Unlock()
+		return e, ans, err
+	} else if e || p.result/1000 != 2 {
+		if s.state == Pending || !s.stateful {
+			removed := c.cleanup(s)
+			s.lock.Unlock()
+			if removed {
+				c.notifyTerminate(s, diameter.ServiceNotProvided)
+			}
+		} else {
+			s.lock.Unlock()
+			c.Terminate(ctx, s, diameter.ServiceNotProvided)
+		}
+		return e, ans, err
+	}
+
+	s.state = Open
+	if p.stateSet {
+		s.stateful = p.stateful
+	}
+	if p.host != "" {
+		s.peer = p.host
+	}
+	if p.realm != "" {
+		s.realm = p.realm
+	}
+	s.startTimer(p, func() {
+		if c.ReAuthNotify != nil {
+			c.ReAuthNotify(s, diameter.AuthorizeOnly)
+		}
+	}, func(cause diameter.Enumerated) {
+		ctx, cancel := context.WithTimeout(context.Background(), node(c.Node).WDInterval)
+		defer cancel()
+		c.Terminate(ctx, s, cause)
+	})
+	s.lock.Unlock()
+	return e, ans, err
+}
+
+// Terminate terminates the session.
+// STR is sent for stateful session, and the session becomes Idle after receiving STA.
+func (c *Client) Terminate(ctx context.Context, s *Session, cause diameter.Enumerated) error {
+	s.lock.Lock()
+	if s.state != Open {
+		s.lock.Unlock()
+		return fmt.Errorf("session is in %s state", s.state)
+	}
+	if !s.stateful {
+		removed := c.cleanup(s)
+		s.lock.Unlock()
+		if removed {
+			c.notifyTerminate(s, cause)
+		}
+		return nil
+	}
+	s.stopTimer()
+	s.state = Discon
+	peer, realm := s.peer, s.realm
+	s.lock.Unlock()
+
+	n := node(c.Node)
+	req := []diameter.AVP{
+		diameter.SetSessionID(s.ID),
+		diameter.SetOriginHost(n.Host),
+		diameter.SetOriginRealm(n.Realm),
+		diameter.SetDestinationRealm(realm),
+		diameter.SetAuthAppID(c.AppID),
+		diameter.SetTerminationCause(cause)}
+	if peer != "" {
+		req = append(req, diameter.SetDestinationHost(peer))
+	}
+	_, ans, err := c.str(ctx, false, req)
+
+	s.lock.Lock()
+	removed := c.cleanup(s)
+	s.lock.Unlock()
+	if removed {
+		c.notifyTerminate(s, cause)
+	}
+
+	if err != nil {
+		return err
+	}
+	p, err := parseAuth(ans)
+	if err == nil && p.result != diameter.Success {
+		err = diameter.FailureAnswer{
+			Code: p.result, VenID: p.venID,
+			ErrMsg: fmt.Sprintf("STR is answered with result %d", p.result)}
+	}
+	return err
+}
+
+func (c *Client) notifyTerminate(s *Session, cause diameter.Enumerated) {
+	if c.TerminateNotify != nil {
+		c.TerminateNotify(s, cause)
+	}
+}
+
+func (c *Client) handleASR(_ bool, avps []diameter.AVP) (bool, []diameter.AVP) {
+	n := node(c.Node)
+	p, err := parseAuth(avps)
+	if err != nil {
+		return invalidAnswer(n, p.sid, err)
+	}
+	s := c.Lookup(p.sid)
+	if s == nil {
+		return answer(n, p.sid, diameter.UnknownSessionID)
+	}
+
+	result := diameter.Success
+	if c.AbortNotify != nil {
+		result = c.AbortNotify(s)
+	}
+	if result == diameter.Success {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), n.WDInterval)
+			defer cancel()
+			c.Terminate(ctx, s, diameter.Administrative)
+		}()
+	}
+	return answer(n, p.sid, result)
+}
+
+func (c *Client) handleRAR(_ bool, avps []diameter.AVP) (bool, []diameter.AVP) {
+	n := node(c.Node)
+	p, err := parseAuth(avps)
+	if err != nil {
+		return invalidAnswer(n, p.sid, err)
+	}
+	s := c.Lookup(p.sid)
+	if s == nil || s.State() != Open {
+		return answer(n, p.sid, diameter.UnknownSessionID)
+	}
+
+	result := diameter.Success
+	if c.ReAuthNotify != nil {
+		result = c.ReAuthNotify(s, p.reauthType)
+	}
+	return answer(n, p.sid, result)
+}
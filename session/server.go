@@ -0,0 +1,229 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fkgi/diameter"
+)
+
+/*
+Server is authorization session state machine of home server (RFC 6733 section 8.1).
+STR for the application is handled by the Server,
+and ASR and RAR are sent to the access device of the session.
+
+	<ASR> ::= < Diameter Header: 274, REQ, PXY >
+		< Session-Id >
+		{ Origin-Host }
+		{ Origin-Realm }
+		{ Destination-Realm }
+		{ Destination-Host }
+		{ Auth-Application-Id }
+
+	<RAR> ::= < Diameter Header: 258, REQ, PXY >
+		< Session-Id >
+		{ Origin-Host }
+		{ Origin-Realm }
+		{ Destination-Realm }
+		{ Destination-Host }
+		{ Auth-Application-Id }
+		{ Re-Auth-Request-Type }
+*/
+type Server struct {
+	// Node is local diameter node. nil is default node.
+	Node  *diameter.Node
+	AppID uint32
+
+	// TerminateNotify is called when the session becomes Idle.
+	TerminateNotify func(*Session, diameter.Enumerated)
+
+	asr diameter.ContextHandler
+	rar diameter.ContextHandler
+	table
+}
+
+// NewServer registers handler of STR for the application,
+// and returns Server that sends ASR and RAR by the Router.
+// Handlers of ASR and RAR are not registered, so that Client can be used on the same node.
+func NewServer(n *diameter.Node, appID, venID uint32, rt diameter.Router) *Server {
+	sv := &Server{Node: n, AppID: appID}
+	local := node(n)
+	local.Handle(TerminationCode, appID, venID, sv.handleSTR, nil)
+	sv.asr = local.Sender(AbortCode, appID, rt)
+	sv.rar = local.Sender(ReAuthCode, appID, rt)
+	return sv
+}
+
+/*
+Handler returns Handler of service specific authorization request that calls h with the session.
+New session is created for unknown Session-Id.
+Stateful session becomes Open when the answer of h is success,
+and timers are started with Authorization-Lifetime, Auth-Grace-Period and Session-Timeout of the answer.
+Stateless session and session with failed answer are removed after the answer.
+*/
+func (sv *Server) Handler(h func(*Session, bool, []diameter.AVP) (bool, []diameter.AVP)) diameter.Handler {
+	return func(retry bool, avps []diameter.AVP) (bool, []diameter.AVP) {
+		n := node(sv.Node)
+		p, err := parseAuth(avps)
+		if err == nil && p.sid == "" {
+			err = diameter.InvalidAVP{
+				Code: diameter.MissingAvp, AVP: diameter.SetSessionID("")}
+		}
+		if err != nil {
+			return invalidAnswer(n, p.sid, err)
+		}
+
+		s := sv.Lookup(p.sid)
+		if s == nil {
+			s = &Session{
+				ID:       p.sid,
+				state:    Idle,
+				stateful: !p.stateSet || p.stateful,
+				peer:     p.host,
+				realm:    p.realm}
+			sv.add(s)
+		}
+
+		e, ans := h(s, retry, avps)
+		if ans == nil {
+			return e, ans
+		}
+		ap, err := parseAuth(ans)
+
+		s.lock.Lock()
+		opened := s.state == Open
+		if ap.stateSet {
+			s.stateful = ap.stateful
+		}
+		if err != nil || e || ap.result/1000 != 2 || !s.stateful {
+			removed := sv.cleanup(s)
+			s.lock.Unlock()
+			if removed && opened {
+				sv.notifyTerminate(s, diameter.ServiceNotProvided)
+			}
+			return e, ans
+		}
+
+		s.state = Open
+		s.peer, s.realm = p.host, p.realm
+		s.startTimer(ap, nil, func(cause diameter.Enumerated) {
+			s.lock.Lock()
+			removed := sv.cleanup(s)
+			s.lock.Unlock()
+			if removed {
+				sv.notifyTerminate(s, cause)
+			}
+		})
+		s.lock.Unlock()
+		return e, ans
+	}
+}
+
+// Abort sends ASR for the session.
+// The session becomes Idle when ASA is received,
+// and it returns to previous state when ASR is failed.
+func (sv *Server) Abort(ctx context.Context, s *Session) error {
+	s.lock.Lock()
+	if s.state != Open && s.state != Discon {
+		s.lock.Unlock()
+		return fmt.Errorf("session is in %s state", s.state)
+	}
+	prev := s.state
+	s.state = Discon
+	peer, realm := s.peer, s.realm
+	s.lock.Unlock()
+
+	n := node(sv.Node)
+	_, ans, err := sv.asr(ctx, false, []diameter.AVP{
+		diameter.SetSessionID(s.ID),
+		diameter.SetOriginHost(n.Host),
+		diameter.SetOriginRealm(n.Realm),
+		diameter.SetDestinationRealm(realm),
+		diameter.SetDestinationHost(peer),
+		diameter.SetAuthAppID(sv.AppID)})
+	if err != nil {
+		// session is kept for retry of ASR
+		s.lock.Lock()
+		if s.state == Discon {
+			s.state = prev
+		}
+		s.lock.Unlock()
+		return err
+	}
+	p, err := parseAuth(ans)
+
+	s.lock.Lock()
+	removed := sv.cleanup(s)
+	s.lock.Unlock()
+	if removed {
+		sv.notifyTerminate(s, diameter.Administrative)
+	}
+
+	if err == nil && p.result != diameter.Success {
+		err = diameter.FailureAnswer{
+			Code: p.result, VenID: p.venID,
+			ErrMsg: fmt.Sprintf("ASR is answered with result %d", p.result)}
+	}
+	return err
+}
+
+// ReAuth sends RAR for the session and returns Result-Code of RAA.
+// The session becomes Idle when RAA is answered with UnknownSessionID.
+func (sv *Server) ReAuth(ctx context.Context, s *Session, typ diameter.Enumerated) (uint32, error) {
+	s.lock.Lock()
+	if s.state != Open {
+		s.lock.Unlock()
+		return 0, fmt.Errorf("session is in %s state", s.state)
+	}
+	peer, realm := s.peer, s.realm
+	s.lock.Unlock()
+
+	n := node(sv.Node)
+	_, ans, err := sv.rar(ctx, false, []diameter.AVP{
+		diameter.SetSessionID(s.ID),
+		diameter.SetOriginHost(n.Host),
+		diameter.SetOriginRealm(n.Realm),
+		diameter.SetDestinationRealm(realm),
+		diameter.SetDestinationHost(peer),
+		diameter.SetAuthAppID(sv.AppID),
+		diameter.SetReAuthRequestType(typ)})
+	if err != nil {
+		return 0, err
+	}
+	p, err := parseAuth(ans)
+	if err == nil && p.venID == 0 && p.result == diameter.UnknownSessionID {
+		s.lock.Lock()
+		removed := sv.cleanup(s)
+		s.lock.Unlock()
+		if removed {
+			sv.notifyTerminate(s, diameter.ServiceNotProvided)
+		}
+	}
+	return p.result, err
+}
+
+func (sv *Server) notifyTerminate(s *Session, cause diameter.Enumerated) {
+	if sv.TerminateNotify != nil {
+		sv.TerminateNotify(s, cause)
+	}
+}
+
+func (sv *Server) handleSTR(_ bool, avps []diameter.AVP) (bool, []diameter.AVP) {
+	n := node(sv.Node)
+	p, err := parseAuth(avps)
+	if err != nil {
+		return invalidAnswer(n, p.sid, err)
+	}
+	s := sv.Lookup(p.sid)
+	if s == nil {
+		return answer(n, p.sid, diameter.UnknownSessionID)
+	}
+
+	s.lock.Lock()
+	removed := sv.cleanup(s)
+	s.lock.Unlock()
+	if removed {
+		sv.notifyTerminate(s, p.cause)
+	}
+	return answer(n, p.sid, diameter.Success)
+}
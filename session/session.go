@@ -0,0 +1,276 @@
+package session
+
+import (
+	"sync"
+	"time"
+
+	"github.com/fkgi/diameter"
+)
+
+const (
+	// ReAuthCode is command code of Re-Auth-Request/Answer.
+	ReAuthCode uint32 = 258
+	// AbortCode is command code of Abort-Session-Request/Answer.
+	AbortCode uint32 = 274
+	// TerminationCode is command code of Session-Termination-Request/Answer.
+	TerminationCode uint32 = 275
+)
+
+// State is state of authorization session (RFC 6733 section 8.1).
+type State int
+
+const (
+	Idle    State = iota // Idle is initial state of session
+	Pending              // Pending is waiting answer of the first authorization request
+	Open                 // Open is authorized state
+	Discon               // Discon is waiting termination of the session
+)
+
+func (s State) String() string {
+	switch s {
+	case Idle:
+		return "Idle"
+	case Pending:
+		return "Pending"
+	case Open:
+		return "Open"
+	case Discon:
+		return "Discon"
+	}
+	return "<nil>"
+}
+
+/*
+Session is authorization session that is identified by Session-Id.
+Application specific data of the session is kept with SetValue.
+*/
+type Session struct {
+	ID string
+
+	lock     sync.Mutex
+	state    State
+	stateful bool
+	peer     diameter.Identity
+	realm    diameter.Identity
+	value    any
+
+	gen    int         // generation of timers
+	timer  *time.Timer // Session-Timeout or Authorization-Lifetime + Auth-Grace-Period
+	reauth *time.Timer // Authorization-Lifetime
+}
+
+// State returns current state of the session.
+func (s *Session) State() State {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.state
+}
+
+// Stateful returns true when the session is STATE_MAINTAINED.
+func (s *Session) Stateful() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.stateful
+}
+
+// Peer returns host and realm of the other side of the session.
+func (s *Session) Peer() (diameter.Identity, diameter.Identity) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.peer, s.realm
+}
+
+// Value returns application specific data of the session.
+func (s *Session) Value() any {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.value
+}
+
+// SetValue stores application specific data of the session.
+func (s *Session) SetValue(v any) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.value = v
+}
+
+// startTimer starts Session-Timeout and Authorization-Lifetime timer.
+// reauth is called when Authorization-Lifetime expires,
+// and expire is called when Session-Timeout or Auth-Grace-Period expires.
+// Lock of the session must be held.
+func (s *Session) startTimer(p authParams, reauth func(), expire func(diameter.Enumerated)) {
+	s.stopTimer()
+	gen := s.gen
+
+	var d time.Duration
+	var cause diameter.Enumerated
+	if p.timeout != 0 {
+		d = p.timeout
+		cause = diameter.SessionTimeout
+	}
+	if p.hasLifetime && (cause == 0 || p.lifetime+p.grace < d) {
+		d = p.lifetime + p.grace
+		cause = diameter.AuthExpired
+		if reauth != nil {
+			s.reauth = time.AfterFunc(p.lifetime, func() {
+				if s.checkGen(gen) {
+					reauth()
+				}
+			})
+		}
+	}
+	if cause != 0 {
+		s.timer = time.AfterFunc(d, func() {
+			if s.checkGen(gen) {
+				expire(cause)
+			}
+		})
+	}
+}
+
+// stopTimer stops timers of the session. Lock of the session must be held.
+func (s *Session) stopTimer() {
+	s.gen++
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	if s.reauth != nil {
+		s.reauth.Stop()
+		s.reauth = nil
+	}
+}
+
+func (s *Session) checkGen(gen int) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.gen == gen
+}
+
+type table struct {
+	lock     sync.RWMutex
+	sessions map[string]*Session
+}
+
+// Lookup returns session of the Session-Id. nil is returned when no session found.
+func (t *table) Lookup(id string) *Session {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.sessions[id]
+}
+
+// Sessions returns all sessions that are not cleaned up.
+func (t *table) Sessions() []*Session {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	ret := make([]*Session, 0, len(t.sessions))
+	for _, s := range t.sessions {
+		ret = append(ret, s)
+	}
+	return ret
+}
+
+func (t *table) add(s *Session) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.sessions == nil {
+		t.sessions = make(map[string]*Session)
+	}
+	t.sessions[s.ID] = s
+}
+
+// cleanup moves the session to Idle and removes it.
+// Output is false when the session is already removed. Lock of the session must be held.
+func (t *table) cleanup(s *Session) bool {
+	s.stopTimer()
+	s.state = Idle
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.sessions[s.ID] != s {
+		return false
+	}
+	delete(t.sessions, s.ID)
+	return true
+}
+
+func node(n *diameter.Node) *diameter.Node {
+	if n == nil {
+		return diameter.DefaultNode()
+	}
+	return n
+}
+
+// authParams is session parameters in authorization answer or request.
+type authParams struct {
+	sid         string
+	result      uint32
+	venID       uint32 // Vendor-Id of Experimental-Result
+	host        diameter.Identity
+	realm       diameter.Identity
+	stateSet    bool
+	stateful    bool
+	hasLifetime bool
+	lifetime    time.Duration
+	grace       time.Duration
+	timeout     time.Duration
+	cause       diameter.Enumerated
+	reauthType  diameter.Enumerated
+}
+
+func parseAuth(avps []diameter.AVP) (p authParams, err error) {
+	for _, a := range avps {
+		if a.VendorID != 0 {
+			continue
+		}
+		switch a.Code {
+		case 263:
+			p.sid, err = diameter.GetSessionID(a)
+		case 268:
+			p.result, err = diameter.GetResultCode(a)
+		case 297:
+			p.venID, p.result, err = diameter.GetExperimentalResult(a)
+		case 264:
+			p.host, err = diameter.GetOriginHost(a)
+		case 296:
+			p.realm, err = diameter.GetOriginRealm(a)
+		case 277:
+			p.stateful, err = diameter.GetAuthSessionState(a)
+			p.stateSet = true
+		case 291:
+			p.lifetime, err = diameter.GetAuthLifetime(a)
+			// all ones means no re-auth is expected
+			p.hasLifetime = p.lifetime != time.Duration(0xffffffff)*time.Second
+		case 276:
+			p.grace, err = diameter.GetAuthGracePeriod(a)
+		case 27:
+			p.timeout, err = diameter.GetSessionTimeout(a)
+		case 295:
+			p.cause, err = diameter.GetTerminationCause(a)
+		case 285:
+			p.reauthType, err = diameter.GetReAuthRequestType(a)
+		}
+		if err != nil {
+			break
+		}
+	}
+	return
+}
+
+// answer makes answer AVPs of base session commands.
+func answer(n *diameter.Node, sid string, result uint32) (bool, []diameter.AVP) {
+	return diameter.IsProtocolError(result), []diameter.AVP{
+		diameter.SetSessionID(sid),
+		diameter.SetResultCode(result),
+		diameter.SetOriginHost(n.Host),
+		diameter.SetOriginRealm(n.Realm)}
+}
+
+// invalidAnswer makes answer AVPs for request that has invalid AVP.
+func invalidAnswer(n *diameter.Node, sid string, err error) (bool, []diameter.AVP) {
+	if iavp, ok := err.(diameter.InvalidAVP); ok {
+		e, avps := answer(n, sid, iavp.Code)
+		return e, append(avps, diameter.SetFailedAVP([]diameter.AVP{iavp.AVP}))
+	}
+	return answer(n, sid, diameter.InvalidAvpValue)
+}
@@ -0,0 +1,552 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fkgi/diameter"
+)
+
+const testAppID uint32 = 4
+
+// fakeTx is fake transport of request that records sent requests
+// and answers with answer function.
+type fakeTx struct {
+	sent   chan []diameter.AVP
+	answer func([]diameter.AVP) ([]diameter.AVP, error)
+}
+
+func newFakeTx(answer func([]diameter.AVP) ([]diameter.AVP, error)) *fakeTx {
+	return &fakeTx{sent: make(chan []diameter.AVP, 10), answer: answer}
+}
+
+func (f *fakeTx) tx(_ context.Context, _ bool, avps []diameter.AVP) (bool, []diameter.AVP, error) {
+	f.sent <- avps
+	ans, err := f.answer(avps)
+	return false, ans, err
+}
+
+// next returns sent request, or fails if no request is sent in timeout.
+func (f *fakeTx) next(t *testing.T, timeout time.Duration) []diameter.AVP {
+	t.Helper()
+	select {
+	case avps := <-f.sent:
+		return avps
+	case <-time.After(timeout):
+		t.Fatal("request is not sent")
+	}
+	return nil
+}
+
+func (f *fakeTx) none(t *testing.T) {
+	t.Helper()
+	select {
+	case <-f.sent:
+		t.Error("unexpected request is sent")
+	default:
+	}
+}
+
+// answerOf returns answer function that answers the result with AVPs of the peer.
+func answerOf(host diameter.Identity, result uint32, avps ...diameter.AVP) func([]diameter.AVP) ([]diameter.AVP, error) {
+	return func(req []diameter.AVP) ([]diameter.AVP, error) {
+		return append([]diameter.AVP{req[0],
+			diameter.SetResultCode(result),
+			diameter.SetOriginHost(host),
+			diameter.SetOriginRealm("local")}, avps...), nil
+	}
+}
+
+// params returns parameters of the AVPs, or fails if the AVPs are invalid.
+func params(t *testing.T, avps []diameter.AVP) authParams {
+	t.Helper()
+	p, err := parseAuth(avps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func find(avps []diameter.AVP, code uint32) (diameter.AVP, bool) {
+	for _, a := range avps {
+		if a.VendorID == 0 && a.Code == code {
+			return a, true
+		}
+	}
+	return diameter.AVP{}, false
+}
+
+// waitCause returns cause that is notified, or fails if nothing is notified in timeout.
+func waitCause(t *testing.T, ch chan diameter.Enumerated, timeout time.Duration) diameter.Enumerated {
+	t.Helper()
+	select {
+	case c := <-ch:
+		return c
+	case <-time.After(timeout):
+		t.Fatal("termination is not notified")
+	}
+	return 0
+}
+
+func newTestClient(t *testing.T, str *fakeTx) (*Client, chan diameter.Enumerated) {
+	n := diameter.NewNode("a.local", "local")
+	n.WDInterval = time.Second
+	t.Cleanup(n.Close)
+	term := make(chan diameter.Enumerated, 10)
+	c := &Client{Node: n, AppID: testAppID, str: str.tx,
+		TerminateNotify: func(_ *Session, cause diameter.Enumerated) { term <- cause }}
+	return c, term
+}
+
+// openClient returns Open session that is authorized by answer with the AVPs.
+func openClient(t *testing.T, c *Client, stateful bool, avps ...diameter.AVP) *Session {
+	t.Helper()
+	s := c.New(stateful)
+	auth := newFakeTx(answerOf("h.local", diameter.Success, avps...))
+	if _, _, err := c.Authorize(context.Background(), s, auth.tx, []diameter.AVP{
+		diameter.SetDestinationRealm("local")}); err != nil {
+		t.Fatal(err)
+	}
+	if st := s.State(); st != Open {
+		t.Fatalf("session is %s, not Open", st)
+	}
+	return s
+}
+
+func TestClientAuthorize(t *testing.T) {
+	c, term := newTestClient(t, newFakeTx(answerOf("h.local", diameter.Success)))
+
+	s := c.New(true)
+	auth := newFakeTx(answerOf("h.local", diameter.Success))
+	if _, _, err := c.Authorize(context.Background(), s, auth.tx, []diameter.AVP{
+		diameter.SetSessionID("ignored"),
+		diameter.SetDestinationRealm("local")}); err != nil {
+		t.Fatal(err)
+	}
+	req := auth.next(t, time.Second)
+	if p := params(t, req); p.sid != s.ID || p.stateSet {
+		t.Errorf("Session-Id is %s and Auth-Session-State is set %t", p.sid, p.stateSet)
+	}
+	if st := s.State(); st != Open || !s.Stateful() {
+		t.Errorf("session is %s, stateful is %t", st, s.Stateful())
+	}
+	if h, r := s.Peer(); h != "h.local" || r != "local" {
+		t.Errorf("peer is %s/%s", h, r)
+	}
+	if c.Lookup(s.ID) != s {
+		t.Error("session is not found")
+	}
+
+	// stateless session is requested with NO_STATE_MAINTAINED
+	s = c.New(false)
+	if _, _, err := c.Authorize(context.Background(), s, auth.tx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if p := params(t, auth.next(t, time.Second)); !p.stateSet || p.stateful {
+		t.Error("Auth-Session-State NO_STATE_MAINTAINED is not requested")
+	}
+	if s.State() != Open {
+		t.Errorf("stateless session is %s", s.State())
+	}
+
+	// failed answer for the first request makes the session Idle
+	s = c.New(true)
+	auth = newFakeTx(answerOf("h.local", diameter.UnableToComply))
+	c.Authorize(context.Background(), s, auth.tx, nil)
+	if s.State() != Idle || c.Lookup(s.ID) != nil {
+		t.Errorf("failed session is %s", s.State())
+	}
+	if cause := waitCause(t, term, time.Second); cause != diameter.ServiceNotProvided {
+		t.Errorf("termination cause is %d", cause)
+	}
+
+	s = c.New(true)
+	auth = newFakeTx(func([]diameter.AVP) ([]diameter.AVP, error) {
+		return nil, errors.New("no route")
+	})
+	if _, _, err := c.Authorize(context.Background(), s, auth.tx, nil); err == nil {
+		t.Error("error of request is not returned")
+	}
+	if cause := waitCause(t, term, time.Second); cause != diameter.BadAnswer {
+		t.Errorf("termination cause is %d", cause)
+	}
+
+	// Pending session can not be authorized again
+	s = c.New(true)
+	s.state = Pending
+	if _, _, err := c.Authorize(context.Background(), s, auth.tx, nil); err == nil {
+		t.Error("Pending session is authorized")
+	}
+}
+
+func TestClientTerminate(t *testing.T) {
+	str := newFakeTx(answerOf("h.local", diameter.Success))
+	c, term := newTestClient(t, str)
+
+	s := openClient(t, c, true)
+	if err := c.Terminate(context.Background(), s, diameter.Administrative); err != nil {
+		t.Fatal(err)
+	}
+	req := str.next(t, time.Second)
+	if p := params(t, req); p.sid != s.ID || p.cause != diameter.Administrative {
+		t.Errorf("STR is sent with Session-Id %s and cause %d", p.sid, p.cause)
+	}
+	if a, ok := find(req, 293); !ok {
+		t.Error("Destination-Host is not sent")
+	} else if h, _ := diameter.GetDestinationHost(a); h != "h.local" {
+		t.Errorf("Destination-Host is %s", h)
+	}
+	if s.State() != Idle || c.Lookup(s.ID) != nil {
+		t.Errorf("terminated session is %s", s.State())
+	}
+	if cause := waitCause(t, term, time.Second); cause != diameter.Administrative {
+		t.Errorf("termination cause is %d", cause)
+	}
+	if err := c.Terminate(context.Background(), s, diameter.Administrative); err == nil {
+		t.Error("Idle session is terminated")
+	}
+
+	// session is terminated even if STR is failed
+	str.answer = answerOf("h.local", diameter.UnknownSessionID)
+	s = openClient(t, c, true)
+	var fa diameter.FailureAnswer
+	if err := c.Terminate(context.Background(), s, diameter.Administrative); !errors.As(err, &fa) ||
+		fa.Code != diameter.UnknownSessionID {
+		t.Errorf("error is %v", err)
+	}
+	str.next(t, time.Second)
+	if s.State() != Idle {
+		t.Errorf("session is %s after failed STR", s.State())
+	}
+	waitCause(t, term, time.Second)
+
+	// STR is not sent for stateless session
+	s = openClient(t, c, false)
+	if err := c.Terminate(context.Background(), s, diameter.Administrative); err != nil {
+		t.Fatal(err)
+	}
+	str.none(t)
+	if s.State() != Idle {
+		t.Errorf("stateless session is %s", s.State())
+	}
+}
+
+func TestClientSessionTimeout(t *testing.T) {
+	str := newFakeTx(answerOf("h.local", diameter.Success))
+	c, term := newTestClient(t, str)
+
+	s := openClient(t, c, true, diameter.SetSessionTimeout(time.Second))
+	start := time.Now()
+	if cause := waitCause(t, term, time.Second*3); cause != diameter.SessionTimeout {
+		t.Errorf("termination cause is %d", cause)
+	}
+	if d := time.Since(start); d < time.Millisecond*900 {
+		t.Errorf("session is expired after %s", d)
+	}
+	if p := params(t, str.next(t, time.Second)); p.cause != diameter.SessionTimeout {
+		t.Errorf("STR is sent with cause %d", p.cause)
+	}
+	if s.State() != Idle {
+		t.Errorf("expired session is %s", s.State())
+	}
+}
+
+func TestClientAuthLifetime(t *testing.T) {
+	str := newFakeTx(answerOf("h.local", diameter.Success))
+	c, term := newTestClient(t, str)
+	reauth := make(chan diameter.Enumerated, 10)
+	c.ReAuthNotify = func(_ *Session, typ diameter.Enumerated) uint32 {
+		reauth <- typ
+		return diameter.Success
+	}
+
+	s := openClient(t, c, true,
+		diameter.SetAuthLifetime(time.Second),
+		diameter.SetAuthGracePeriod(time.Second))
+	select {
+	case typ := <-reauth:
+		if typ != diameter.AuthorizeOnly {
+			t.Errorf("re-auth type is %d", typ)
+		}
+	case <-time.After(time.Second * 2):
+		t.Fatal("re-auth is not notified")
+	}
+	if s.State() != Open {
+		t.Errorf("session is %s in grace period", s.State())
+	}
+
+	// session is terminated after grace period without re-authorization
+	if cause := waitCause(t, term, time.Second*2); cause != diameter.AuthExpired {
+		t.Errorf("termination cause is %d", cause)
+	}
+	if p := params(t, str.next(t, time.Second)); p.cause != diameter.AuthExpired {
+		t.Errorf("STR is sent with cause %d", p.cause)
+	}
+
+	// re-authorization restarts timers
+	s = openClient(t, c, true,
+		diameter.SetAuthLifetime(time.Second),
+		diameter.SetAuthGracePeriod(0))
+	time.Sleep(time.Millisecond * 600)
+	auth := newFakeTx(answerOf("h.local", diameter.Success, diameter.SetSessionTimeout(time.Second*10)))
+	if _, _, err := c.Authorize(context.Background(), s, auth.tx, nil); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-term:
+		t.Error("re-authorized session is expired")
+	case <-time.After(time.Millisecond * 800):
+	}
+	if s.State() != Open {
+		t.Errorf("re-authorized session is %s", s.State())
+	}
+}
+
+func TestClientASR(t *testing.T) {
+	str := newFakeTx(answerOf("h.local", diameter.Success))
+	c, term := newTestClient(t, str)
+	s := openClient(t, c, true)
+
+	c.AbortNotify = func(*Session) uint32 { return diameter.UnableToComply }
+	asr := []diameter.AVP{
+		diameter.SetSessionID(s.ID),
+		diameter.SetOriginHost("h.local"), diameter.SetOriginRealm("local")}
+	e, ans := c.handleASR(false, asr)
+	if p := params(t, ans); e || p.result != diameter.UnableToComply {
+		t.Errorf("ASA is %d", p.result)
+	}
+	time.Sleep(time.Millisecond * 100)
+	str.none(t)
+	if s.State() != Open {
+		t.Errorf("rejected abort makes session %s", s.State())
+	}
+
+	c.AbortNotify = nil
+	if _, ans = c.handleASR(false, asr); params(t, ans).result != diameter.Success {
+		t.Errorf("ASA is %d", params(t, ans).result)
+	}
+	if p := params(t, str.next(t, time.Second)); p.cause != diameter.Administrative {
+		t.Errorf("STR is sent with cause %d", p.cause)
+	}
+	if cause := waitCause(t, term, time.Second); cause != diameter.Administrative {
+		t.Errorf("termination cause is %d", cause)
+	}
+
+	if _, ans = c.handleASR(false, asr); params(t, ans).result != diameter.UnknownSessionID {
+		t.Errorf("ASA for unknown session is %d", params(t, ans).result)
+	}
+}
+
+func TestClientRAR(t *testing.T) {
+	c, _ := newTestClient(t, newFakeTx(answerOf("h.local", diameter.Success)))
+	reauth := make(chan diameter.Enumerated, 10)
+	c.ReAuthNotify = func(_ *Session, typ diameter.Enumerated) uint32 {
+		reauth <- typ
+		return diameter.Success
+	}
+	s := openClient(t, c, true)
+
+	rar := []diameter.AVP{
+		diameter.SetSessionID(s.ID),
+		diameter.SetOriginHost("h.local"), diameter.SetOriginRealm("local"),
+		diameter.SetReAuthRequestType(diameter.AuthorizeAuthenticate)}
+	if _, ans := c.handleRAR(false, rar); params(t, ans).result != diameter.Success {
+		t.Errorf("RAA is %d", params(t, ans).result)
+	}
+	if typ := <-reauth; typ != diameter.AuthorizeAuthenticate {
+		t.Errorf("re-auth type is %d", typ)
+	}
+
+	rar[0] = diameter.SetSessionID("a.local;0;0")
+	if _, ans := c.handleRAR(false, rar); params(t, ans).result != diameter.UnknownSessionID {
+		t.Errorf("RAA for unknown session is %d", params(t, ans).result)
+	}
+}
+
+func newTestServer(t *testing.T) (*Server, chan diameter.Enumerated) {
+	n := diameter.NewNode("h.local", "local")
+	t.Cleanup(n.Close)
+	term := make(chan diameter.Enumerated, 10)
+	sv := &Server{Node: n, AppID: testAppID,
+		TerminateNotify: func(_ *Session, cause diameter.Enumerated) { term <- cause }}
+	return sv, term
+}
+
+// openServer returns Open session that is authorized with answer that has the AVPs.
+func openServer(t *testing.T, sv *Server, sid string, avps ...diameter.AVP) *Session {
+	t.Helper()
+	h := sv.Handler(func(_ *Session, _ bool, req []diameter.AVP) (bool, []diameter.AVP) {
+		return false, append([]diameter.AVP{req[0],
+			diameter.SetResultCode(diameter.Success),
+			diameter.SetOriginHost("h.local"), diameter.SetOriginRealm("local")}, avps...)
+	})
+	h(false, []diameter.AVP{
+		diameter.SetSessionID(sid),
+		diameter.SetOriginHost("a.local"), diameter.SetOriginRealm("local")})
+	s := sv.Lookup(sid)
+	if s == nil || s.State() != Open {
+		t.Fatal("session is not opened")
+	}
+	return s
+}
+
+func TestServerHandler(t *testing.T) {
+	sv, _ := newTestServer(t)
+
+	s := openServer(t, sv, "a.local;1;1")
+	if h, r := s.Peer(); h != "a.local" || r != "local" || !s.Stateful() {
+		t.Errorf("peer is %s/%s, stateful is %t", h, r, s.Stateful())
+	}
+
+	var called *Session
+	h := sv.Handler(func(s *Session, _ bool, req []diameter.AVP) (bool, []diameter.AVP) {
+		called = s
+		return false, []diameter.AVP{req[0],
+			diameter.SetResultCode(diameter.UnableToComply),
+			diameter.SetOriginHost("h.local"), diameter.SetOriginRealm("local")}
+	})
+	// failed answer removes the session
+	h(false, []diameter.AVP{diameter.SetSessionID("a.local;1;2")})
+	if called == nil || called.State() != Idle || sv.Lookup("a.local;1;2") != nil {
+		t.Error("failed session is not removed")
+	}
+
+	// missing Session-Id is answered with Failed-AVP
+	called = nil
+	e, ans := h(false, []diameter.AVP{diameter.SetOriginHost("a.local")})
+	if p := params(t, ans); called != nil || e || p.result != diameter.MissingAvp {
+		t.Errorf("answer of request without Session-Id is %d", p.result)
+	}
+	if _, ok := find(ans, 279); !ok {
+		t.Error("Failed-AVP is not answered")
+	}
+
+	// stateless session is removed after the answer
+	stateless := sv.Handler(func(_ *Session, _ bool, req []diameter.AVP) (bool, []diameter.AVP) {
+		return false, []diameter.AVP{req[0], diameter.SetResultCode(diameter.Success)}
+	})
+	stateless(false, []diameter.AVP{
+		diameter.SetSessionID("a.local;1;3"), diameter.SetAuthSessionState(false)})
+	if sv.Lookup("a.local;1;3") != nil {
+		t.Error("stateless session is kept")
+	}
+}
+
+func TestServerSTR(t *testing.T) {
+	sv, term := newTestServer(t)
+	s := openServer(t, sv, "a.local;2;1")
+
+	str := []diameter.AVP{
+		diameter.SetSessionID(s.ID),
+		diameter.SetOriginHost("a.local"), diameter.SetOriginRealm("local"),
+		diameter.SetTerminationCause(diameter.Logout)}
+	if _, ans := sv.handleSTR(false, str); params(t, ans).result != diameter.Success {
+		t.Errorf("STA is %d", params(t, ans).result)
+	}
+	if s.State() != Idle || sv.Lookup(s.ID) != nil {
+		t.Errorf("terminated session is %s", s.State())
+	}
+	if cause := waitCause(t, term, time.Second); cause != diameter.Logout {
+		t.Errorf("termination cause is %d", cause)
+	}
+	if _, ans := sv.handleSTR(false, str); params(t, ans).result != diameter.UnknownSessionID {
+		t.Errorf("STA for unknown session is %d", params(t, ans).result)
+	}
+}
+
+func TestServerAbort(t *testing.T) {
+	sv, term := newTestServer(t)
+	s := openServer(t, sv, "a.local;3;1")
+
+	// failed ASR keeps the session
+	asr := newFakeTx(func([]diameter.AVP) ([]diameter.AVP, error) {
+		return nil, errors.New("no route")
+	})
+	sv.asr = asr.tx
+	if err := sv.Abort(context.Background(), s); err == nil {
+		t.Error("error of ASR is not returned")
+	}
+	req := asr.next(t, time.Second)
+	if a, ok := find(req, 293); !ok {
+		t.Error("Destination-Host is not sent")
+	} else if h, _ := diameter.GetDestinationHost(a); h != "a.local" {
+		t.Errorf("Destination-Host is %s", h)
+	}
+	if s.State() != Open || sv.Lookup(s.ID) != s {
+		t.Errorf("session is %s after failed ASR", s.State())
+	}
+
+	asr.answer = answerOf("a.local", diameter.Success)
+	if err := sv.Abort(context.Background(), s); err != nil {
+		t.Fatal(err)
+	}
+	if s.State() != Idle || sv.Lookup(s.ID) != nil {
+		t.Errorf("aborted session is %s", s.State())
+	}
+	if cause := waitCause(t, term, time.Second); cause != diameter.Administrative {
+		t.Errorf("termination cause is %d", cause)
+	}
+	if err := sv.Abort(context.Background(), s); err == nil {
+		t.Error("Idle session is aborted")
+	}
+}
+
+func TestServerReAuth(t *testing.T) {
+	sv, term := newTestServer(t)
+	s := openServer(t, sv, "a.local;4;1")
+
+	rar := newFakeTx(answerOf("a.local", diameter.Success))
+	sv.rar = rar.tx
+	if r, err := sv.ReAuth(context.Background(), s, diameter.AuthorizeOnly); err != nil || r != diameter.Success {
+		t.Errorf("RAA is %d, error is %v", r, err)
+	}
+	if p := params(t, rar.next(t, time.Second)); p.reauthType != diameter.AuthorizeOnly {
+		t.Errorf("Re-Auth-Request-Type is %d", p.reauthType)
+	}
+	if s.State() != Open {
+		t.Errorf("re-authorized session is %s", s.State())
+	}
+
+	// session that is unknown for client is removed
+	rar.answer = answerOf("a.local", diameter.UnknownSessionID)
+	if r, _ := sv.ReAuth(context.Background(), s, diameter.AuthorizeOnly); r != diameter.UnknownSessionID {
+		t.Errorf("RAA is %d", r)
+	}
+	if s.State() != Idle || sv.Lookup(s.ID) != nil {
+		t.Errorf("unknown session is %s", s.State())
+	}
+	if cause := waitCause(t, term, time.Second); cause != diameter.ServiceNotProvided {
+		t.Errorf("termination cause is %d", cause)
+	}
+}
+
+func TestServerSessionTimeout(t *testing.T) {
+	sv, term := newTestServer(t)
+	s := openServer(t, sv, "a.local;5;1", diameter.SetSessionTimeout(time.Second))
+
+	if cause := waitCause(t, term, time.Second*3); cause != diameter.SessionTimeout {
+		t.Errorf("termination cause is %d", cause)
+	}
+	if s.State() != Idle || sv.Lookup(s.ID) != nil {
+		t.Errorf("expired session is %s", s.State())
+	}
+}
+
+func TestAnswerFlag(t *testing.T) {
+	n := diameter.NewNode("h.local", "local")
+	defer n.Close()
+	for _, tc := range []struct {
+		result uint32
+		e      bool
+	}{
+		{diameter.Success, false},
+		{diameter.UnableToDeliver, true},
+		{diameter.UnknownSessionID, false},
+	} {
+		if e, _ := answer(n, "a.local;1;1", tc.result); e != tc.e {
+			t.Errorf("E flag of result %d is %t", tc.result, e)
+		}
+	}
+}
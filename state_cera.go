@@ -19,9 +19,9 @@ Capabilities-Exchange-Request message
 		   [ Origin-State-Id ]
 		 * [ Supported-Vendor-Id ]
 		 * [ Auth-Application-Id ]
-		 * [ Inband-Security-Id ]             // not supported (not recommended)
-		 * [ Acct-Application-Id ]            // not supported
-		 * [ Vendor-Specific-Application-Id ] // only support auth
+		 * [ Inband-Security-Id ]             // NO_INBAND_SECURITY or TLS
+		 * [ Acct-Application-Id ]
+		 * [ Vendor-Specific-Application-Id ]
 		   [ Firmware-Revision ]
 		 * [ AVP ]                            // no any other AVP
 
@@ -38,15 +38,16 @@ Capabilities-Exchange-Answer message
 		   [ Failed-AVP ]                     // ignored
 		 * [ Supported-Vendor-Id ]
 		 * [ Auth-Application-Id ]
-		 * [ Inband-Security-Id ]             // not supported (not recommended)
-		 * [ Acct-Application-Id ]            // not supported
-		 * [ Vendor-Specific-Application-Id ] // only support auth
+		 * [ Inband-Security-Id ]             // NO_INBAND_SECURITY or TLS
+		 * [ Acct-Application-Id ]
+		 * [ Vendor-Specific-Application-Id ]
 		   [ Firmware-Revision ]              // ignored
 		 * [ AVP ]                            // ignored
 */
 
 type eventRcvCER struct {
-	m Message
+	m      Message
+	resume chan net.Conn // transport connection for reader after CER/CEA
 }
 
 func (eventRcvCER) String() string {
@@ -54,13 +55,17 @@ func (eventRcvCER) String() string {
 }
 
 func (v eventRcvCER) exec(c *Connection) error {
+	n := c.local()
+	if v.resume != nil {
+		defer func() { v.resume <- c.conn }()
+	}
 	var err error
 	if c.state != waitCER {
 		err = RejectRxMessage{
 			State: c.state, ErrMsg: "CER is not acceptable"}
 	}
-	if TraceMessage != nil {
-		TraceMessage(v.m, Rx, err)
+	if n.TraceMessage != nil {
+		n.TraceMessage(v.m, Rx, err)
 	}
 	if err != nil {
 		return err
@@ -73,14 +78,13 @@ func (v eventRcvCER) exec(c *Connection) error {
 	var prodName string
 	var oState uint32
 	var supportVendor = []uint32{}
-	var authApps = make(map[uint32]uint32)
+	var peerApps = make(map[uint32]application)
+	var peerSecurity []uint32
 	// var firmwareRevision uint32
 
-	for rdr := bytes.NewReader(v.m.AVPs); rdr.Len() != 0; {
-		a := AVP{}
-		if err = a.wrapedUnmarshalFrom(rdr); err != nil {
-			break
-		}
+	it := NewAVPIterator(v.m.AVPs)
+	for it.Next() {
+		a := it.AVP()
 		if a.VendorID != 0 {
 			if a.Mandatory {
 				err = InvalidAVP{Code: AvpUnsupported, AVP: a}
@@ -128,14 +132,23 @@ func (v eventRcvCER) exec(c *Connection) error {
 		case 258:
 			var aid uint32
 			if aid, err = GetAuthAppID(a); err == nil {
-				authApps[aid] = 0
+				peerApps[aid] = application{}
+			}
+		case 259:
+			var aid uint32
+			if aid, err = GetAcctAppID(a); err == nil {
+				peerApps[aid] = application{acct: true}
 			}
 		case 299:
-			_, err = getInbandSecurityID(a)
+			var sid uint32
+			if sid, err = getInbandSecurityID(a); err == nil {
+				peerSecurity = append(peerSecurity, sid)
+			}
 		case 260:
 			var vid, aid uint32
-			if vid, aid, err = GetVendorSpecAppID(a); err == nil {
-				authApps[aid] = vid
+			var acct bool
+			if vid, aid, acct, err = getVendorSpecApp(a); err == nil {
+				peerApps[aid] = application{venID: vid, acct: acct}
 			}
 		case 278:
 			if oState != 0 {
@@ -155,8 +168,11 @@ func (v eventRcvCER) exec(c *Connection) error {
 			break
 		}
 	}
+	if err == nil {
+		err = it.Err()
+	}
 
-	if len(authApps) == 0 {
+	if len(peerApps) == 0 {
 		err = InvalidAVP{Code: MissingAvp, AVP: SetAuthAppID(0)}
 	} else if err == nil {
 		for _, vid := range supportVendor {
@@ -166,27 +182,30 @@ func (v eventRcvCER) exec(c *Connection) error {
 			// ToDo: verify common supported AVP vendor
 		}
 
-		if _, ok := authApps[0xffffffff]; ok {
-			if len(applications) != 0 {
-				for aid, app := range applications {
+		apps := n.loadApplications()
+		if _, ok := peerApps[0xffffffff]; ok {
+			if len(apps) != 0 {
+				for aid, app := range apps {
 					c.commonApp[aid] = app
 				}
 			}
-		} else if len(applications) == 0 {
-			for aid, vid := range authApps {
+		} else if len(apps) == 0 {
+			for aid, papp := range peerApps {
 				c.commonApp[aid] = application{
-					venID:    vid,
+					venID:    papp.venID,
+					acct:     papp.acct,
 					handlers: make(map[uint32]Handler)}
 			}
 		} else {
-			for laid, lapp := range applications {
-				if pvid, ok := authApps[laid]; ok && pvid == lapp.venID {
+			for laid, lapp := range apps {
+				if papp, ok := peerApps[laid]; ok &&
+					papp.venID == lapp.venID && papp.acct == lapp.acct {
 					c.commonApp[laid] = lapp
 				}
 			}
 			if len(c.commonApp) == 0 {
 				rap := "required applications are "
-				for k := range authApps {
+				for k := range peerApps {
 					rap = fmt.Sprintf("%s, %d", rap, k)
 				}
 				err = InvalidMessage{
@@ -196,6 +215,7 @@ func (v eventRcvCER) exec(c *Connection) error {
 		}
 	}
 
+	security, secOK := selectInbandSecurity(n.TLSConfig, peerSecurity)
 	result := Success
 	if v.m.FlgP || v.m.FlgT {
 		result = InvalidHdrBits
@@ -228,6 +248,13 @@ func (v eventRcvCER) exec(c *Connection) error {
 	} else if len(hostIP) == 0 {
 		result = MissingAvp
 		err = InvalidAVP{Code: result, AVP: setHostIPAddress(net.IPv4zero)}
+	} else if !secOK {
+		result = NoCommonSecurity
+		err = InvalidMessage{
+			Code: result, ErrMsg: "no common security mechanism"}
+	} else if e := c.verifyTransport(oHost); e != nil {
+		result = UnknownPeer
+		err = e
 		/*
 			} else if venID == 0 {
 				result = MissingAvp
@@ -236,7 +263,27 @@ func (v eventRcvCER) exec(c *Connection) error {
 				result = MissingAvp
 				err = InvalidAVP{Code: result, AVP: setProductName("")}
 		*/
+	} else if p := n.LookupPeer(oHost); p != nil && p != c && p.isOpen() {
+		result = UnableToComply
+		err = InvalidMessage{
+			Code: result,
+			ErrMsg: fmt.Sprintf(
+				"connection with peer %s is already open", oHost)}
+	} else if p != nil && p != c && n.Host.less(oHost) {
+		result = ElectionLost
+		err = InvalidMessage{
+			Code: result,
+			ErrMsg: fmt.Sprintf(
+				"election with peer %s is lost", oHost)}
 	} else {
+		if p != nil && p != c {
+			// election won, close the connection initiated by local
+			if con := p.snapshot().conn; con != nil {
+				con.Close()
+			}
+		}
+		n.setPeer(oHost, c)
+
 		if oState != 0 {
 			c.stateID = oState
 		}
@@ -246,11 +293,11 @@ func (v eventRcvCER) exec(c *Connection) error {
 
 	buf := new(bytes.Buffer)
 	SetResultCode(result).MarshalTo(buf)
-	SetOriginHost(Host).MarshalTo(buf)
-	SetOriginRealm(Realm).MarshalTo(buf)
+	SetOriginHost(n.Host).MarshalTo(buf)
+	SetOriginRealm(n.Realm).MarshalTo(buf)
 
-	if len(OverwriteAddr) != 0 {
-		for _, h := range OverwriteAddr {
+	if len(n.OverwriteAddr) != 0 {
+		for _, h := range n.OverwriteAddr {
 			setHostIPAddress(h).MarshalTo(buf)
 		}
 	} else {
@@ -263,61 +310,55 @@ func (v eventRcvCER) exec(c *Connection) error {
 	SetVendorID(VendorID).MarshalTo(buf)
 	setProductName(ProductName).MarshalTo(buf)
 
-	if stateID != 0 {
-		setOriginStateID(stateID).MarshalTo(buf)
+	if n.StateID != 0 {
+		setOriginStateID(n.StateID).MarshalTo(buf)
 	}
 	if err != nil {
 	} else if len(c.commonApp) == 0 {
 		SetAuthAppID(0xffffffff).MarshalTo(buf)
 	} else {
-		vmap := make(map[uint32]interface{})
-		for aid, app := range c.commonApp {
-			if app.venID == 0 {
-				SetAuthAppID(aid).MarshalTo(buf)
-			} else if _, ok := vmap[app.venID]; ok {
-				SetVendorSpecAppID(app.venID, aid).MarshalTo(buf)
-			} else {
-				setSupportedVendorID(app.venID).MarshalTo(buf)
-				SetVendorSpecAppID(app.venID, aid).MarshalTo(buf)
-				vmap[app.venID] = nil
-			}
-		}
+		marshalApplications(buf, c.commonApp)
 	}
 
-	if iavp, ok := err.(InvalidAVP); ok {
-		setFailedAVP([]AVP{iavp.AVP}).MarshalTo(buf)
+	if err == nil && security != noInbandSecurity {
+		setInbandSecurityID(security).MarshalTo(buf)
+	}
+
+	if err != nil {
+		marshalErrorTo(buf, err)
 	}
 	setFirmwareRevision(FirmwareRev).MarshalTo(buf)
 
 	cea := Message{
-		FlgR: false, FlgP: false, FlgE: result != Success, FlgT: false,
+		FlgR: false, FlgP: false, FlgE: IsProtocolError(result), FlgT: false,
 		Code: 257, AppID: 0,
 		HbHID: v.m.HbHID, EtEID: v.m.EtEID,
 		AVPs: buf.Bytes()}
 
-	if e := cea.MarshalTo(c.conn); e != nil {
-		err = TransportTxError{err: e}
+	if e := c.write(cea, err); e != nil {
+		err = e
 		c.notify <- eventPeerDisc{reason: err}
-	} else if err == nil {
+	} else if result == ElectionLost || result == UnableToComply {
+		c.notify <- eventPeerDisc{reason: err}
+	} else if err != nil {
+	} else if e := c.upgradeTransport(security, true); e != nil {
+		err = e
+		c.notify <- eventPeerDisc{reason: err}
+	} else {
 		c.state = open
 		// wdTimer.Stop()
-		c.wdTimer = time.AfterFunc(WDInterval, func() {
+		c.wdTimer = time.AfterFunc(n.WDInterval, func() {
 			c.notify <- eventWatchdog{}
 		})
-		if ConnectionUpNotify != nil {
-			ConnectionUpNotify(c)
-		}
 	}
 
-	if TraceMessage != nil {
-		TraceMessage(cea, Tx, err)
-	}
 	return err
 }
 
 // RcvCEA
 type eventRcvCEA struct {
-	m Message
+	m      Message
+	resume chan net.Conn // transport connection for reader after CER/CEA
 }
 
 func (eventRcvCEA) String() string {
@@ -325,6 +366,10 @@ func (eventRcvCEA) String() string {
 }
 
 func (v eventRcvCEA) exec(c *Connection) error {
+	n := c.local()
+	if v.resume != nil {
+		defer func() { v.resume <- c.conn }()
+	}
 	var err error
 
 	if v.m.FlgP {
@@ -341,8 +386,8 @@ func (v eventRcvCEA) exec(c *Connection) error {
 	}
 
 	if err != nil {
-		if TraceMessage != nil {
-			TraceMessage(v.m, Rx, err)
+		if n.TraceMessage != nil {
+			n.TraceMessage(v.m, Rx, err)
 		}
 		return err
 	}
@@ -358,14 +403,13 @@ func (v eventRcvCEA) exec(c *Connection) error {
 	var errorMsg string
 	var failedAVP []AVP
 	var supportVendor = []uint32{}
-	var authApps = make(map[uint32]uint32)
+	var peerApps = make(map[uint32]application)
+	var peerSecurity []uint32
 	// var firmwareRevision uint32
 
-	for rdr := bytes.NewReader(v.m.AVPs); rdr.Len() != 0; {
-		a := AVP{}
-		if err = a.wrapedUnmarshalFrom(rdr); err != nil {
-			break
-		}
+	it := NewAVPIterator(v.m.AVPs)
+	for it.Next() {
+		a := it.AVP()
 		if a.VendorID != 0 {
 			if a.Mandatory {
 				err = InvalidAVP{Code: AvpUnsupported, AVP: a}
@@ -419,14 +463,23 @@ func (v eventRcvCEA) exec(c *Connection) error {
 		case 258:
 			var aid uint32
 			if aid, err = GetAuthAppID(a); err == nil {
-				authApps[aid] = 0
+				peerApps[aid] = application{}
+			}
+		case 259:
+			var aid uint32
+			if aid, err = GetAcctAppID(a); err == nil {
+				peerApps[aid] = application{acct: true}
 			}
 		case 299:
-			_, err = getInbandSecurityID(a)
+			var sid uint32
+			if sid, err = getInbandSecurityID(a); err == nil {
+				peerSecurity = append(peerSecurity, sid)
+			}
 		case 260:
 			var vid, aid uint32
-			if vid, aid, err = GetVendorSpecAppID(a); err == nil {
-				authApps[aid] = vid
+			var acct bool
+			if vid, aid, acct, err = getVendorSpecApp(a); err == nil {
+				peerApps[aid] = application{venID: vid, acct: acct}
 			}
 		case 278:
 			if oState != 0 {
@@ -450,8 +503,11 @@ func (v eventRcvCEA) exec(c *Connection) error {
 			break
 		}
 	}
+	if err == nil {
+		err = it.Err()
+	}
 
-	if err == nil && len(authApps) == 0 {
+	if err == nil && len(peerApps) == 0 {
 		err = InvalidAVP{Code: MissingAvp, AVP: SetAuthAppID(0)}
 	}
 	if err == nil {
@@ -463,27 +519,30 @@ func (v eventRcvCEA) exec(c *Connection) error {
 		}
 	}
 	if err == nil {
-		if _, ok := authApps[0xffffffff]; ok {
-			if len(applications) != 0 {
-				for aid, app := range applications {
+		apps := n.loadApplications()
+		if _, ok := peerApps[0xffffffff]; ok {
+			if len(apps) != 0 {
+				for aid, app := range apps {
 					c.commonApp[aid] = app
 				}
 			}
-		} else if len(applications) == 0 {
-			for aid, vid := range authApps {
+		} else if len(apps) == 0 {
+			for aid, papp := range peerApps {
 				c.commonApp[aid] = application{
-					venID:    vid,
+					venID:    papp.venID,
+					acct:     papp.acct,
 					handlers: make(map[uint32]Handler)}
 			}
 		} else {
-			for laid, lapp := range applications {
-				if pvid, ok := authApps[laid]; ok && pvid == lapp.venID {
+			for laid, lapp := range apps {
+				if papp, ok := peerApps[laid]; ok &&
+					papp.venID == lapp.venID && papp.acct == lapp.acct {
 					c.commonApp[laid] = lapp
 				}
 			}
 			if len(c.commonApp) == 0 {
 				rap := "required applications are "
-				for k := range authApps {
+				for k := range peerApps {
 					rap = fmt.Sprintf("%s, %d", rap, k)
 				}
 				err = InvalidMessage{
@@ -503,24 +562,31 @@ func (v eventRcvCEA) exec(c *Connection) error {
 		err = InvalidAVP{Code: MissingAvp, AVP: SetOriginHost("")}
 	} else if len(oRealm) == 0 {
 		err = InvalidAVP{Code: MissingAvp, AVP: SetOriginRealm("")}
-	} else if oHost != c.Host && oHost != Host {
+	} else if oHost != c.Host && oHost != n.Host {
 		err = InvalidMessage{
 			Code: UnknownPeer,
 			ErrMsg: fmt.Sprintf(
 				"peer host %s is not match with %s or %s",
-				oHost, c.Host, Host)}
-	} else if oRealm != c.Realm && oRealm != Realm {
+				oHost, c.Host, n.Host)}
+	} else if oRealm != c.Realm && oRealm != n.Realm {
 		err = InvalidMessage{
 			Code: UnknownPeer,
 			ErrMsg: fmt.Sprintf(
 				"peer realm %s is not match with %s or %s",
-				oRealm, c.Realm, Host)}
+				oRealm, c.Realm, n.Host)}
 	} else if result != Success {
 		err = FailureAnswer{Code: result, ErrMsg: errorMsg, Avps: failedAVP}
 	} else if err != nil {
 		// invalid AVP value
 	} else if len(hostIP) == 0 {
 		err = InvalidAVP{Code: MissingAvp, AVP: setHostIPAddress(net.IPv4zero)}
+	} else if e := c.verifyTransport(oHost); e != nil {
+		err = e
+	} else if security, ok := selectInbandSecurity(n.TLSConfig, peerSecurity); !ok {
+		err = InvalidMessage{
+			Code: NoCommonSecurity, ErrMsg: "no common security mechanism"}
+	} else if e := c.upgradeTransport(security, false); e != nil {
+		err = e
 		/*
 			} else if venID == 0 {
 				err = InvalidAVP{Code: MissingAvp, AVP: SetVendorID(0)}
@@ -534,17 +600,21 @@ func (v eventRcvCEA) exec(c *Connection) error {
 
 		c.state = open
 		c.wdTimer.Stop()
-		c.wdTimer = time.AfterFunc(WDInterval, func() {
+		c.wdTimer = time.AfterFunc(n.WDInterval, func() {
 			c.notify <- eventWatchdog{}
 		})
 		delete(c.sndQueue, v.m.HbHID)
 		//ch <- v.m
-		if ConnectionUpNotify != nil {
-			ConnectionUpNotify(c)
+
+		if p := n.LookupPeer(c.Host); p != nil && p != c && p.isOpen() {
+			// duplicated connection is opened by peer
+			c.notify <- eventStop{cause: DoNotWantToTalkToYou}
+		} else {
+			n.setPeer(c.Host, c)
 		}
 	}
-	if TraceMessage != nil {
-		TraceMessage(v.m, Rx, err)
+	if n.TraceMessage != nil {
+		n.TraceMessage(v.m, Rx, err)
 	}
 
 	if err != nil {
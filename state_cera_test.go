@@ -0,0 +1,181 @@
+package diameter
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// pendingConnection starts connection of node n to host that never answers CER.
+// Returned connection is in waitCEA state and registered as peer of n.
+func pendingConnection(t *testing.T, n *Node, host Identity) *Connection {
+	t.Helper()
+	dc, lc := tcpPair(t)
+	go func() {
+		// drain CER without answer
+		b := make([]byte, 1024)
+		for {
+			if _, e := lc.Read(b); e != nil {
+				return
+			}
+		}
+	}()
+	c := &Connection{Local: n, Host: host, Realm: "local"}
+	done := make(chan error, 1)
+	go func() { done <- c.DialAndServe(dc) }()
+	t.Cleanup(func() {
+		dc.Close()
+		lc.Close()
+		<-done
+	})
+	waitState(t, c, "waitCEA")
+	return c
+}
+
+func TestElection(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		rcv, ini    Identity
+		receiverWin bool
+	}{
+		{"receiver wins", "b.local", "a.local", true},
+		{"receiver loses", "a.local", "b.local", false},
+		{"receiver wins with upper case", "B.local", "a.local", true},
+		{"receiver loses with upper case", "a.local", "B.local", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rcv := NewNode(tc.rcv, "local")
+			ini := NewNode(tc.ini, "local")
+			t.Cleanup(func() {
+				rcv.Close()
+				ini.Close()
+			})
+			rcv.WDInterval = time.Second * 5
+			ini.WDInterval = time.Second * 5
+
+			var lock sync.Mutex
+			var result error
+			ini.TraceMessage = func(m Message, d Direction, err error) {
+				if m.Code == 257 && !m.FlgR && d == Rx {
+					lock.Lock()
+					result = err
+					lock.Unlock()
+				}
+			}
+
+			// receiver is connecting to initiator at the same time
+			pending := pendingConnection(t, rcv, ini.Host)
+
+			dc, lc := tcpPair(t)
+			ci, cr, ok := servePair(t, ini, rcv, dc, lc)
+			if ok != tc.receiverWin {
+				t.Fatalf("connection from initiator is opened=%t", ok)
+			}
+
+			if tc.receiverWin {
+				// connection initiated by the loser is closed
+				waitState(t, pending, "closed")
+				if p := rcv.LookupPeer(ini.Host); p != cr {
+					t.Error("accepted connection is not registered as peer")
+				}
+				return
+			}
+
+			waitState(t, ci, "closed")
+			waitState(t, cr, "closed")
+			if s := pending.State(); s != "waitCEA" {
+				t.Errorf("connection initiated by winner is %s", s)
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			var fa FailureAnswer
+			if !errors.As(result, &fa) || fa.Code != ElectionLost {
+				t.Errorf("CEA result is %v, not ElectionLost", result)
+			}
+		})
+	}
+}
+
+func TestElectionAlreadyOpen(t *testing.T) {
+	p := newTestPeers(t, nil)
+	// other node with the same host name as b connects to a
+	b2 := NewNode(p.b.Host, p.b.Realm)
+	defer b2.Close()
+	b2.WDInterval = time.Second * 5
+
+	dc, lc := tcpPair(t)
+	_, _, ok := servePair(t, b2, p.a, dc, lc)
+	if ok {
+		t.Error("duplicated connection is opened")
+	}
+	if c := p.a.LookupPeer(p.b.Host); c != p.ca {
+		t.Error("open connection is replaced")
+	}
+	if s := p.ca.State(); s != "open" {
+		t.Errorf("open connection is %s", s)
+	}
+	p.ca.Close(Rebooting)
+	p.wait(t)
+}
+
+func TestElectionDoNotWantToTalkToYou(t *testing.T) {
+	a, b := newTLSNodes(t)
+
+	// a connects to fake b that answers CER later
+	dc, lc := tcpPair(t)
+	t.Cleanup(func() {
+		dc.Close()
+		lc.Close()
+	})
+	c := &Connection{Local: a, Host: b.Host, Realm: b.Realm}
+	done := make(chan error, 1)
+	go func() { done <- c.DialAndServe(dc) }()
+	var cer Message
+	if err := cer.UnmarshalFrom(lc); err != nil {
+		t.Fatal(err)
+	}
+
+	// other connection with b is opened before CEA
+	open := connectNode(t, a, b)
+
+	cea := NewAnswer(cer).Add(
+		SetResultCode(Success),
+		SetOriginHost(b.Host), SetOriginRealm(b.Realm),
+		setHostIPAddress(net.IPv4(127, 0, 0, 1)),
+		SetVendorID(0), setProductName("test"),
+		SetAuthAppID(0xffffffff)).Message()
+	if err := cea.MarshalTo(lc); err != nil {
+		t.Fatal(err)
+	}
+
+	// duplicated connection is closed with DPR
+	_ = lc.SetReadDeadline(time.Now().Add(time.Second * 5))
+	var dpr Message
+	if err := dpr.UnmarshalFrom(lc); err != nil {
+		t.Fatal(err)
+	}
+	if dpr.Code != 282 || !dpr.FlgR {
+		t.Fatalf("message code %d is not DPR", dpr.Code)
+	}
+	avps, err := dpr.GetAVP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cause := Enumerated(-1)
+	for _, a := range avps {
+		if a.Code == 273 {
+			cause, _ = getDisconnectCause(a)
+		}
+	}
+	if cause != DoNotWantToTalkToYou {
+		t.Errorf("Disconnect-Cause is %d, not DoNotWantToTalkToYou", cause)
+	}
+	if pc := a.LookupPeer(b.Host); pc != open {
+		t.Error("open connection is replaced")
+	}
+
+	lc.Close()
+	<-done
+}
@@ -33,13 +33,14 @@ func (eventRcvDPR) String() string {
 }
 
 func (v eventRcvDPR) exec(c *Connection) error {
+	n := c.local()
 	var err error
 	if c.state != open && c.state != locked {
 		err = RejectRxMessage{
 			State: c.state, ErrMsg: "DPR is not acceptable"}
 	}
-	if TraceMessage != nil {
-		TraceMessage(v.m, Rx, err)
+	if n.TraceMessage != nil {
+		n.TraceMessage(v.m, Rx, err)
 	}
 	if err != nil {
 		return err
@@ -50,11 +51,9 @@ func (v eventRcvDPR) exec(c *Connection) error {
 	var oRealm Identity
 	cause := Enumerated(-1)
 
-	for rdr := bytes.NewReader(v.m.AVPs); rdr.Len() != 0; {
-		a := AVP{}
-		if err = a.wrapedUnmarshalFrom(rdr); err != nil {
-			break
-		}
+	it := NewAVPIterator(v.m.AVPs)
+	for it.Next() {
+		a := it.AVP()
 		if a.VendorID != 0 {
 			if a.Mandatory {
 				err = InvalidAVP{Code: AvpUnsupported, AVP: a}
@@ -92,6 +91,9 @@ func (v eventRcvDPR) exec(c *Connection) error {
 			break
 		}
 	}
+	if err == nil {
+		err = it.Err()
+	}
 
 	result := Success
 	if v.m.FlgP || v.m.FlgT {
@@ -127,32 +129,29 @@ func (v eventRcvDPR) exec(c *Connection) error {
 
 	buf := new(bytes.Buffer)
 	SetResultCode(result).MarshalTo(buf)
-	SetOriginHost(Host).MarshalTo(buf)
-	SetOriginRealm(Realm).MarshalTo(buf)
-	if iavp, ok := err.(InvalidAVP); ok {
-		setFailedAVP([]AVP{iavp.AVP}).MarshalTo(buf)
+	SetOriginHost(n.Host).MarshalTo(buf)
+	SetOriginRealm(n.Realm).MarshalTo(buf)
+	if err != nil {
+		marshalErrorTo(buf, err)
 	}
 
 	dpa := Message{
-		FlgR: false, FlgP: false, FlgE: result != Success, FlgT: false,
+		FlgR: false, FlgP: false, FlgE: IsProtocolError(result), FlgT: false,
 		Code: 282, AppID: 0,
 		HbHID: v.m.HbHID, EtEID: v.m.EtEID,
 		AVPs: buf.Bytes()}
 
-	if e := dpa.MarshalTo(c.conn); e != nil {
-		err = TransportTxError{err: e}
+	if e := c.write(dpa, err); e != nil {
+		err = e
 		c.notify <- eventPeerDisc{reason: err}
 	} else if err == nil {
 		c.state = closing
 		c.wdTimer.Stop()
-		c.wdTimer = time.AfterFunc(WDInterval, func() {
+		c.wdTimer = time.AfterFunc(n.WDInterval, func() {
 			c.notify <- eventPeerDisc{}
 		})
 	}
 
-	if TraceMessage != nil {
-		TraceMessage(dpa, Tx, err)
-	}
 	return err
 }
 
@@ -165,6 +164,7 @@ func (eventRcvDPA) String() string {
 }
 
 func (v eventRcvDPA) exec(c *Connection) error {
+	n := c.local()
 	var err error
 
 	if v.m.FlgP {
@@ -181,8 +181,8 @@ func (v eventRcvDPA) exec(c *Connection) error {
 	}
 
 	if err != nil {
-		if TraceMessage != nil {
-			TraceMessage(v.m, Rx, err)
+		if n.TraceMessage != nil {
+			n.TraceMessage(v.m, Rx, err)
 		}
 		return err
 	}
@@ -193,11 +193,9 @@ func (v eventRcvDPA) exec(c *Connection) error {
 	// var errorMsg string
 	// var failedAVP []AVP
 
-	for rdr := bytes.NewReader(v.m.AVPs); rdr.Len() != 0; {
-		a := AVP{}
-		if err = a.wrapedUnmarshalFrom(rdr); err != nil {
-			break
-		}
+	it := NewAVPIterator(v.m.AVPs)
+	for it.Next() {
+		a := it.AVP()
 		if a.VendorID != 0 {
 			if a.Mandatory {
 				err = InvalidAVP{Code: AvpUnsupported, AVP: a}
@@ -239,6 +237,9 @@ func (v eventRcvDPA) exec(c *Connection) error {
 			break
 		}
 	}
+	if err == nil {
+		err = it.Err()
+	}
 
 	if v.m.FlgE && result == Success {
 		err = InvalidMessage{
@@ -250,18 +251,18 @@ func (v eventRcvDPA) exec(c *Connection) error {
 		err = InvalidAVP{Code: MissingAvp, AVP: SetOriginHost("")}
 	} else if len(oRealm) == 0 {
 		err = InvalidAVP{Code: MissingAvp, AVP: SetOriginRealm("")}
-	} else if oHost != c.Host && oHost != Host {
+	} else if oHost != c.Host && oHost != n.Host {
 		err = InvalidMessage{
 			Code: UnknownPeer,
 			ErrMsg: fmt.Sprintf(
 				"peer host %s is not match with %s or %s",
-				oHost, c.Host, Host)}
-	} else if oRealm != c.Realm && oRealm != Realm {
+				oHost, c.Host, n.Host)}
+	} else if oRealm != c.Realm && oRealm != n.Realm {
 		err = InvalidMessage{
 			Code: UnknownPeer,
 			ErrMsg: fmt.Sprintf(
 				"peer realm %s is not match with %s or %s",
-				oRealm, c.Realm, Host)}
+				oRealm, c.Realm, n.Host)}
 	} else if result != Success {
 		err = FailureAnswer{Code: result}
 		delete(c.sndQueue, v.m.HbHID)
@@ -273,8 +274,8 @@ func (v eventRcvDPA) exec(c *Connection) error {
 		c.notify <- eventPeerDisc{}
 	}
 
-	if TraceMessage != nil {
-		TraceMessage(v.m, Rx, err)
+	if n.TraceMessage != nil {
+		n.TraceMessage(v.m, Rx, err)
 	}
 	return err
 }
@@ -35,13 +35,14 @@ func (eventRcvDWR) String() string {
 }
 
 func (v eventRcvDWR) exec(c *Connection) error {
+	n := c.local()
 	var err error
 	if c.state != open && c.state != locked {
 		err = RejectRxMessage{
 			State: c.state, ErrMsg: "DWR is not acceptable"}
 	}
-	if TraceMessage != nil {
-		TraceMessage(v.m, Rx, err)
+	if n.TraceMessage != nil {
+		n.TraceMessage(v.m, Rx, err)
 	}
 	if err != nil {
 		return err
@@ -51,11 +52,9 @@ func (v eventRcvDWR) exec(c *Connection) error {
 	var oRealm Identity
 	var oState uint32
 
-	for rdr := bytes.NewReader(v.m.AVPs); rdr.Len() != 0; {
-		a := AVP{}
-		if err = a.wrapedUnmarshalFrom(rdr); err != nil {
-			break
-		}
+	it := NewAVPIterator(v.m.AVPs)
+	for it.Next() {
+		a := it.AVP()
 		if a.VendorID != 0 {
 			if a.Mandatory {
 				err = InvalidAVP{Code: AvpUnsupported, AVP: a}
@@ -93,6 +92,9 @@ func (v eventRcvDWR) exec(c *Connection) error {
 			break
 		}
 	}
+	if err == nil {
+		err = it.Err()
+	}
 
 	result := Success
 	if v.m.FlgP || v.m.FlgT {
@@ -131,32 +133,29 @@ func (v eventRcvDWR) exec(c *Connection) error {
 
 	buf := new(bytes.Buffer)
 	SetResultCode(result).MarshalTo(buf)
-	SetOriginHost(Host).MarshalTo(buf)
-	SetOriginRealm(Realm).MarshalTo(buf)
-	if stateID != 0 {
-		setOriginStateID(stateID).MarshalTo(buf)
+	SetOriginHost(n.Host).MarshalTo(buf)
+	SetOriginRealm(n.Realm).MarshalTo(buf)
+	if n.StateID != 0 {
+		setOriginStateID(n.StateID).MarshalTo(buf)
 	}
-	if iavp, ok := err.(InvalidAVP); ok {
-		setFailedAVP([]AVP{iavp.AVP}).MarshalTo(buf)
+	if err != nil {
+		marshalErrorTo(buf, err)
 	}
 
 	dwa := Message{
-		FlgR: false, FlgP: false, FlgE: result != Success, FlgT: false,
+		FlgR: false, FlgP: false, FlgE: IsProtocolError(result), FlgT: false,
 		Code: 280, AppID: 0,
 		HbHID: v.m.HbHID, EtEID: v.m.EtEID,
 		AVPs: buf.Bytes()}
 
-	if e := dwa.MarshalTo(c.conn); e != nil {
-		err = TransportTxError{err: e}
+	if e := c.write(dwa, err); e != nil {
+		err = e
 		c.notify <- eventPeerDisc{reason: err}
 	} else if err == nil && c.wdCount == 0 {
 		c.wdTimer.Stop()
-		c.wdTimer.Reset(WDInterval)
+		c.wdTimer.Reset(n.WDInterval)
 	}
 
-	if TraceMessage != nil {
-		TraceMessage(dwa, Tx, err)
-	}
 	return err
 }
 
@@ -170,6 +169,7 @@ func (eventRcvDWA) String() string {
 }
 
 func (v eventRcvDWA) exec(c *Connection) error {
+	n := c.local()
 	var err error
 
 	if v.m.FlgP {
@@ -186,8 +186,8 @@ func (v eventRcvDWA) exec(c *Connection) error {
 	}
 
 	if err != nil {
-		if TraceMessage != nil {
-			TraceMessage(v.m, Rx, err)
+		if n.TraceMessage != nil {
+			n.TraceMessage(v.m, Rx, err)
 		}
 		return err
 	}
@@ -199,11 +199,9 @@ func (v eventRcvDWA) exec(c *Connection) error {
 	// var failedAVP []AVP
 	var oState uint32
 
-	for rdr := bytes.NewReader(v.m.AVPs); rdr.Len() != 0; {
-		a := AVP{}
-		if err = a.wrapedUnmarshalFrom(rdr); err != nil {
-			break
-		}
+	it := NewAVPIterator(v.m.AVPs)
+	for it.Next() {
+		a := it.AVP()
 		if a.VendorID != 0 {
 			if a.Mandatory {
 				err = InvalidAVP{Code: AvpUnsupported, AVP: a}
@@ -251,6 +249,9 @@ func (v eventRcvDWA) exec(c *Connection) error {
 			break
 		}
 	}
+	if err == nil {
+		err = it.Err()
+	}
 
 	if v.m.FlgE && result == Success {
 		err = InvalidMessage{
@@ -262,21 +263,21 @@ func (v eventRcvDWA) exec(c *Connection) error {
 		err = InvalidAVP{Code: MissingAvp, AVP: SetOriginHost("")}
 	} else if len(oRealm) == 0 {
 		err = InvalidAVP{Code: MissingAvp, AVP: SetOriginRealm("")}
-	} else if oHost != c.Host && oHost != Host {
+	} else if oHost != c.Host && oHost != n.Host {
 		err = InvalidMessage{
 			Code: UnknownPeer,
 			ErrMsg: fmt.Sprintf(
 				"peer host %s is not match with %s or %s",
-				oHost, c.Host, Host)}
-	} else if oRealm != c.Realm && oRealm != Realm {
+				oHost, c.Host, n.Host)}
+	} else if oRealm != c.Realm && oRealm != n.Realm {
 		err = InvalidMessage{
 			Code: UnknownPeer,
 			ErrMsg: fmt.Sprintf(
 				"peer realm %s is not match with %s or %s",
-				oRealm, c.Realm, Host)}
+				oRealm, c.Realm, n.Host)}
 	} else if oState != 0 && oState != c.stateID {
 		err = errors.New("peer may be abruptly restarted")
-		c.Close(Rebooting)
+		c.notify <- eventStop{Rebooting}
 	} else if result == Success && err != nil {
 		// invalid AVP value
 	} else {
@@ -287,13 +288,13 @@ func (v eventRcvDWA) exec(c *Connection) error {
 		}
 		delete(c.sndQueue, v.m.HbHID)
 		c.wdTimer.Stop()
-		c.wdTimer = time.AfterFunc(WDInterval, func() {
+		c.wdTimer = time.AfterFunc(n.WDInterval, func() {
 			c.notify <- eventWatchdog{}
 		})
 	}
 
-	if TraceMessage != nil {
-		TraceMessage(v.m, Rx, err)
+	if n.TraceMessage != nil {
+		n.TraceMessage(v.m, Rx, err)
 	}
 	return err
 }
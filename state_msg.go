@@ -1,8 +1,6 @@
 package diameter
 
 import (
-	"bytes"
-	"errors"
 	"fmt"
 )
 
@@ -15,57 +13,50 @@ func (eventRcvReq) String() string {
 }
 
 func (v eventRcvReq) exec(c *Connection) error {
+	n := c.local()
 	var err error
 	if c.state == closed || c.state == waitCER || c.state == waitCEA {
 		err = RejectRxMessage{
 			State: c.state, ErrMsg: "Request Message is not acceptable"}
 	}
-	if TraceMessage != nil {
-		TraceMessage(v.m, Rx, err)
+	if n.TraceMessage != nil {
+		n.TraceMessage(v.m, Rx, err)
 	}
 	if err != nil {
 		return err
 	}
 
-	var ch chan Message
-	// Auth-Session-State AVP=STATE_MAINTAINED
-	if bytes.Contains(v.m.AVPs, []byte{
-		0x00, 0x00, 0x01, 0x15, 0x00, 0x00, 0x00, 0x0c, 0x00, 0x00, 0x00, 0x00}) {
-		ch = c.rcvQueue
-	} else {
-		ch = sharedQ
-	}
-	v.m.notify = c.notify
+	v.m.rcv = c
 
 	result := Success
 	if c.state == locked {
 		result = UnableToDeliver
-	} else if len(ch) == cap(ch) {
-		result = TooBusy
-		err = errors.New("too busy, receive queue is full")
-	} else if len(c.commonApp) == 0 {
-		ch <- v.m
-	} else if _, ok := c.commonApp[v.m.AppID]; ok {
-		ch <- v.m
-	} else {
+	} else if _, ok := c.commonApp[v.m.AppID]; !ok && len(c.commonApp) != 0 {
 		result = ApplicationUnsupported
 		err = InvalidMessage{
 			Code:   result,
 			ErrMsg: fmt.Sprintf("unknown application %d", v.m.AppID)}
+	} else if !n.dispatch(v.m) {
+		result = TooBusy
+		err = InvalidMessage{Code: result, ErrMsg: "receive queue is full"}
 	}
 
 	if c.wdCount == 0 {
 		c.wdTimer.Stop()
-		c.wdTimer.Reset(WDInterval)
+		c.wdTimer.Reset(n.WDInterval)
 	}
 	if result != Success {
-		ans := v.m.GenerateAnswerBy(result)
-		if e := ans.MarshalTo(c.conn); e != nil {
-			err = e
-			c.notify <- eventPeerDisc{reason: err}
+		var ans Message
+		if err != nil {
+			ans = v.m.GenerateAnswerByError(err)
+		} else {
+			ans = v.m.GenerateAnswerBy(result)
 		}
-		if TraceMessage != nil {
-			TraceMessage(ans, Tx, err)
+		if e := c.tryWrite(ans, err); e != nil {
+			if _, ok := e.(TransportTxError); ok {
+				c.notify <- eventPeerDisc{reason: e}
+			}
+			err = e
 		}
 	}
 
@@ -81,29 +72,30 @@ func (eventRcvAns) String() string {
 }
 
 func (v eventRcvAns) exec(c *Connection) (e error) {
+	n := c.local()
 	var err error
 
 	if c.state != open && c.state != locked {
 		err = InvalidMessage{
 			Code:   UnableToComply,
 			ErrMsg: "Answer Message is not acceptable in " + c.state.String() + " state"}
-	} else if ch, ok := c.sndQueue[v.m.HbHID]; ok {
-		ch <- v.m
+	} else if r, ok := c.sndQueue[v.m.HbHID]; ok {
+		r.ch <- v.m
 	} else {
 		err = InvalidMessage{
 			Code:   UnableToComply,
 			ErrMsg: "correlated request with the Hop-by-Hop ID not found"}
 	}
 
-	if TraceMessage != nil {
-		TraceMessage(v.m, Rx, err)
+	if n.TraceMessage != nil {
+		n.TraceMessage(v.m, Rx, err)
 	}
 	if err == nil {
 		delete(c.sndQueue, v.m.HbHID)
 
 		if c.wdCount == 0 {
 			c.wdTimer.Stop()
-			c.wdTimer.Reset(WDInterval)
+			c.wdTimer.Reset(n.WDInterval)
 		}
 	}
 
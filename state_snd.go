@@ -64,17 +64,18 @@ func (eventConnect) String() string {
 }
 
 func (v eventConnect) exec(c *Connection) error {
+	n := c.local()
 	if c.state != closed {
 		return notAcceptableEvent{e: v, s: c.state}
 	}
 	c.state = waitCEA
 
 	buf := new(bytes.Buffer)
-	SetOriginHost(Host).MarshalTo(buf)
-	SetOriginRealm(Realm).MarshalTo(buf)
+	SetOriginHost(n.Host).MarshalTo(buf)
+	SetOriginRealm(n.Realm).MarshalTo(buf)
 
-	if len(OverwriteAddr) != 0 {
-		for _, h := range OverwriteAddr {
+	if len(n.OverwriteAddr) != 0 {
+		for _, h := range n.OverwriteAddr {
 			setHostIPAddress(h).MarshalTo(buf)
 		}
 	} else {
@@ -86,27 +87,17 @@ func (v eventConnect) exec(c *Connection) error {
 
 	SetVendorID(VendorID).MarshalTo(buf)
 	setProductName(ProductName).MarshalTo(buf)
-	if stateID != 0 {
-		setOriginStateID(stateID).MarshalTo(buf)
+	if n.StateID != 0 {
+		setOriginStateID(n.StateID).MarshalTo(buf)
 	}
-	if len(applications) == 0 {
+	if apps := n.loadApplications(); len(apps) == 0 {
 		SetAuthAppID(0xffffffff).MarshalTo(buf)
 	} else {
-		vmap := make(map[uint32]interface{})
-		for aid, app := range applications {
-			if app.venID == 0 {
-				SetAuthAppID(aid).MarshalTo(buf)
-			} else if _, ok := vmap[app.venID]; ok {
-				SetVendorSpecAppID(app.venID, aid).MarshalTo(buf)
-			} else {
-				setSupportedVendorID(app.venID).MarshalTo(buf)
-				SetVendorSpecAppID(app.venID, aid).MarshalTo(buf)
-				vmap[app.venID] = nil
-			}
-		}
+		marshalApplications(buf, apps)
+	}
+	if n.TLSConfig != nil {
+		setInbandSecurityID(inbandTLS).MarshalTo(buf)
 	}
-	// Inband-Security-Id
-	// Acct-Application-Id
 	setFirmwareRevision(FirmwareRev).MarshalTo(buf)
 
 	cer := Message{
@@ -116,20 +107,15 @@ func (v eventConnect) exec(c *Connection) error {
 		AVPs:     buf.Bytes(),
 		PeerName: c.Host, PeerRealm: c.Realm}
 
-	c.sndQueue[cer.HbHID] = make(chan Message)
-	c.wdTimer = time.AfterFunc(WDInterval, func() {
-		c.notify <- eventRcvCEA{cer.GenerateAnswerBy(UnableToDeliver)}
+	c.sndQueue[cer.HbHID] = sndRequest{ch: make(chan Message)}
+	c.wdTimer = time.AfterFunc(n.WDInterval, func() {
+		c.notify <- eventRcvCEA{m: cer.GenerateAnswerBy(UnableToDeliver)}
 	})
 
-	err := cer.MarshalTo(c.conn)
+	err := c.write(cer, nil)
 	if err != nil {
-		err = TransportTxError{err: err}
 		c.notify <- eventPeerDisc{reason: err}
 	}
-
-	if TraceMessage != nil {
-		TraceMessage(cer, Tx, err)
-	}
 	return err
 }
 
@@ -141,12 +127,13 @@ func (eventWatchdog) String() string {
 }
 
 func (v eventWatchdog) exec(c *Connection) error {
+	n := c.local()
 	if c.state != open && c.state != locked {
 		return notAcceptableEvent{e: v, s: c.state}
 	}
 
 	c.wdCount++
-	if c.wdCount > WDMaxSend {
+	if c.wdCount > n.WDMaxSend {
 		err := fmt.Errorf("watchdog is expired")
 		c.notify <- eventPeerDisc{reason: err}
 		return err
@@ -154,10 +141,10 @@ func (v eventWatchdog) exec(c *Connection) error {
 	c.wdTimer.Stop()
 
 	buf := new(bytes.Buffer)
-	SetOriginHost(Host).MarshalTo(buf)
-	SetOriginRealm(Realm).MarshalTo(buf)
-	if stateID != 0 {
-		setOriginStateID(stateID).MarshalTo(buf)
+	SetOriginHost(n.Host).MarshalTo(buf)
+	SetOriginRealm(n.Realm).MarshalTo(buf)
+	if n.StateID != 0 {
+		setOriginStateID(n.StateID).MarshalTo(buf)
 	}
 
 	dwr := Message{
@@ -167,21 +154,16 @@ func (v eventWatchdog) exec(c *Connection) error {
 		AVPs:     buf.Bytes(),
 		PeerName: c.Host, PeerRealm: c.Realm}
 
-	c.sndQueue[dwr.HbHID] = make(chan Message)
-	c.wdTimer = time.AfterFunc(WDInterval, func() {
+	c.sndQueue[dwr.HbHID] = sndRequest{ch: make(chan Message)}
+	c.wdTimer = time.AfterFunc(n.WDInterval, func() {
 		c.notify <- eventRcvDWA{dwr.GenerateAnswerBy(UnableToDeliver)}
 		c.notify <- eventWatchdog{}
 	})
 
-	err := dwr.MarshalTo(c.conn)
+	err := c.write(dwr, nil)
 	if err != nil {
-		err = TransportTxError{err: err}
 		c.notify <- eventPeerDisc{reason: err}
 	}
-
-	if TraceMessage != nil {
-		TraceMessage(dwr, Tx, err)
-	}
 	return err
 }
 
@@ -210,6 +192,7 @@ func (eventStop) String() string {
 }
 
 func (v eventStop) exec(c *Connection) error {
+	n := c.local()
 	if c.state != open && c.state != locked {
 		err := notAcceptableEvent{e: v, s: c.state}
 		c.notify <- eventPeerDisc{reason: err}
@@ -219,8 +202,8 @@ func (v eventStop) exec(c *Connection) error {
 	c.wdTimer.Stop()
 
 	buf := new(bytes.Buffer)
-	SetOriginHost(Host).MarshalTo(buf)
-	SetOriginRealm(Realm).MarshalTo(buf)
+	SetOriginHost(n.Host).MarshalTo(buf)
+	SetOriginRealm(n.Realm).MarshalTo(buf)
 	setDisconnectCause(v.cause).MarshalTo(buf)
 
 	dpr := Message{
@@ -230,20 +213,15 @@ func (v eventStop) exec(c *Connection) error {
 		AVPs:     buf.Bytes(),
 		PeerName: c.Host, PeerRealm: c.Realm}
 
-	c.sndQueue[dpr.HbHID] = make(chan Message)
-	c.wdTimer = time.AfterFunc(WDInterval, func() {
+	c.sndQueue[dpr.HbHID] = sndRequest{ch: make(chan Message)}
+	c.wdTimer = time.AfterFunc(n.WDInterval, func() {
 		c.notify <- eventRcvDPA{dpr.GenerateAnswerBy(UnableToDeliver)}
 	})
 
-	err := dpr.MarshalTo(c.conn)
+	err := c.write(dpr, nil)
 	if err != nil {
-		err = TransportTxError{err: err}
 		c.notify <- eventPeerDisc{reason: err}
 	}
-
-	if TraceMessage != nil {
-		TraceMessage(dpr, Tx, err)
-	}
 	return err
 }
 
@@ -264,13 +242,15 @@ func (v eventPeerDisc) exec(c *Connection) error {
 		v.reason = nil
 	}
 
+	c.closeWriter()
 	c.conn.Close()
 	c.state = closed
 
-	for _, ch := range c.sndQueue {
-		close(ch)
+	for _, r := range c.sndQueue {
+		if !c.retransmit(r) {
+			close(r.ch)
+		}
 	}
-	close(c.rcvQueue)
 
 	return v.reason
 }
@@ -287,23 +267,47 @@ func (eventSndMsg) String() string {
 
 func (v eventSndMsg) exec(c *Connection) error {
 	if c.state != open && c.state != locked {
+		if v.ch != nil {
+			close(v.ch)
+		}
 		return notAcceptableEvent{e: v, s: c.state}
 	}
 
 	v.m.PeerName = c.Host
 	v.m.PeerRealm = c.Realm
 
-	if v.ch != nil {
-		c.sndQueue[v.m.HbHID] = v.ch
+	var err error
+	if v.ch == nil {
+		// answer can use reserved capacity, because dropped answer
+		// makes the peer retransmit the request that is already handled
+		err = c.write(v.m, nil)
+	} else {
+		err = c.tryWrite(v.m, nil)
 	}
-	err := v.m.MarshalTo(c.conn)
-	if err != nil {
-		err = TransportTxError{err: err}
+	if _, ok := err.(TransportTxError); ok {
 		c.notify <- eventPeerDisc{reason: err}
 	}
-
-	if TraceMessage != nil {
-		TraceMessage(v.m, Tx, err)
+	if v.ch == nil {
+	} else if err != nil {
+		close(v.ch)
+	} else {
+		c.sndQueue[v.m.HbHID] = sndRequest{m: v.m, ch: v.ch}
 	}
 	return err
 }
+
+// Abort MSG
+type eventAbortMsg struct {
+	hbhID uint32
+}
+
+func (eventAbortMsg) String() string {
+	return "Abort-MSG"
+}
+
+// exec removes aborted request. The request is already removed
+// when the answer is received before abort, and it is not error.
+func (v eventAbortMsg) exec(c *Connection) error {
+	delete(c.sndQueue, v.hbhID)
+	return nil
+}
@@ -0,0 +1,105 @@
+package diameter
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+)
+
+// TLSConfig is TLS configuration for in-band security negotiation.
+// TLS is required with Inband-Security-Id in CER/CEA when it is not nil,
+// and the transport connection is upgraded to TLS after CER/CEA exchange (RFC 3588 section 2.2).
+// Certificate of the peer is verified with Origin-Host of the peer.
+// It must be nil when the transport connection is already TLS.
+var TLSConfig *tls.Config
+
+// AcceptAnonymousPeer accepts TLS peer that presents no certificate.
+// Identity of the peer is not verified with certificate when it is enabled.
+var AcceptAnonymousPeer = false
+
+// Inband-Security-Id value (RFC 3588 section 6.10)
+const (
+	noInbandSecurity uint32 = 0
+	inbandTLS        uint32 = 1
+)
+
+// selectInbandSecurity returns Inband-Security-Id that is used with the peer.
+// peer is Inband-Security-Id values in CER/CEA of the peer,
+// empty means that the peer supports NO_INBAND_SECURITY only.
+func selectInbandSecurity(conf *tls.Config, peer []uint32) (uint32, bool) {
+	want := noInbandSecurity
+	if conf != nil {
+		want = inbandTLS
+	}
+	if len(peer) == 0 {
+		return noInbandSecurity, want == noInbandSecurity
+	}
+	for _, v := range peer {
+		if v == want {
+			return want, true
+		}
+	}
+	return noInbandSecurity, false
+}
+
+// upgradeTransport upgrades transport connection to TLS after queued messages are written
+// when TLS is selected with Inband-Security-Id.
+func (c *Connection) upgradeTransport(security uint32, server bool) error {
+	if security != inbandTLS {
+		return nil
+	}
+	if !server && c.Host == "" {
+		// server name and certificate of the peer are verified with the host
+		return InvalidMessage{
+			Code: UnknownPeer, ErrMsg: "host of the peer is unknown for TLS handshake"}
+	}
+	n := c.local()
+	c.closeWriter()
+
+	conf := n.TLSConfig.Clone()
+	var tc *tls.Conn
+	if server {
+		tc = tls.Server(c.conn, conf)
+	} else {
+		if conf.ServerName == "" && !conf.InsecureSkipVerify {
+			conf.ServerName = string(c.Host)
+		}
+		tc = tls.Client(c.conn, conf)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.WDInterval)
+	defer cancel()
+	if e := tc.HandshakeContext(ctx); e != nil {
+		return TransportTxError{err: e}
+	}
+	c.conn = tc
+	c.startWriter()
+	return verifyPeerIdentity(tc, c.Host, n.AcceptAnonymousPeer)
+}
+
+// verifyTransport checks certificate of TLS transport connection with Origin-Host of the peer.
+func (c *Connection) verifyTransport(host Identity) error {
+	if tc, ok := c.conn.(*tls.Conn); ok {
+		return verifyPeerIdentity(tc, host, c.local().AcceptAnonymousPeer)
+	}
+	return nil
+}
+
+// verifyPeerIdentity checks certificate of the TLS peer with Origin-Host of the peer.
+// Peer without certificate is rejected unless anonymous is true.
+func verifyPeerIdentity(tc *tls.Conn, host Identity, anonymous bool) error {
+	cs := tc.ConnectionState()
+	if len(cs.PeerCertificates) == 0 && anonymous {
+		return nil
+	} else if len(cs.PeerCertificates) == 0 {
+		return InvalidMessage{
+			Code:   UnknownPeer,
+			ErrMsg: fmt.Sprintf("no certificate is presented by %s", host)}
+	}
+	if e := cs.PeerCertificates[0].VerifyHostname(string(host)); e != nil {
+		return InvalidMessage{
+			Code:   UnknownPeer,
+			ErrMsg: fmt.Sprintf("peer certificate is not match with %s: %s", host, e)}
+	}
+	return nil
+}
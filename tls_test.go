@@ -0,0 +1,227 @@
+package diameter
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+// testCA issues certificates for test nodes.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca := &testCA{key: key, pool: x509.NewCertPool()}
+	if ca.cert, err = x509.ParseCertificate(der); err != nil {
+		t.Fatal(err)
+	}
+	ca.pool.AddCert(ca.cert)
+	return ca
+}
+
+func (ca *testCA) issue(t *testing.T, host string) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{
+			x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// config returns TLS configuration with certificate of host for mutual authentication.
+// Empty host means that no certificate is presented.
+func (ca *testCA) config(t *testing.T, host string) *tls.Config {
+	c := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    ca.pool,
+		ClientCAs:  ca.pool,
+		ClientAuth: tls.VerifyClientCertIfGiven}
+	if host != "" {
+		c.Certificates = []tls.Certificate{ca.issue(t, host)}
+	}
+	return c
+}
+
+// tcpPair returns connected loopback TCP connections.
+func tcpPair(t *testing.T) (net.Conn, net.Conn) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	ch := make(chan net.Conn, 1)
+	go func() {
+		con, _ := l.Accept()
+		ch <- con
+	}()
+	dc, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	lc := <-ch
+	if lc == nil {
+		t.Fatal("failed to accept connection")
+	}
+	return dc, lc
+}
+
+// servePair serves connection of node a on dc and node b on lc.
+// Output is true when both connections are opened.
+func servePair(t *testing.T, a, b *Node, dc, lc net.Conn) (*Connection, *Connection, bool) {
+	t.Helper()
+	ca := &Connection{Local: a, Host: b.Host, Realm: b.Realm}
+	cb := &Connection{Local: b}
+	done := make(chan error, 2)
+	go func() { done <- cb.ListenAndServe(lc) }()
+	go func() { done <- ca.DialAndServe(dc) }()
+	t.Cleanup(func() {
+		ca.Close(Rebooting)
+		dc.Close()
+		lc.Close()
+		for i := 0; i < 2; i++ {
+			<-done
+		}
+	})
+
+	for i := 0; i < 500; i++ {
+		if ca.State() == "open" && cb.State() == "open" {
+			return ca, cb, true
+		}
+		select {
+		case err := <-done:
+			done <- err
+			return ca, cb, false
+		case <-time.After(time.Millisecond * 10):
+		}
+	}
+	t.Fatal("connection is not opened or closed")
+	return ca, cb, false
+}
+
+func newTLSNodes(t *testing.T) (*Node, *Node) {
+	a := NewNode("a.local", "local")
+	b := NewNode("b.local", "local")
+	t.Cleanup(func() {
+		a.Close()
+		b.Close()
+	})
+	a.WDInterval = time.Second * 5
+	b.WDInterval = time.Second * 5
+	return a, b
+}
+
+func TestInbandTLS(t *testing.T) {
+	ca := newTestCA(t)
+	for _, tc := range []struct {
+		name             string
+		aHost, bHost     string
+		anonymous, opens bool
+	}{
+		{"valid", "a.local", "b.local", false, true},
+		{"server mismatch", "a.local", "x.local", false, false},
+		{"client mismatch", "x.local", "b.local", false, false},
+		{"no client certificate", "", "b.local", false, false},
+		{"anonymous client", "", "b.local", true, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b := newTLSNodes(t)
+			a.TLSConfig = ca.config(t, tc.aHost)
+			b.TLSConfig = ca.config(t, tc.bHost)
+			b.AcceptAnonymousPeer = tc.anonymous
+
+			dc, lc := tcpPair(t)
+			c, _, ok := servePair(t, a, b, dc, lc)
+			if ok != tc.opens {
+				t.Fatalf("connection is opened=%t, not %t", ok, tc.opens)
+			}
+			if ok && c.TLSState() == nil {
+				t.Error("transport is not upgraded to TLS")
+			}
+		})
+	}
+}
+
+func TestTransportTLS(t *testing.T) {
+	ca := newTestCA(t)
+	for _, tc := range []struct {
+		name         string
+		aHost, bHost string
+		opens        bool
+	}{
+		{"valid", "a.local", "b.local", true},
+		{"client mismatch", "x.local", "b.local", false},
+		{"no client certificate", "", "b.local", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b := newTLSNodes(t)
+			dc, lc := tcpPair(t)
+			// certificate chain is verified in handshake, and identity is verified with CER
+			dconf := ca.config(t, tc.aHost)
+			dconf.ServerName = string(b.Host)
+			tdc := tls.Client(dc, dconf)
+			tlc := tls.Server(lc, ca.config(t, tc.bHost))
+			go tlc.Handshake()
+			if err := tdc.Handshake(); err != nil {
+				t.Fatal(err)
+			}
+
+			_, _, ok := servePair(t, a, b, tdc, tlc)
+			if ok != tc.opens {
+				t.Fatalf("connection is opened=%t, not %t", ok, tc.opens)
+			}
+		})
+	}
+}
+
+func TestInbandTLSWithoutHost(t *testing.T) {
+	a, _ := newTLSNodes(t)
+	a.TLSConfig = newTestCA(t).config(t, "a.local")
+	// transport is not touched before the handshake
+	c := &Connection{Local: a}
+	var im InvalidMessage
+	if e := c.upgradeTransport(inbandTLS, false); !errors.As(e, &im) || im.Code != UnknownPeer {
+		t.Errorf("error is %v, not UnknownPeer", e)
+	}
+}
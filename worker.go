@@ -1,105 +1,256 @@
 package diameter
 
 import (
-	"bytes"
+	"encoding/binary"
+	"hash/fnv"
 	"time"
 )
 
 const (
 	minWorkers = 128
 	maxWorkers = 65535 - minWorkers
+
+	// DefaultDispatchLanes is default count of worker lanes for received message with Session-Id.
+	DefaultDispatchLanes = 64
+	laneQueueSize        = 1024
 )
 
 var sharedQ = make(chan Message, maxWorkers)
 var activeWorkers = make(chan int, 1)
 
+// Worker lanes that handle messages of one session serially.
+var lanes = make(chan []chan Message, 1)
+
 func init() {
 	activeWorkers <- 0
+	DefaultNode().startWorkers()
+}
+
+func (n *Node) startWorkers() {
+	n.lanes <- startLanes(DefaultDispatchLanes)
+
 	worker := func() {
 		for c := 0; c < 500; {
-			if len(sharedQ) < minWorkers {
+			if len(n.sharedQ) < minWorkers {
 				time.Sleep(time.Millisecond * 10)
 				c++
 				continue
 			}
-			if req, ok := <-sharedQ; !ok {
+			if req, ok := <-n.sharedQ; !ok {
 				break
 			} else {
 				handleMsg(req)
 				c = 0
 			}
 		}
-		activeWorkers <- (<-activeWorkers - 1)
+		n.activeWorkers <- (<-n.activeWorkers - 1)
 	}
 	for i := 0; i < minWorkers; i++ {
 		go func() {
-			for req, ok := <-sharedQ; ok; req, ok = <-sharedQ {
-				a := <-activeWorkers
-				activeWorkers <- a
-				if len(sharedQ) > minWorkers && a < maxWorkers {
-					activeWorkers <- (<-activeWorkers + 1)
+			for req, ok := <-n.sharedQ; ok; req, ok = <-n.sharedQ {
+				a := <-n.activeWorkers
+				n.activeWorkers <- a
+				if len(n.sharedQ) > minWorkers && a < maxWorkers {
+					n.activeWorkers <- (<-n.activeWorkers + 1)
 					go worker()
 				}
 				handleMsg(req)
 			}
 		}()
 	}
-	/*
-		for i := 0; i < 10; i++ {
-			go func() {
-				for req, ok := <-sharedQ; ok; req, ok = <-sharedQ {
-					handleMsg(req)
-				}
-			}()
+}
+
+func startLanes(k int) []chan Message {
+	ls := make([]chan Message, k)
+	for i := range ls {
+		ls[i] = make(chan Message, laneQueueSize)
+		go func(q chan Message) {
+			for req, ok := <-q; ok; req, ok = <-q {
+				handleMsg(req)
+			}
+		}(ls[i])
+	}
+	return ls
+}
+
+/*
+SetDispatchLanes changes count of worker lanes of the node.
+Received requests with same Session-Id are handled serially in one lane,
+and requests of different sessions are handled in parallel.
+Zero means that all requests are handled by shared workers without ordering.
+It should be called before connections are opened,
+because requests already in old lanes are not ordered with new lanes.
+*/
+func (n *Node) SetDispatchLanes(k int) {
+	if k < 0 {
+		k = 0
+	}
+	old := <-n.lanes
+	if n.stopped {
+		n.lanes <- old
+		return
+	}
+	n.lanes <- startLanes(k)
+	for _, q := range old {
+		close(q)
+	}
+}
+
+/*
+Close stops worker pool of the node after queued requests are handled.
+Connections of the node should be closed before,
+because requests that are received after Close are answered with TooBusy.
+Worker pool of default node is shared with package level functions and is not stopped.
+*/
+func (n *Node) Close() {
+	if n.sharedQ == sharedQ {
+		return
+	}
+	ls := <-n.lanes
+	if !n.stopped {
+		n.stopped = true
+		for _, q := range ls {
+			close(q)
+		}
+		close(n.sharedQ)
+	}
+	n.lanes <- nil
+}
+
+// dispatch puts received request to worker lane selected by Session-Id,
+// or to shared queue when the request has no Session-Id.
+// Output is false when the queue is full.
+func (n *Node) dispatch(req Message) bool {
+	if req.rcv != nil {
+		req.rcv.rxPending.Add(1)
+	}
+
+	ok := false
+	ls := <-n.lanes
+	if n.stopped {
+	} else if sid := findSessionID(req.AVPs); sid != nil && len(ls) != 0 {
+		h := fnv.New32a()
+		h.Write(sid)
+		select {
+		case ls[h.Sum32()%uint32(len(ls))] <- req:
+			ok = true
+		default:
+		}
+	} else {
+		select {
+		case n.sharedQ <- req:
+			ok = true
+		default:
+		}
+	}
+	n.lanes <- ls
+
+	if !ok && req.rcv != nil {
+		req.rcv.rxPending.Add(-1)
+	}
+	return ok
+}
+
+// findSessionID returns data of Session-Id AVP without decoding other AVPs.
+func findSessionID(avps []byte) []byte {
+	for len(avps) >= 8 {
+		code := binary.BigEndian.Uint32(avps)
+		vendor := avps[4]&0x80 != 0
+		l := int(binary.BigEndian.Uint32(avps[4:]) & 0x00ffffff)
+		h := 8
+		if vendor {
+			h = 12
+		}
+		if l < h || l > len(avps) {
+			return nil
+		}
+		if code == 263 && !vendor {
+			return avps[h:l]
+		}
+		if l = (l + 3) &^ 3; l > len(avps) {
+			return nil
 		}
-	*/
+		avps = avps[l:]
+	}
+	return nil
 }
 
 func handleMsg(req Message) {
+	n := req.local
+	if n == nil {
+		n = DefaultNode()
+	}
+	if req.rcv != nil {
+		defer req.rcv.rxPending.Add(-1)
+	}
+
+	k, done, cached, seen := n.checkDuplicate(req)
+	if cached != nil {
+		ans := *cached
+		ans.HbHID = req.HbHID
+		sendAnswer(n, req, ans)
+		return
+	}
+	req.dup = seen
+
+	ans, ok := answerMsg(n, req)
+	if !ok {
+		n.finishDuplicate(k, done, nil)
+		return
+	}
+	n.finishDuplicate(k, done, &ans)
+	sendAnswer(n, req, ans)
+}
+
+// sendAnswer queues answer to the connection that receive the request.
+// The answer is dropped when the connection is already closed.
+func sendAnswer(n *Node, req, ans Message) {
+	if req.rcv == nil || req.rcv.post(eventSndMsg{ans, nil}) {
+		return
+	}
+	if n.TraceMessage != nil {
+		n.TraceMessage(ans, Tx, RejectTxMessage{
+			State: closed, ErrMsg: "connection is closed before sending answer"})
+	}
+}
+
+func answerMsg(n *Node, req Message) (Message, bool) {
 	var f Handler
-	if app, ok := applications[req.AppID]; !ok {
+	if app, ok := n.loadApplications()[req.AppID]; !ok {
 		f = nil
 	} else if f, ok = app.handlers[req.Code]; !ok {
 		f = nil
 	}
 	if f == nil {
-		ans := DefaultRxHandler(req)
+		ans := n.DefaultRxHandler(req)
 		ans.FlgR = false
 		ans.HbHID = req.HbHID
 		ans.EtEID = req.EtEID
-		req.notify <- eventSndMsg{ans, nil}
-		return
+		return ans, true
 	}
 
-	avp := make([]AVP, 0, avpBufferSize)
-	for rdr := bytes.NewReader(req.AVPs); rdr.Len() != 0; {
-		a := AVP{}
-		if e := a.UnmarshalFrom(rdr); e != nil {
-			buf := new(bytes.Buffer)
-			SetResultCode(InvalidAvpValue).MarshalTo(buf)
-			SetOriginHost(Host).MarshalTo(buf)
-			SetOriginRealm(Realm).MarshalTo(buf)
-
-			req.notify <- eventSndMsg{Message{
-				FlgR: false, FlgP: req.FlgP, FlgE: true, FlgT: false,
-				Code: req.Code, AppID: req.AppID,
-				HbHID: req.HbHID, EtEID: req.EtEID,
-				AVPs: buf.Bytes()}, nil}
-			return
-		}
-		avp = append(avp, a)
+	avp, e := req.GetAVP()
+	if e != nil {
+		return req.GenerateAnswerByError(e), true
 	}
 
-	if req.FlgE, avp = f(req.FlgT, avp); avp != nil {
-		buf := new(bytes.Buffer)
-		for _, a := range avp {
-			a.MarshalTo(buf)
+	if n.ValidateRequest != nil {
+		if e := n.ValidateRequest(req, avp); e != nil {
+			return req.GenerateAnswerByError(e), true
 		}
+	}
 
-		req.notify <- eventSndMsg{Message{
-			FlgR: false, FlgP: req.FlgP, FlgE: req.FlgE, FlgT: false,
-			Code: req.Code, AppID: req.AppID,
-			HbHID: req.HbHID, EtEID: req.EtEID,
-			AVPs: buf.Bytes()}, nil}
+	retry := req.FlgT
+	if n.DuplicateWindow > 0 {
+		retry = req.FlgT && req.dup
+	}
+	if req.FlgE, avp = f(retry, avp); avp == nil {
+		return req, false
 	}
+	ans := Message{
+		FlgR: false, FlgP: req.FlgP, FlgE: req.FlgE, FlgT: false,
+		Code: req.Code, AppID: req.AppID,
+		HbHID: req.HbHID, EtEID: req.EtEID}
+	ans.SetAVP(avp)
+	return ans, true
 }
@@ -0,0 +1,136 @@
+package diameter
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAnswerAfterPeerDisc(t *testing.T) {
+	const count = 40 // more than state machine queue of the connection
+	var entered atomic.Int32
+	block := make(chan struct{})
+	p := newTestPeers(t, func(_ bool, avp []AVP) (bool, []AVP) {
+		entered.Add(1)
+		<-block
+		return false, []AVP{
+			SetResultCode(Success),
+			SetOriginHost("b.local"), SetOriginRealm("local")}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	for i := 0; i < count; i++ {
+		// request without Session-Id is handled by shared workers
+		m := Message{
+			FlgR: true, FlgP: true, Code: testCode, AppID: testAppID,
+			HbHID: nextHbH(), EtEID: nextEtE()}
+		m.SetAVP([]AVP{
+			SetOriginHost(p.a.Host), SetOriginRealm(p.a.Realm),
+			SetDestinationRealm(p.b.Realm)})
+		go p.ca.SendContext(ctx, m)
+	}
+	for i := 0; i < 500 && entered.Load() != count; i++ {
+		time.Sleep(time.Millisecond * 10)
+	}
+	if n := entered.Load(); n != count {
+		t.Fatalf("%d requests are handled, not %d", n, count)
+	}
+
+	if con := p.cb.snapshot().conn; con != nil {
+		con.Close()
+	}
+	p.wait(t)
+	close(block)
+
+	// answers for closed connection are dropped without blocking workers
+	for i := 0; i < 100 && p.cb.RxQueue() != 0; i++ {
+		time.Sleep(time.Millisecond * 10)
+	}
+	if n := p.cb.RxQueue(); n != 0 {
+		t.Errorf("%d workers are blocked by answer to closed connection", n)
+	}
+}
+
+func TestDispatchLanes(t *testing.T) {
+	const lanes, count = 4, 100
+	laneOf := func(sid string) uint32 {
+		h := fnv.New32a()
+		h.Write([]byte(sid))
+		return h.Sum32() % lanes
+	}
+	// sessions that are handled in different lanes
+	sidA, sidB := "b.local;1;0", ""
+	for i := 1; sidB == "" || laneOf(sidA) == laneOf(sidB); i++ {
+		sidB = fmt.Sprintf("b.local;1;%d", i)
+	}
+
+	var lock sync.Mutex
+	handled := map[string][]uint32{}
+	done := make(chan struct{}, count*2)
+	released := make(chan bool, 1)
+	block := make(chan struct{})
+	n := NewNode("b.local", "local")
+	defer n.Close()
+	n.SetDispatchLanes(lanes)
+	n.Handle(testCode, testAppID, 0, func(_ bool, avp []AVP) (bool, []AVP) {
+		sid, _ := GetSessionID(avp[0])
+		var seq uint32
+		avp[1].Decode(&seq)
+		switch {
+		case sid == sidA && seq == 0:
+			// first request of A waits for all requests of B
+			select {
+			case <-block:
+				released <- true
+			case <-time.After(time.Second * 5):
+				released <- false
+			}
+		case sid == sidB && seq == count-1:
+			close(block)
+		}
+		lock.Lock()
+		handled[sid] = append(handled[sid], seq)
+		lock.Unlock()
+		done <- struct{}{}
+		return false, nil
+	}, nil)
+
+	for i := uint32(0); i < count; i++ {
+		for _, sid := range []string{sidA, sidB} {
+			m := Message{FlgR: true, Code: testCode, AppID: testAppID, local: n}
+			m.SetAVP([]AVP{SetSessionID(sid), SetGenericAVP(1, 0, i)})
+			if !n.dispatch(m) {
+				t.Fatal("request is not dispatched")
+			}
+		}
+	}
+	for i := 0; i < count*2; i++ {
+		select {
+		case <-done:
+		case <-time.After(time.Second * 10):
+			t.Fatalf("%d requests are handled", i)
+		}
+	}
+
+	if !<-released {
+		t.Error("requests of other session are not handled in parallel")
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	for _, sid := range []string{sidA, sidB} {
+		if len(handled[sid]) != count {
+			t.Fatalf("%d requests of %s are handled", len(handled[sid]), sid)
+		}
+		for i, seq := range handled[sid] {
+			if seq != uint32(i) {
+				t.Errorf("request %d of %s is handled at %d", seq, sid, i)
+				break
+			}
+		}
+	}
+}
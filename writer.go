@@ -0,0 +1,186 @@
+package diameter
+
+import (
+	"hash/fnv"
+	"net"
+	"time"
+)
+
+const (
+	writeQueueSize = 1024 // capacity of transport write queue for application messages
+	writeReserve   = 64   // additional capacity of write queue for base protocol messages
+	maxWriteBatch  = 64   // maximum count of messages that are written in one system call
+)
+
+func (c *Connection) startWriter() {
+	c.wQueue = make(chan Message, writeQueueSize+writeReserve)
+	c.wDone = make(chan struct{})
+	c.wClosed = false
+	go c.writeLoop(c.conn, c.wQueue, c.wDone)
+}
+
+// write queues base protocol message or answer to writer with reserved capacity of the queue.
+// RejectTxMessage is returned when the queue is full, it means that transport is stalled.
+// err is passed to TraceMessage with the message.
+func (c *Connection) write(m Message, err error) error {
+	return c.enqueue(m, err, cap(c.wQueue))
+}
+
+// tryWrite queues the message to writer.
+// RejectTxMessage is returned when the queue is full.
+func (c *Connection) tryWrite(m Message, err error) error {
+	return c.enqueue(m, err, writeQueueSize)
+}
+
+// enqueue queues the message when length of the queue is less than limit.
+// Message is traced when it is queued, and error of writer is reported by Peer-Disc event.
+func (c *Connection) enqueue(m Message, err error, limit int) error {
+	if c.wClosed {
+		return c.rejectTx(m, TransportTxError{err: net.ErrClosed})
+	}
+	if e, ok := c.wErr.Load().(error); ok {
+		return c.rejectTx(m, e)
+	}
+	// state machine is only sender, so the length is not increased by others
+	if len(c.wQueue) >= limit {
+		return c.rejectTx(m, RejectTxMessage{
+			State: c.state, ErrMsg: "transmit queue is full"})
+	}
+	c.wQueue <- m
+	c.wLen.Add(1)
+	if n := c.local(); n.TraceMessage != nil {
+		n.TraceMessage(m, Tx, err)
+	}
+	return nil
+}
+
+func (c *Connection) rejectTx(m Message, err error) error {
+	if n := c.local(); n.TraceMessage != nil {
+		n.TraceMessage(m, Tx, err)
+	}
+	return err
+}
+
+// closeWriter stops writer after queued messages are written.
+// Transport connection is closed when writing is not finished in WDInterval.
+func (c *Connection) closeWriter() {
+	if c.wClosed {
+		return
+	}
+	c.wClosed = true
+	close(c.wQueue)
+
+	t := time.NewTimer(c.local().WDInterval)
+	defer t.Stop()
+	select {
+	case <-c.wDone:
+	case <-t.C:
+		c.conn.Close()
+		<-c.wDone
+	}
+}
+
+// writeLoop writes queued messages to transport connection.
+// Messages in the queue are written together in one system call on stream transport,
+// and each message is written in one call on message oriented transport such as SCTP.
+func (c *Connection) writeLoop(conn net.Conn, q <-chan Message, done chan<- struct{}) {
+	defer close(done)
+
+	batch := make([]Message, 0, maxWriteBatch)
+	hdr := make([]byte, 0, 20*maxWriteBatch)
+	bufs := make(net.Buffers, 0, 2*maxWriteBatch)
+	_, writev := conn.(*net.TCPConn)
+	sc, _ := conn.(streamConn)
+	var streams uint16
+	if sc != nil {
+		_, streams, _ = sc.Streams()
+	}
+
+	var err error
+	for m := range q {
+		batch = append(batch[:0], m)
+	fill:
+		for len(batch) < maxWriteBatch {
+			select {
+			case m, ok := <-q:
+				if !ok {
+					break fill
+				}
+				batch = append(batch, m)
+			default:
+				break fill
+			}
+		}
+		c.wLen.Add(-int32(len(batch)))
+
+		if err != nil {
+			// discard queued messages after error
+			continue
+		} else if sc != nil {
+			err = writeStreams(sc, batch, streams)
+		} else if writev {
+			// write header and AVPs of each message without copy
+			hdr, bufs = hdr[:0], bufs[:0]
+			for _, m := range batch {
+				l := len(hdr)
+				hdr = m.appendHeader(hdr)
+				bufs = append(bufs, hdr[l:], m.AVPs)
+			}
+			v := bufs
+			_, err = v.WriteTo(conn)
+		} else {
+			p := getBuffer()
+			*p = (*p)[:0]
+			for _, m := range batch {
+				*p = m.AppendTo(*p)
+			}
+			_, err = conn.Write(*p)
+			putBuffer(p)
+		}
+		if err != nil {
+			err = TransportTxError{err: err}
+			c.wErr.Store(err)
+			// reader detects closed connection and notifies Peer-Disc event with the error
+			conn.Close()
+		}
+	}
+}
+
+// streamConn is message oriented transport connection with multiple streams, such as SCTP.
+type streamConn interface {
+	WriteStream([]byte, uint16) (int, error)
+	Streams() (in, out uint16, e error)
+}
+
+// writeStreams writes each message as one user message to the stream selected by streamOf.
+func writeStreams(sc streamConn, batch []Message, streams uint16) (err error) {
+	p := getBuffer()
+	b := (*p)[:0]
+	for _, m := range batch {
+		b = m.AppendTo(b[:0])
+		if _, err = sc.WriteStream(b, streamOf(m, streams)); err != nil {
+			break
+		}
+	}
+	*p = b
+	putBuffer(p)
+	return
+}
+
+// streamOf returns stream ID for the message.
+// Base protocol messages, messages without Session-Id
+// and messages on single stream association use stream 0,
+// and other messages use a stream selected by Session-Id,
+// so that messages of one session are delivered in order.
+func streamOf(m Message, streams uint16) uint16 {
+	if m.AppID == 0 || streams < 2 {
+		return 0
+	}
+	sid := findSessionID(m.AVPs)
+	if sid == nil {
+		return 0
+	}
+	h := fnv.New32a()
+	h.Write(sid)
+	return 1 + uint16(h.Sum32()%uint32(streams-1))
+}
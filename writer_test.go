@@ -0,0 +1,134 @@
+package diameter
+
+import (
+	"errors"
+	"net"
+	"testing"
+)
+
+// testStreamConn records each WriteStream call.
+type testStreamConn struct {
+	net.Conn
+	msgs    [][]byte
+	streams []uint16
+}
+
+func (c *testStreamConn) WriteStream(b []byte, s uint16) (int, error) {
+	c.msgs = append(c.msgs, append([]byte{}, b...))
+	c.streams = append(c.streams, s)
+	return len(b), nil
+}
+
+func (c *testStreamConn) Streams() (uint16, uint16, error) {
+	return 10, 10, nil
+}
+
+func TestWriteStreamPerMessage(t *testing.T) {
+	n := NewNode("a.local", "local")
+	defer n.Close()
+	c := &Connection{Local: n}
+	sc := &testStreamConn{}
+
+	var msgs []Message
+	for i := 0; i < 8; i++ {
+		m := Message{
+			FlgR: true, FlgP: true, Code: testCode, AppID: testAppID,
+			HbHID: nextHbH(), EtEID: nextEtE()}
+		m.SetAVP([]AVP{SetSessionID("a.local;1;2"), SetOriginHost(n.Host)})
+		msgs = append(msgs, m)
+	}
+	msgs = append(msgs, Message{FlgR: true, Code: 280, HbHID: nextHbH(), EtEID: nextEtE()})
+
+	q := make(chan Message, len(msgs))
+	for _, m := range msgs {
+		q <- m
+	}
+	close(q)
+	done := make(chan struct{})
+	c.writeLoop(sc, q, done)
+
+	if len(sc.msgs) != len(msgs) {
+		t.Fatalf("%d messages are written with %d calls", len(msgs), len(sc.msgs))
+	}
+	for i, m := range msgs {
+		if string(sc.msgs[i]) != string(m.AppendTo(nil)) {
+			t.Errorf("user message %d is not one Diameter message", i)
+		}
+		if sc.streams[i] != streamOf(m, 10) {
+			t.Errorf("message %d is written to stream %d", i, sc.streams[i])
+		}
+	}
+	if s := sc.streams[len(msgs)-1]; s != 0 {
+		t.Errorf("base protocol message is written to stream %d", s)
+	}
+}
+
+func TestWriteQueueFull(t *testing.T) {
+	n := NewNode("a.local", "local")
+	defer n.Close()
+	traced := 0
+	n.TraceMessage = func(Message, Direction, error) { traced++ }
+	// writer is not started, so that the queue is not consumed
+	c := &Connection{Local: n, wQueue: make(chan Message, writeQueueSize+writeReserve)}
+	m := Message{FlgR: true, Code: testCode, AppID: testAppID}
+
+	for i := 0; i < writeQueueSize; i++ {
+		if e := c.tryWrite(m, nil); e != nil {
+			t.Fatalf("message %d is rejected: %v", i, e)
+		}
+	}
+	var re RejectTxMessage
+	if e := c.tryWrite(m, nil); !errors.As(e, &re) {
+		t.Errorf("error is %v, not RejectTxMessage", e)
+	}
+	for i := 0; i < writeReserve; i++ {
+		if e := c.write(m, nil); e != nil {
+			t.Fatalf("base protocol message %d is rejected: %v", i, e)
+		}
+	}
+	if e := c.write(m, nil); !errors.As(e, &re) {
+		t.Errorf("error is %v, not RejectTxMessage", e)
+	}
+	if want := writeQueueSize + writeReserve + 2; traced != want {
+		t.Errorf("%d messages are traced, not %d", traced, want)
+	}
+}
+
+func TestAnswerUsesReserve(t *testing.T) {
+	n := NewNode("a.local", "local")
+	defer n.Close()
+	// writer is not started, so that the queue is not consumed
+	c := &Connection{Local: n, state: open,
+		wQueue:   make(chan Message, writeQueueSize+writeReserve),
+		sndQueue: make(map[uint32]sndRequest)}
+	req := Message{FlgR: true, Code: testCode, AppID: testAppID}
+	for i := 0; i < writeQueueSize; i++ {
+		req.HbHID = nextHbH()
+		if e := (eventSndMsg{req, make(chan Message, 1)}).exec(c); e != nil {
+			t.Fatalf("request %d is rejected: %v", i, e)
+		}
+	}
+
+	// request is rejected when the queue is full
+	ch := make(chan Message, 1)
+	req.HbHID = nextHbH()
+	var re RejectTxMessage
+	if e := (eventSndMsg{req, ch}).exec(c); !errors.As(e, &re) {
+		t.Errorf("error is %v, not RejectTxMessage", e)
+	}
+	if _, ok := <-ch; ok {
+		t.Error("channel of rejected request is not closed")
+	}
+
+	// answer is queued to reserved capacity
+	ans := Message{FlgR: false, Code: testCode, AppID: testAppID, HbHID: nextHbH()}
+	if e := (eventSndMsg{ans, nil}).exec(c); e != nil {
+		t.Errorf("answer is rejected: %v", e)
+	}
+	if l := c.WriteQueue(); l != writeQueueSize+1 {
+		t.Errorf("write queue length is %d, not %d", l, writeQueueSize+1)
+	}
+	if l := len(c.sndQueue); l != writeQueueSize {
+		t.Errorf("%d requests are waiting answer, not %d", l, writeQueueSize)
+	}
+}